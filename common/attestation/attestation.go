@@ -0,0 +1,222 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package attestation
+
+import (
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"time"
+
+	"github.com/blang/semver"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultMaxAge is used when a check doesn't specify how old a statement may be
+	DefaultMaxAge = 10 * time.Minute
+
+	// ClockSkew is the tolerance applied to statement timestamps
+	ClockSkew = time.Minute
+)
+
+var validMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512", "EdDSA"}
+
+// Statement is the set of claims an endpoint management agent signs about a device. Statements are submitted as
+// compact JWS (JWT) tokens signed with a key pinned in the posture check.
+type Statement struct {
+	jwt.RegisteredClaims
+	IdentityId      string `json:"identityId,omitempty"`
+	DiskEncrypted   bool   `json:"diskEncrypted"`
+	FirewallEnabled bool   `json:"firewallEnabled"`
+	EdrAgent        string `json:"edrAgent,omitempty"`
+	EdrVersion      string `json:"edrVersion,omitempty"`
+}
+
+// Check holds the pinned signing keys and the requirements a statement must meet
+type Check struct {
+	PublicKeys            []string
+	MaxAgeSeconds         int64
+	RequireDiskEncryption bool
+	RequireFirewall       bool
+	EdrAgent              string
+	MinEdrVersion         string
+}
+
+func (self *Check) Validate() error {
+	if len(self.PublicKeys) == 0 {
+		return errorz.NewFieldError("at least one public key is required", "publicKeys", self.PublicKeys)
+	}
+
+	for _, publicKey := range self.PublicKeys {
+		if _, err := ParsePublicKey(publicKey); err != nil {
+			return errorz.NewFieldError(err.Error(), "publicKeys", publicKey)
+		}
+	}
+
+	if self.MaxAgeSeconds < 0 {
+		return errorz.NewFieldError("must be zero, for the default, or greater", "maxAgeSeconds", self.MaxAgeSeconds)
+	}
+
+	if self.MinEdrVersion != "" {
+		if _, err := semver.ParseTolerant(self.MinEdrVersion); err != nil {
+			return errorz.NewFieldError("invalid version: "+err.Error(), "minEdrVersion", self.MinEdrVersion)
+		}
+	}
+
+	return nil
+}
+
+// MaxAge returns how long after being issued a statement is accepted
+func (self *Check) MaxAge() time.Duration {
+	if self.MaxAgeSeconds <= 0 {
+		return DefaultMaxAge
+	}
+	return time.Duration(self.MaxAgeSeconds) * time.Second
+}
+
+// Verify checks the statement's signature against the pinned keys and its freshness as of now. If the statement
+// names an identity, it must be the given one. The parsed statement is returned along with any error, so callers
+// may report what was claimed.
+func (self *Check) Verify(token string, identityId string, now time.Time) (*Statement, error) {
+	if token == "" {
+		return nil, errors.New("no attestation statement has been submitted")
+	}
+
+	var statement *Statement
+	var lastErr error
+
+	for _, pemKey := range self.PublicKeys {
+		publicKey, err := ParsePublicKey(pemKey)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		claims := &Statement{}
+		_, err = jwt.ParseWithClaims(token, claims, func(*jwt.Token) (interface{}, error) {
+			return publicKey, nil
+		}, jwt.WithValidMethods(validMethods), jwt.WithTimeFunc(func() time.Time { return now }),
+			jwt.WithLeeway(ClockSkew), jwt.WithIssuedAt())
+
+		if err == nil {
+			statement = claims
+			break
+		}
+
+		// the signature is checked before the claims, so any other failure means the key matched
+		if !errors.Is(err, jwt.ErrTokenSignatureInvalid) && !errors.Is(err, jwt.ErrTokenUnverifiable) {
+			return claims, errors.Wrap(err, "attestation statement is not valid")
+		}
+		lastErr = err
+	}
+
+	if statement == nil {
+		return nil, errors.Wrap(lastErr, "attestation statement signature is not valid for any pinned key")
+	}
+
+	if statement.IssuedAt == nil {
+		return statement, errors.New("attestation statement has no issued at time")
+	}
+
+	if age := now.Sub(statement.IssuedAt.Time); age > self.MaxAge() {
+		return statement, errors.Errorf("attestation statement was issued %s ago, max age is %s", age.Truncate(time.Second), self.MaxAge())
+	}
+
+	if statement.IdentityId != "" && statement.IdentityId != identityId {
+		return statement, errors.Errorf("attestation statement was issued for identity %s", statement.IdentityId)
+	}
+
+	return statement, self.Evaluate(statement)
+}
+
+// Evaluate checks the claims of a verified statement against the requirements
+func (self *Check) Evaluate(statement *Statement) error {
+	if self.RequireDiskEncryption && !statement.DiskEncrypted {
+		return errors.New("disk encryption is required")
+	}
+
+	if self.RequireFirewall && !statement.FirewallEnabled {
+		return errors.New("firewall is required")
+	}
+
+	if self.EdrAgent != "" && self.EdrAgent != statement.EdrAgent {
+		return errors.Errorf("edr agent %s is required, have [%s]", self.EdrAgent, statement.EdrAgent)
+	}
+
+	if self.MinEdrVersion != "" {
+		minVersion, err := semver.ParseTolerant(self.MinEdrVersion)
+		if err != nil {
+			return errors.Wrapf(err, "invalid minimum edr version %s", self.MinEdrVersion)
+		}
+
+		version, err := semver.ParseTolerant(statement.EdrVersion)
+		if err != nil {
+			return errors.Errorf("edr version %s or later is required, have [%s]", self.MinEdrVersion, statement.EdrVersion)
+		}
+
+		if version.LT(minVersion) {
+			return errors.Errorf("edr version %s or later is required, have %s", self.MinEdrVersion, statement.EdrVersion)
+		}
+	}
+
+	return nil
+}
+
+// Remaining returns how long until the given statement is too old, or zero if it already is
+func (self *Check) Remaining(statement *Statement, now time.Time) time.Duration {
+	if statement == nil || statement.IssuedAt == nil {
+		return 0
+	}
+
+	remaining := statement.IssuedAt.Add(self.MaxAge()).Sub(now)
+	if statement.ExpiresAt != nil {
+		if untilExpiry := statement.ExpiresAt.Sub(now); untilExpiry < remaining {
+			remaining = untilExpiry
+		}
+	}
+
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
+
+// ParsePublicKey parses a PEM encoded public key or certificate
+func ParsePublicKey(pemKey string) (crypto.PublicKey, error) {
+	block, _ := pem.Decode([]byte(pemKey))
+	if block == nil {
+		return nil, errors.New("public key is not PEM encoded")
+	}
+
+	switch block.Type {
+	case "PUBLIC KEY":
+		return x509.ParsePKIXPublicKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		return x509.ParsePKCS1PublicKey(block.Bytes)
+	case "CERTIFICATE":
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		return cert.PublicKey, nil
+	}
+
+	return nil, errors.Errorf("unsupported PEM block type %s", block.Type)
+}
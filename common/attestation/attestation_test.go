@@ -0,0 +1,137 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package attestation
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestKey(t *testing.T) (*ecdsa.PrivateKey, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+
+	return key, string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+func sign(t *testing.T, key *ecdsa.PrivateKey, statement *Statement) string {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodES256, statement).SignedString(key)
+	require.NoError(t, err)
+	return token
+}
+
+func TestCheck_Verify(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	key, pemKey := newTestKey(t)
+	otherKey, otherPemKey := newTestKey(t)
+
+	check := &Check{
+		PublicKeys:            []string{otherPemKey, pemKey},
+		MaxAgeSeconds:         300,
+		RequireDiskEncryption: true,
+		RequireFirewall:       true,
+		EdrAgent:              "acme-edr",
+		MinEdrVersion:         "7.2",
+	}
+	require.NoError(t, check.Validate())
+
+	newStatement := func() *Statement {
+		return &Statement{
+			RegisteredClaims: jwt.RegisteredClaims{
+				IssuedAt: jwt.NewNumericDate(now.Add(-time.Minute)),
+			},
+			IdentityId:      "identity1",
+			DiskEncrypted:   true,
+			FirewallEnabled: true,
+			EdrAgent:        "acme-edr",
+			EdrVersion:      "7.3.1",
+		}
+	}
+
+	t.Run("a fresh, signed statement which meets the requirements passes", func(t *testing.T) {
+		statement, err := check.Verify(sign(t, key, newStatement()), "identity1", now)
+		require.NoError(t, err)
+		require.Equal(t, "7.3.1", statement.EdrVersion)
+		require.Equal(t, 4*time.Minute, check.Remaining(statement, now))
+	})
+
+	t.Run("a statement signed by an unpinned key fails", func(t *testing.T) {
+		unpinnedKey, _ := newTestKey(t)
+		_, err := check.Verify(sign(t, unpinnedKey, newStatement()), "identity1", now)
+		require.Error(t, err)
+	})
+
+	t.Run("a statement signed by either pinned key passes", func(t *testing.T) {
+		_, err := check.Verify(sign(t, otherKey, newStatement()), "identity1", now)
+		require.NoError(t, err)
+	})
+
+	t.Run("a stale statement fails", func(t *testing.T) {
+		statement := newStatement()
+		statement.IssuedAt = jwt.NewNumericDate(now.Add(-10 * time.Minute))
+		_, err := check.Verify(sign(t, key, statement), "identity1", now)
+		require.Error(t, err)
+	})
+
+	t.Run("a statement for another identity fails", func(t *testing.T) {
+		_, err := check.Verify(sign(t, key, newStatement()), "identity2", now)
+		require.Error(t, err)
+	})
+
+	t.Run("unmet requirements fail", func(t *testing.T) {
+		statement := newStatement()
+		statement.DiskEncrypted = false
+		_, err := check.Verify(sign(t, key, statement), "identity1", now)
+		require.ErrorContains(t, err, "disk encryption")
+
+		statement = newStatement()
+		statement.EdrVersion = "7.1.9"
+		_, err = check.Verify(sign(t, key, statement), "identity1", now)
+		require.ErrorContains(t, err, "edr version")
+	})
+
+	t.Run("a tampered statement fails", func(t *testing.T) {
+		token := sign(t, key, newStatement())
+		_, err := check.Verify(token[:len(token)-4]+"AAAA", "identity1", now)
+		require.Error(t, err)
+	})
+
+	t.Run("a missing statement fails", func(t *testing.T) {
+		_, err := check.Verify("", "identity1", now)
+		require.Error(t, err)
+	})
+}
+
+func TestCheck_Validate(t *testing.T) {
+	_, pemKey := newTestKey(t)
+
+	require.Error(t, (&Check{}).Validate())
+	require.Error(t, (&Check{PublicKeys: []string{"not a key"}}).Validate())
+	require.Error(t, (&Check{PublicKeys: []string{pemKey}, MinEdrVersion: "abc"}).Validate())
+	require.NoError(t, (&Check{PublicKeys: []string{pemKey}}).Validate())
+}
@@ -78,11 +78,15 @@ type SerialGenerator interface {
 
 type DefaultSerialGenerator struct{}
 
+// serialLimit bounds serial numbers to 128 bits, so certificates can be told apart by serial number alone when
+// they're revoked
+var serialLimit = new(big.Int).Lsh(big.NewInt(1), 128)
+
 func (DefaultSerialGenerator) Generate() *big.Int {
-	//@todo this need to be better, this does not include negative numbers for 20bit values, nor is this managed
-	r, _ := rand.Int(rand.Reader, big.NewInt(524287))
+	r, _ := rand.Int(rand.Reader, serialLimit)
 
-	return r
+	// serial numbers must be positive
+	return r.Add(r, big.NewInt(1))
 }
 
 var _ Signer = &ServerSigner{}
@@ -153,6 +157,11 @@ type ClientSigner struct {
 	caCert          *x509.Certificate
 	caKey           crypto.PrivateKey
 	SerialGenerator SerialGenerator
+
+	// CrlDistributionPoints and OcspServers, if set, are embedded in signed certificates so relying parties can
+	// check their revocation status
+	CrlDistributionPoints []string
+	OcspServers           []string
 }
 
 func (s *ClientSigner) Cert() *x509.Certificate {
@@ -195,6 +204,9 @@ func (s *ClientSigner) SignCsr(csr *x509.CertificateRequest, opts *SigningOpts)
 		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment | x509.KeyUsageDataEncipherment,
 		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
 		IsCA:         false,
+
+		CRLDistributionPoints: s.CrlDistributionPoints,
+		OCSPServer:            s.OcspServers,
 	}
 
 	if opts != nil {
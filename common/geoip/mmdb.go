@@ -0,0 +1,85 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package geoip
+
+import (
+	"net"
+
+	"github.com/oschwald/maxminddb-golang"
+	"github.com/pkg/errors"
+)
+
+// Reader resolves countries from a MaxMind DB (mmdb) file, such as the GeoLite2 and GeoIP2 country databases. It is
+// safe for concurrent lookups.
+type Reader struct {
+	db *maxminddb.Reader
+}
+
+type countryRecord struct {
+	Country struct {
+		IsoCode string `maxminddb:"iso_code"`
+	} `maxminddb:"country"`
+	RegisteredCountry struct {
+		IsoCode string `maxminddb:"iso_code"`
+	} `maxminddb:"registered_country"`
+}
+
+// Open loads the mmdb file at the given path
+func Open(path string) (*Reader, error) {
+	db, err := maxminddb.Open(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to load geoip database [%s]", path)
+	}
+	return &Reader{db: db}, nil
+}
+
+// FromBytes creates a Reader from an in-memory mmdb database
+func FromBytes(buf []byte) (*Reader, error) {
+	db, err := maxminddb.FromBytes(buf)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid geoip database")
+	}
+	return &Reader{db: db}, nil
+}
+
+// Metadata returns the metadata of the loaded database
+func (self *Reader) Metadata() maxminddb.Metadata {
+	return self.db.Metadata
+}
+
+// Country returns the ISO 3166-1 alpha-2 country code for the given ip, falling back to the registered country
+// when no location based country is present. An empty string is returned if the ip is not in the database.
+func (self *Reader) Country(ip net.IP) (string, error) {
+	if ip == nil {
+		return "", errors.New("ip is nil")
+	}
+
+	record := &countryRecord{}
+	if err := self.db.Lookup(ip, record); err != nil {
+		return "", err
+	}
+
+	if record.Country.IsoCode != "" {
+		return record.Country.IsoCode, nil
+	}
+	return record.RegisteredCountry.IsoCode, nil
+}
+
+// Close releases the database
+func (self *Reader) Close() error {
+	return self.db.Close()
+}
@@ -0,0 +1,254 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package geoip
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// mmdb format constants used to build test databases
+var metadataStartMarker = []byte("\xAB\xCD\xEFMaxMind.com")
+
+const (
+	typePointer = 1
+	typeString  = 2
+	typeUint16  = 5
+	typeUint32  = 6
+	typeMap     = 7
+)
+
+type testDbBuilder struct {
+	nodes [][2]int
+	data  bytes.Buffer
+}
+
+func (self *testDbBuilder) newNode() int {
+	self.nodes = append(self.nodes, [2]int{-1, -1})
+	return len(self.nodes) - 1
+}
+
+// insert adds a network pointing at the given data offset. Records are encoded as -1 for empty, >= 0 for a child
+// node and <= -2 for data, which are resolved in build once the node count is known.
+func (self *testDbBuilder) insert(cidr string, dataOffset int) {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		panic(err)
+	}
+
+	ip := network.IP.To16()
+	prefixLen, _ := network.Mask.Size()
+	if network.IP.To4() != nil {
+		ip = append(make(net.IP, 12), network.IP.To4()...)
+		prefixLen += 96
+	}
+
+	node := 0
+	for i := 0; i < prefixLen; i++ {
+		bit := (ip[i/8] >> (7 - uint(i%8))) & 1
+		if i == prefixLen-1 {
+			self.nodes[node][bit] = -2 - dataOffset
+			return
+		}
+		next := self.nodes[node][bit]
+		if next < 0 {
+			next = self.newNode()
+			self.nodes[node][bit] = next
+		}
+		node = next
+	}
+}
+
+func (self *testDbBuilder) build(recordSize int) []byte {
+	nodeCount := len(self.nodes)
+	var out bytes.Buffer
+	for _, node := range self.nodes {
+		var records [2]uint32
+		for i, record := range node {
+			switch {
+			case record == -1:
+				records[i] = uint32(nodeCount)
+			case record >= 0:
+				records[i] = uint32(record)
+			default:
+				records[i] = uint32(nodeCount + 16 + (-2 - record))
+			}
+		}
+
+		switch recordSize {
+		case 24:
+			out.Write([]byte{byte(records[0] >> 16), byte(records[0] >> 8), byte(records[0])})
+			out.Write([]byte{byte(records[1] >> 16), byte(records[1] >> 8), byte(records[1])})
+		case 28:
+			out.Write([]byte{byte(records[0] >> 16), byte(records[0] >> 8), byte(records[0])})
+			out.WriteByte(byte((records[0]>>24)<<4) | byte(records[1]>>24&0x0F))
+			out.Write([]byte{byte(records[1] >> 16), byte(records[1] >> 8), byte(records[1])})
+		default:
+			_ = binary.Write(&out, binary.BigEndian, records)
+		}
+	}
+
+	out.Write(make([]byte, 16))
+	out.Write(self.data.Bytes())
+	out.Write(metadataStartMarker)
+
+	metadata := &bytes.Buffer{}
+	writeMapHeader(metadata, 5)
+	writeString(metadata, "binary_format_major_version")
+	writeUint16(metadata, 2)
+	writeString(metadata, "node_count")
+	writeUint32(metadata, uint32(nodeCount))
+	writeString(metadata, "record_size")
+	writeUint16(metadata, uint16(recordSize))
+	writeString(metadata, "ip_version")
+	writeUint16(metadata, 6)
+	writeString(metadata, "database_type")
+	writeString(metadata, "Test-Country")
+	out.Write(metadata.Bytes())
+
+	return out.Bytes()
+}
+
+func writeMapHeader(buf *bytes.Buffer, size int) {
+	buf.WriteByte(typeMap<<5 | byte(size))
+}
+
+func writeString(buf *bytes.Buffer, value string) {
+	buf.WriteByte(typeString<<5 | byte(len(value)))
+	buf.WriteString(value)
+}
+
+func writeUint16(buf *bytes.Buffer, value uint16) {
+	buf.WriteByte(typeUint16<<5 | 2)
+	_ = binary.Write(buf, binary.BigEndian, value)
+}
+
+func writeUint32(buf *bytes.Buffer, value uint32) {
+	buf.WriteByte(typeUint32<<5 | 4)
+	_ = binary.Write(buf, binary.BigEndian, value)
+}
+
+func writePointer(buf *bytes.Buffer, offset int) {
+	buf.WriteByte(typePointer<<5 | byte(offset>>8)&0x7)
+	buf.WriteByte(byte(offset))
+}
+
+func newTestDb(t *testing.T, recordSize int) *Reader {
+	builder := &testDbBuilder{}
+	builder.newNode()
+
+	// a shared country map, referenced through pointers
+	canadaOffset := builder.data.Len()
+	writeMapHeader(&builder.data, 1)
+	writeString(&builder.data, "iso_code")
+	writeString(&builder.data, "CA")
+
+	canadaRecord := builder.data.Len()
+	writeMapHeader(&builder.data, 1)
+	writeString(&builder.data, "country")
+	writePointer(&builder.data, canadaOffset)
+
+	registeredOnlyRecord := builder.data.Len()
+	writeMapHeader(&builder.data, 1)
+	writeString(&builder.data, "registered_country")
+	writePointer(&builder.data, canadaOffset)
+
+	germanyRecord := builder.data.Len()
+	writeMapHeader(&builder.data, 1)
+	writeString(&builder.data, "country")
+	writeMapHeader(&builder.data, 1)
+	writeString(&builder.data, "iso_code")
+	writeString(&builder.data, "DE")
+
+	builder.insert("24.0.0.0/8", canadaRecord)
+	builder.insert("25.1.0.0/16", registeredOnlyRecord)
+	builder.insert("2a00:1450::/32", germanyRecord)
+
+	reader, err := FromBytes(builder.build(recordSize))
+	require.NoError(t, err)
+	return reader
+}
+
+func TestReader_Country(t *testing.T) {
+	for _, recordSize := range []int{24, 28, 32} {
+		reader := newTestDb(t, recordSize)
+		req := require.New(t)
+
+		req.Equal(uint(recordSize), reader.Metadata().RecordSize)
+		req.Equal(uint(6), reader.Metadata().IPVersion)
+		req.Equal("Test-Country", reader.Metadata().DatabaseType)
+
+		country, err := reader.Country(net.ParseIP("24.10.20.30"))
+		req.NoError(err)
+		req.Equal("CA", country)
+
+		country, err = reader.Country(net.ParseIP("25.1.2.3"))
+		req.NoError(err)
+		req.Equal("CA", country)
+
+		country, err = reader.Country(net.ParseIP("2a00:1450:4001::1"))
+		req.NoError(err)
+		req.Equal("DE", country)
+
+		country, err = reader.Country(net.ParseIP("25.2.0.1"))
+		req.NoError(err)
+		req.Equal("", country)
+
+		country, err = reader.Country(net.ParseIP("2001:db8::1"))
+		req.NoError(err)
+		req.Equal("", country)
+	}
+}
+
+func TestReader_InvalidDatabase(t *testing.T) {
+	_, err := FromBytes([]byte("not a database"))
+	require.Error(t, err)
+}
+
+func TestRules_Evaluate(t *testing.T) {
+	req := require.New(t)
+
+	rules := &Rules{
+		AllowedCidrs:     []string{"10.0.0.0/8", "192.168.1.10"},
+		DeniedCidrs:      []string{"10.10.0.0/16"},
+		AllowedCountries: []string{"ca"},
+		DeniedCountries:  []string{"KP"},
+	}
+	req.NoError(rules.Validate())
+
+	req.NoError(rules.Evaluate(net.ParseIP("10.1.2.3"), ""))
+	req.NoError(rules.Evaluate(net.ParseIP("192.168.1.10"), ""))
+	req.NoError(rules.Evaluate(net.ParseIP("24.1.2.3"), "CA"))
+	req.Error(rules.Evaluate(net.ParseIP("10.10.2.3"), ""))
+	req.Error(rules.Evaluate(net.ParseIP("10.1.2.3"), "KP"))
+	req.Error(rules.Evaluate(net.ParseIP("192.168.1.11"), ""))
+	req.Error(rules.Evaluate(net.ParseIP("24.1.2.3"), "US"))
+	req.Error(rules.Evaluate(nil, "CA"))
+
+	denyOnly := &Rules{DeniedCountries: []string{"DE"}}
+	req.NoError(denyOnly.Evaluate(net.ParseIP("24.1.2.3"), ""))
+	req.NoError(denyOnly.EvaluateWithLookup(net.ParseIP("24.1.2.3"), newTestDb(t, 24)))
+	req.Error(denyOnly.EvaluateWithLookup(net.ParseIP("2a00:1450::1"), newTestDb(t, 24)))
+
+	req.Error((&Rules{}).Validate())
+	req.Error((&Rules{AllowedCidrs: []string{"10.0.0.0/33"}}).Validate())
+	req.Error((&Rules{AllowedCountries: []string{"CAN"}}).Validate())
+}
@@ -0,0 +1,169 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package geoip
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/pkg/errors"
+)
+
+// CountryLookup resolves an ip to an ISO 3166-1 alpha-2 country code. Implemented by Reader.
+type CountryLookup interface {
+	Country(ip net.IP) (string, error)
+}
+
+// Rules are the allow and deny lists of a source ip check. Deny lists are evaluated first. If no allow lists are
+// given, any source that is not denied passes. Otherwise, the source must be in an allowed network or country.
+type Rules struct {
+	AllowedCidrs     []string
+	DeniedCidrs      []string
+	AllowedCountries []string
+	DeniedCountries  []string
+}
+
+func (self *Rules) Validate() error {
+	for _, field := range []struct {
+		name   string
+		values []string
+	}{{"allowedCidrs", self.AllowedCidrs}, {"deniedCidrs", self.DeniedCidrs}} {
+		for _, value := range field.values {
+			if _, err := ParseNetwork(value); err != nil {
+				return errorz.NewFieldError(err.Error(), field.name, value)
+			}
+		}
+	}
+
+	for _, field := range []struct {
+		name   string
+		values []string
+	}{{"allowedCountries", self.AllowedCountries}, {"deniedCountries", self.DeniedCountries}} {
+		for _, value := range field.values {
+			if !isCountryCode(value) {
+				return errorz.NewFieldError("must be an ISO 3166-1 alpha-2 country code", field.name, value)
+			}
+		}
+	}
+
+	if len(self.AllowedCidrs)+len(self.DeniedCidrs)+len(self.AllowedCountries)+len(self.DeniedCountries) == 0 {
+		return errorz.NewFieldError("at least one network or country must be given", "allowedCidrs", self.AllowedCidrs)
+	}
+
+	return nil
+}
+
+// UsesCountries returns true if the rules need a country resolved for the source ip
+func (self *Rules) UsesCountries() bool {
+	return len(self.AllowedCountries) > 0 || len(self.DeniedCountries) > 0
+}
+
+// Evaluate checks the given source ip and its country, which may be empty if unknown. A nil error means the source
+// passes the rules.
+func (self *Rules) Evaluate(ip net.IP, country string) error {
+	if ip == nil {
+		return errors.New("source ip is unknown")
+	}
+
+	for _, cidr := range self.DeniedCidrs {
+		if network, err := ParseNetwork(cidr); err == nil && network.Contains(ip) {
+			return errors.Errorf("source ip %s is in denied network %s", ip, cidr)
+		}
+	}
+
+	if country != "" {
+		for _, denied := range self.DeniedCountries {
+			if strings.EqualFold(denied, country) {
+				return errors.Errorf("source ip %s is in denied country %s", ip, strings.ToUpper(country))
+			}
+		}
+	}
+
+	if len(self.AllowedCidrs) == 0 && len(self.AllowedCountries) == 0 {
+		return nil
+	}
+
+	for _, cidr := range self.AllowedCidrs {
+		if network, err := ParseNetwork(cidr); err == nil && network.Contains(ip) {
+			return nil
+		}
+	}
+
+	if country != "" {
+		for _, allowed := range self.AllowedCountries {
+			if strings.EqualFold(allowed, country) {
+				return nil
+			}
+		}
+	}
+
+	return errors.Errorf("source ip %s%s is not in an allowed network or country", ip, describeCountry(country))
+}
+
+// EvaluateWithLookup resolves the country of the source ip, if required, and evaluates the rules against it
+func (self *Rules) EvaluateWithLookup(ip net.IP, lookup CountryLookup) error {
+	country := ""
+	if ip != nil && lookup != nil && self.UsesCountries() {
+		var err error
+		if country, err = lookup.Country(ip); err != nil {
+			country = ""
+		}
+	}
+	return self.Evaluate(ip, country)
+}
+
+// ParseNetwork parses a CIDR, or a single ip which is treated as a host network
+func ParseNetwork(value string) (*net.IPNet, error) {
+	if strings.Contains(value, "/") {
+		_, network, err := net.ParseCIDR(value)
+		if err != nil {
+			return nil, errors.Errorf("invalid cidr %s", value)
+		}
+		return network, nil
+	}
+
+	ip := net.ParseIP(value)
+	if ip == nil {
+		return nil, errors.Errorf("invalid ip or cidr %s", value)
+	}
+
+	if ipv4 := ip.To4(); ipv4 != nil {
+		return &net.IPNet{IP: ipv4, Mask: net.CIDRMask(32, 32)}, nil
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}, nil
+}
+
+func isCountryCode(value string) bool {
+	if len(value) != 2 {
+		return false
+	}
+	for _, c := range strings.ToUpper(value) {
+		if c < 'A' || c > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+func describeCountry(country string) string {
+	if country == "" {
+		return ""
+	}
+	return fmt.Sprintf(" (country %s)", strings.ToUpper(country))
+}
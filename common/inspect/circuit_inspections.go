@@ -21,6 +21,7 @@ type CircuitInspectDetail struct {
 	Forwards          map[string]string             `json:"forwards"`
 	XgressDetails     map[string]*XgressDetail      `json:"xgressDetails"`
 	LinkDetails       map[string]*LinkInspectDetail `json:"linkDetails"`
+	Priority          string                        `json:"priority,omitempty"`
 	includeGoroutines bool
 }
 
@@ -43,6 +44,7 @@ type XgressDetail struct {
 	Goroutines            []string                `json:"goroutines"`
 	Sequence              uint64                  `json:"sequence"`
 	Flags                 string                  `json:"flags"`
+	Compression           string                  `json:"compression,omitempty"`
 }
 
 type XgressSendBufferDetail struct {
@@ -61,6 +63,14 @@ type XgressSendBufferDetail struct {
 	TimeSinceLastRetx     string  `json:"timeSinceLastRetx"`
 	CloseWhenEmpty        bool    `json:"closeWhenEmpty"`
 	AcquiredSafely        bool    `json:"acquiredSafely"`
+	CongestionControl     string  `json:"congestionControl"`
+	CongestionState       string  `json:"congestionState,omitempty"`
+	Rtt                   uint16  `json:"rtt"`
+	MinRtt                uint16  `json:"minRtt,omitempty"`
+	BandwidthEstimate     uint64  `json:"bandwidthEstimate,omitempty"`
+	Gain                  float64 `json:"gain,omitempty"`
+	Sack                  bool    `json:"sack"`
+	FastRetransmits       uint32  `json:"fastRetransmits"`
 }
 
 type XgressRecvBufferDetail struct {
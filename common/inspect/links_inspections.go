@@ -27,15 +27,16 @@ type LinksInspectResult struct {
 }
 
 type LinkInspectDetail struct {
-	Id          string `json:"id"`
-	Iteration   uint32 `json:"iteration"`
-	Key         string `json:"key"`
-	Split       bool   `json:"split"`
-	Protocol    string `json:"protocol"`
-	DialAddress string `json:"dialAddress"`
-	Dest        string `json:"dest"`
-	DestVersion string `json:"destVersion"`
-	Dialed      bool   `json:"dialed"`
+	Id             string         `json:"id"`
+	Iteration      uint32         `json:"iteration"`
+	Key            string         `json:"key"`
+	Split          bool           `json:"split"`
+	Protocol       string         `json:"protocol"`
+	DialAddress    string         `json:"dialAddress"`
+	Dest           string         `json:"dest"`
+	DestVersion    string         `json:"destVersion"`
+	Dialed         bool           `json:"dialed"`
+	PriorityQueues map[string]int `json:"priorityQueues,omitempty"`
 }
 
 type LinkDest struct {
@@ -25,6 +25,9 @@ type TerminatorCostDetail struct {
 	CircuitCount uint32 `json:"circuitCount"`
 	FailureCost  uint32 `json:"failureCost"`
 	CurrentCost  uint32 `json:"currentCost"`
+
+	// DialTimeEwmaMillis is the moving average of dial times, for strategies which track it
+	DialTimeEwmaMillis float64 `json:"dialTimeEwmaMillis,omitempty"`
 }
 
 type SdkTerminatorInspectResult struct {
@@ -28,6 +28,10 @@ func (x *UpdateServiceConfigsCmd) GetCommandType() int32 {
 	return int32(CommandType_UpdateServiceConfigsType)
 }
 
+func (x *PruneAuditRecordsCmd) GetCommandType() int32 {
+	return int32(CommandType_PruneAuditRecordsType)
+}
+
 func EncodeTags(tags map[string]interface{}) (map[string]*TagValue, error) {
 	if len(tags) == 0 {
 		return nil, nil
@@ -27,13 +27,16 @@ import (
 	"github.com/michaelquigley/pfxlog"
 	"github.com/openziti/foundation/v2/concurrenz"
 	"ztna-core/ztna/common/pb/edge_ctrl_pb"
+	"ztna-core/ztna/common/schedule"
 	cmap "github.com/orcaman/concurrent-map/v2"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"io"
 	"os"
 	"sort"
 	"sync/atomic"
+	"time"
 )
 
 // RouterDataModelConfig contains the configuration values for a RouterDataModel
@@ -96,6 +99,42 @@ type ServicePolicy struct {
 	*DataStateServicePolicy
 	Services      cmap.ConcurrentMap[string, struct{}] `json:"services"`
 	PostureChecks cmap.ConcurrentMap[string, struct{}] `json:"postureChecks"`
+	schedule      *schedule.Schedule
+}
+
+// IsActive returns true if the policy is unscheduled or if its schedule is in force at the given time
+func (self *ServicePolicy) IsActive(t time.Time) bool {
+	return self.schedule.IsActive(t)
+}
+
+func (self *ServicePolicy) IsScheduled() bool {
+	return self.schedule != nil
+}
+
+func parseServicePolicySchedule(servicePolicy *edge_ctrl_pb.DataState_ServicePolicy) *schedule.Schedule {
+	pbSchedule := servicePolicy.GetSchedule()
+	if pbSchedule == nil {
+		return nil
+	}
+
+	var notBefore, notAfter *time.Time
+	if pbSchedule.NotBefore != nil {
+		t := pbSchedule.NotBefore.AsTime()
+		notBefore = &t
+	}
+	if pbSchedule.NotAfter != nil {
+		t := pbSchedule.NotAfter.AsTime()
+		notAfter = &t
+	}
+
+	result, err := schedule.Parse(pbSchedule.Timezone, pbSchedule.Days, pbSchedule.Windows, notBefore, notAfter)
+	if err != nil {
+		pfxlog.Logger().WithField("policyId", servicePolicy.Id).WithError(err).
+			Error("invalid service policy schedule, policy will be treated as inactive")
+		// fail closed, a schedule which ended at the zero time is never active
+		return &schedule.Schedule{NotAfter: &time.Time{}}
+	}
+	return result
 }
 
 // RouterDataModel represents a sub-set of a controller's data model. Enough to validate an identities access to dial/bind
@@ -122,6 +161,9 @@ type RouterDataModel struct {
 
 	subscriptions cmap.ConcurrentMap[string, *IdentitySubscription]
 	events        chan subscriberEvent
+
+	// scheduleStates tracks the last seen active state of scheduled policies. Only accessed from processSubscriberEvents
+	scheduleStates map[string]bool
 	closeNotify   <-chan struct{}
 	stopNotify    chan struct{}
 	stopped       atomic.Bool
@@ -271,7 +313,13 @@ func NewReceiverRouterDataModelFromFile(path string, listenerBufferSize uint, cl
 	return rdm, nil
 }
 
+// ScheduleCheckInterval is how often a receiver checks whether any service policy schedules have opened or closed
+var ScheduleCheckInterval = 15 * time.Second
+
 func (rdm *RouterDataModel) processSubscriberEvents() {
+	ticker := time.NewTicker(ScheduleCheckInterval)
+	defer ticker.Stop()
+
 	for !rdm.stopped.Load() {
 		select {
 		case <-rdm.closeNotify:
@@ -280,10 +328,38 @@ func (rdm *RouterDataModel) processSubscriberEvents() {
 			return
 		case evt := <-rdm.events:
 			evt.process(rdm)
+		case <-ticker.C:
+			if rdm.checkSchedules(time.Now()) {
+				syncAllSubscribersEvent{}.process(rdm)
+			}
 		}
 	}
 }
 
+// checkSchedules returns true if any scheduled service policy has become active or inactive since the last check,
+// meaning subscribers need to be re-evaluated so access is granted or removed.
+func (rdm *RouterDataModel) checkSchedules(now time.Time) bool {
+	if rdm.scheduleStates == nil {
+		rdm.scheduleStates = map[string]bool{}
+	}
+
+	changed := false
+	current := map[string]bool{}
+	rdm.ServicePolicies.IterCb(func(key string, policy *ServicePolicy) {
+		if !policy.IsScheduled() {
+			return
+		}
+		active := policy.IsActive(now)
+		current[key] = active
+		if prev, found := rdm.scheduleStates[key]; found && prev != active {
+			pfxlog.Logger().WithField("policyId", key).WithField("active", active).Info("service policy schedule changed state")
+			changed = true
+		}
+	})
+	rdm.scheduleStates = current
+	return changed
+}
+
 func (rdm *RouterDataModel) Stop() {
 	if rdm.stopped.CompareAndSwap(false, true) {
 		close(rdm.stopNotify)
@@ -477,12 +553,14 @@ func (rdm *RouterDataModel) applyUpdateServicePolicyEvent(model *edge_ctrl_pb.Da
 				DataStateServicePolicy: servicePolicy,
 				Services:               cmap.New[struct{}](),
 				PostureChecks:          cmap.New[struct{}](),
+				schedule:               parseServicePolicySchedule(servicePolicy),
 			}
 		} else {
 			return &ServicePolicy{
 				DataStateServicePolicy: servicePolicy,
 				Services:               valueInMap.Services,
 				PostureChecks:          valueInMap.PostureChecks,
+				schedule:               parseServicePolicySchedule(servicePolicy),
 			}
 		}
 	})
@@ -914,6 +992,8 @@ func (rdm *RouterDataModel) buildServiceList(sub *IdentitySubscription) (map[str
 	services := map[string]*IdentityService{}
 	postureChecks := map[string]*PostureCheck{}
 
+	now := time.Now()
+
 	sub.Identity.ServicePolicies.IterCb(func(policyId string, _ struct{}) {
 		policy, ok := rdm.ServicePolicies.Get(policyId)
 		if !ok {
@@ -921,6 +1001,10 @@ func (rdm *RouterDataModel) buildServiceList(sub *IdentitySubscription) (map[str
 			return
 		}
 
+		if !policy.IsActive(now) {
+			return
+		}
+
 		policy.Services.IterCb(func(serviceId string, _ struct{}) {
 			service, ok := rdm.Services.Get(serviceId)
 			if !ok {
@@ -1071,7 +1155,8 @@ func (rdm *RouterDataModel) Diff(o *RouterDataModel, sink DiffSink) {
 	diffType("config", rdm.Configs, o.Configs, sink, Config{}, DataStateConfig{})
 	diffType("identity", rdm.Identities, o.Identities, sink, Identity{}, DataStateIdentity{})
 	diffType("service", rdm.Services, o.Services, sink, Service{}, DataStateService{})
-	diffType("service-policy", rdm.ServicePolicies, o.ServicePolicies, sink, ServicePolicy{}, DataStateServicePolicy{})
+	diffType("service-policy", rdm.ServicePolicies, o.ServicePolicies, sink, ServicePolicy{}, DataStateServicePolicy{},
+		edge_ctrl_pb.DataState_Schedule{}, timestamppb.Timestamp{})
 	diffType("posture-check", rdm.PostureChecks, o.PostureChecks, sink,
 		PostureCheck{}, DataStatePostureCheck{},
 		edge_ctrl_pb.DataState_PostureCheck_Domains_{}, edge_ctrl_pb.DataState_PostureCheck_Domains{},
@@ -1079,7 +1164,9 @@ func (rdm *RouterDataModel) Diff(o *RouterDataModel, sink DiffSink) {
 		edge_ctrl_pb.DataState_PostureCheck_Mfa_{}, edge_ctrl_pb.DataState_PostureCheck_Mfa{},
 		edge_ctrl_pb.DataState_PostureCheck_OsList_{}, edge_ctrl_pb.DataState_PostureCheck_OsList{}, edge_ctrl_pb.DataState_PostureCheck_Os{},
 		edge_ctrl_pb.DataState_PostureCheck_Process_{}, edge_ctrl_pb.DataState_PostureCheck_Process{},
-		edge_ctrl_pb.DataState_PostureCheck_ProcessMulti_{}, edge_ctrl_pb.DataState_PostureCheck_ProcessMulti{})
+		edge_ctrl_pb.DataState_PostureCheck_ProcessMulti_{}, edge_ctrl_pb.DataState_PostureCheck_ProcessMulti{},
+		edge_ctrl_pb.DataState_PostureCheck_SourceIp_{}, edge_ctrl_pb.DataState_PostureCheck_SourceIp{},
+		edge_ctrl_pb.DataState_PostureCheck_Attestation_{}, edge_ctrl_pb.DataState_PostureCheck_Attestation{})
 	diffType("public-keys", rdm.PublicKeys, o.PublicKeys, sink, edge_ctrl_pb.DataState_PublicKey{})
 	diffType("revocations", rdm.Revocations, o.Revocations, sink, edge_ctrl_pb.DataState_Revocation{})
 	diffMaps("cached-public-keys", rdm.getPublicKeysAsCmap(), o.getPublicKeysAsCmap(), sink, func(a, b crypto.PublicKey) []string {
@@ -0,0 +1,275 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+// Package schedule evaluates the optional time constraints that can be attached to policies: days of the week,
+// time-of-day windows interpreted in a timezone and absolute not-before/not-after bounds.
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const MinutesPerDay = 24 * 60
+
+var dayNames = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
+// Window is a time-of-day range, in minutes since midnight. The start is inclusive and the end exclusive. An end
+// before the start denotes a window that wraps past midnight, in which case the day check applies to the day the
+// window opened.
+type Window struct {
+	Start int
+	End   int
+}
+
+// ParseWindow parses a window of the form HH:MM-HH:MM. 24:00 is accepted as an end time.
+func ParseWindow(s string) (Window, error) {
+	parts := strings.Split(strings.TrimSpace(s), "-")
+	if len(parts) != 2 {
+		return Window{}, errors.Errorf("invalid window '%s', expected HH:MM-HH:MM", s)
+	}
+	start, err := parseTimeOfDay(parts[0])
+	if err != nil {
+		return Window{}, errors.Wrapf(err, "invalid window '%s'", s)
+	}
+	end, err := parseTimeOfDay(parts[1])
+	if err != nil {
+		return Window{}, errors.Wrapf(err, "invalid window '%s'", s)
+	}
+	result := Window{Start: start, End: end}
+	if err = result.Validate(); err != nil {
+		return Window{}, err
+	}
+	return result, nil
+}
+
+func parseTimeOfDay(s string) (int, error) {
+	s = strings.TrimSpace(s)
+	hourStr, minuteStr, found := strings.Cut(s, ":")
+	if !found {
+		return 0, errors.Errorf("invalid time of day '%s', expected HH:MM", s)
+	}
+	hour, err := strconv.Atoi(hourStr)
+	if err != nil {
+		return 0, errors.Errorf("invalid hour in '%s'", s)
+	}
+	minute, err := strconv.Atoi(minuteStr)
+	if err != nil {
+		return 0, errors.Errorf("invalid minute in '%s'", s)
+	}
+	if hour < 0 || hour > 24 || minute < 0 || minute > 59 || (hour == 24 && minute != 0) {
+		return 0, errors.Errorf("time of day '%s' out of range", s)
+	}
+	return hour*60 + minute, nil
+}
+
+func (self Window) Validate() error {
+	if self.Start < 0 || self.Start >= MinutesPerDay {
+		return errors.Errorf("window start %d out of range", self.Start)
+	}
+	if self.End < 0 || self.End > MinutesPerDay {
+		return errors.Errorf("window end %d out of range", self.End)
+	}
+	if self.Start == self.End {
+		return errors.Errorf("window %s is empty", self)
+	}
+	return nil
+}
+
+func (self Window) WrapsMidnight() bool {
+	return self.End < self.Start
+}
+
+func (self Window) String() string {
+	return fmt.Sprintf("%02d:%02d-%02d:%02d", self.Start/60, self.Start%60, self.End/60, self.End%60)
+}
+
+// ParseDay accepts either the short (mon) or long (monday) english day name, case-insensitively.
+func ParseDay(s string) (time.Weekday, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	for idx, name := range dayNames {
+		if s == name || s == strings.ToLower(time.Weekday(idx).String()) {
+			return time.Weekday(idx), nil
+		}
+	}
+	return 0, errors.Errorf("invalid day of week '%s'", s)
+}
+
+func DayName(day time.Weekday) string {
+	if day < 0 || int(day) >= len(dayNames) {
+		return day.String()
+	}
+	return dayNames[day]
+}
+
+// Schedule restricts when something is in force. Empty Days means every day, empty Windows means all day and an
+// empty Timezone means UTC.
+type Schedule struct {
+	Timezone  string
+	Days      []time.Weekday
+	Windows   []Window
+	NotBefore *time.Time
+	NotAfter  *time.Time
+
+	locOnce sync.Once
+	loc     *time.Location
+	locErr  error
+}
+
+func (self *Schedule) location() (*time.Location, error) {
+	self.locOnce.Do(func() {
+		if self.Timezone == "" {
+			self.loc = time.UTC
+			return
+		}
+		self.loc, self.locErr = time.LoadLocation(self.Timezone)
+	})
+	return self.loc, self.locErr
+}
+
+func (self *Schedule) Validate() error {
+	if self == nil {
+		return nil
+	}
+	if _, err := self.location(); err != nil {
+		return errors.Wrapf(err, "invalid timezone '%s'", self.Timezone)
+	}
+	for _, day := range self.Days {
+		if day < time.Sunday || day > time.Saturday {
+			return errors.Errorf("invalid day of week %d", day)
+		}
+	}
+	for _, window := range self.Windows {
+		if err := window.Validate(); err != nil {
+			return err
+		}
+	}
+	if self.NotBefore != nil && self.NotAfter != nil && !self.NotBefore.Before(*self.NotAfter) {
+		return errors.New("notBefore must be before notAfter")
+	}
+	return nil
+}
+
+// IsActive reports whether the schedule is in force at the given time. A nil schedule is always active. A schedule
+// with an unloadable timezone is never active, so that a bad configuration fails closed.
+func (self *Schedule) IsActive(t time.Time) bool {
+	if self == nil {
+		return true
+	}
+
+	if self.NotBefore != nil && t.Before(*self.NotBefore) {
+		return false
+	}
+
+	if self.NotAfter != nil && !t.Before(*self.NotAfter) {
+		return false
+	}
+
+	loc, err := self.location()
+	if err != nil {
+		return false
+	}
+
+	local := t.In(loc)
+	minute := local.Hour()*60 + local.Minute()
+	today := local.Weekday()
+	yesterday := (today + 6) % 7
+
+	if len(self.Windows) == 0 {
+		return self.isDayAllowed(today)
+	}
+
+	for _, window := range self.Windows {
+		if window.WrapsMidnight() {
+			if minute >= window.Start && self.isDayAllowed(today) {
+				return true
+			}
+			if minute < window.End && self.isDayAllowed(yesterday) {
+				return true
+			}
+		} else if minute >= window.Start && minute < window.End && self.isDayAllowed(today) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (self *Schedule) isDayAllowed(day time.Weekday) bool {
+	if len(self.Days) == 0 {
+		return true
+	}
+	for _, allowed := range self.Days {
+		if allowed == day {
+			return true
+		}
+	}
+	return false
+}
+
+// IsTimeBased returns true if the schedule has constraints beyond absolute bounds, meaning its active state can
+// change repeatedly over time.
+func (self *Schedule) IsTimeBased() bool {
+	return self != nil && (len(self.Days) > 0 || len(self.Windows) > 0)
+}
+
+func (self *Schedule) DayNames() []string {
+	var result []string
+	for _, day := range self.Days {
+		result = append(result, DayName(day))
+	}
+	return result
+}
+
+func (self *Schedule) WindowStrings() []string {
+	var result []string
+	for _, window := range self.Windows {
+		result = append(result, window.String())
+	}
+	return result
+}
+
+// Parse builds a schedule from its string representation, as stored and exposed over the API.
+func Parse(timezone string, days []string, windows []string, notBefore, notAfter *time.Time) (*Schedule, error) {
+	result := &Schedule{
+		Timezone:  timezone,
+		NotBefore: notBefore,
+		NotAfter:  notAfter,
+	}
+	for _, dayStr := range days {
+		day, err := ParseDay(dayStr)
+		if err != nil {
+			return nil, err
+		}
+		result.Days = append(result.Days, day)
+	}
+	for _, windowStr := range windows {
+		window, err := ParseWindow(windowStr)
+		if err != nil {
+			return nil, err
+		}
+		result.Windows = append(result.Windows, window)
+	}
+	if err := result.Validate(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,127 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseWindow(t *testing.T) {
+	req := require.New(t)
+
+	w, err := ParseWindow("09:00-17:30")
+	req.NoError(err)
+	req.Equal(Window{Start: 9 * 60, End: 17*60 + 30}, w)
+	req.Equal("09:00-17:30", w.String())
+
+	w, err = ParseWindow("22:00-24:00")
+	req.NoError(err)
+	req.Equal(MinutesPerDay, w.End)
+
+	w, err = ParseWindow("22:00-06:00")
+	req.NoError(err)
+	req.True(w.WrapsMidnight())
+
+	for _, invalid := range []string{"", "09:00", "9-17", "25:00-26:00", "09:60-10:00", "10:00-10:00", "24:00-01:00"} {
+		_, err = ParseWindow(invalid)
+		req.Error(err, invalid)
+	}
+}
+
+func TestParseDay(t *testing.T) {
+	req := require.New(t)
+
+	day, err := ParseDay("Mon")
+	req.NoError(err)
+	req.Equal(time.Monday, day)
+
+	day, err = ParseDay("saturday")
+	req.NoError(err)
+	req.Equal(time.Saturday, day)
+
+	_, err = ParseDay("someday")
+	req.Error(err)
+}
+
+func TestIsActive(t *testing.T) {
+	req := require.New(t)
+
+	var nilSchedule *Schedule
+	req.True(nilSchedule.IsActive(time.Now()))
+
+	s, err := Parse("America/New_York", []string{"mon", "tue", "wed", "thu", "fri"}, []string{"09:00-17:00"}, nil, nil)
+	req.NoError(err)
+
+	ny, err := time.LoadLocation("America/New_York")
+	req.NoError(err)
+
+	// Wednesday
+	req.True(s.IsActive(time.Date(2024, 5, 15, 9, 0, 0, 0, ny)))
+	req.True(s.IsActive(time.Date(2024, 5, 15, 16, 59, 0, 0, ny)))
+	req.False(s.IsActive(time.Date(2024, 5, 15, 17, 0, 0, 0, ny)))
+	req.False(s.IsActive(time.Date(2024, 5, 15, 8, 59, 0, 0, ny)))
+
+	// same instant expressed in UTC
+	req.True(s.IsActive(time.Date(2024, 5, 15, 14, 0, 0, 0, time.UTC)))
+
+	// Saturday
+	req.False(s.IsActive(time.Date(2024, 5, 18, 12, 0, 0, 0, ny)))
+}
+
+func TestIsActiveWrapsMidnight(t *testing.T) {
+	req := require.New(t)
+
+	s, err := Parse("", []string{"fri"}, []string{"22:00-02:00"}, nil, nil)
+	req.NoError(err)
+
+	req.True(s.IsActive(time.Date(2024, 5, 17, 23, 0, 0, 0, time.UTC)))  // friday night
+	req.True(s.IsActive(time.Date(2024, 5, 18, 1, 0, 0, 0, time.UTC)))   // early saturday, window opened friday
+	req.False(s.IsActive(time.Date(2024, 5, 18, 23, 0, 0, 0, time.UTC))) // saturday night
+	req.False(s.IsActive(time.Date(2024, 5, 17, 1, 0, 0, 0, time.UTC)))  // early friday, window opened thursday
+}
+
+func TestIsActiveBounds(t *testing.T) {
+	req := require.New(t)
+
+	notBefore := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	notAfter := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	s, err := Parse("", nil, nil, &notBefore, &notAfter)
+	req.NoError(err)
+	req.False(s.IsTimeBased())
+
+	req.False(s.IsActive(notBefore.Add(-time.Second)))
+	req.True(s.IsActive(notBefore))
+	req.True(s.IsActive(notAfter.Add(-time.Second)))
+	req.False(s.IsActive(notAfter))
+
+	_, err = Parse("", nil, nil, &notAfter, &notBefore)
+	req.Error(err)
+}
+
+func TestInvalidTimezone(t *testing.T) {
+	req := require.New(t)
+
+	_, err := Parse("Not/AZone", nil, nil, nil, nil)
+	req.Error(err)
+
+	s := &Schedule{Timezone: "Not/AZone"}
+	req.False(s.IsActive(time.Now()))
+}
@@ -0,0 +1,36 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package shaping
+
+// Service and identity tags carrying bandwidth limits. Rates are in bytes per second, bursts in bytes.
+const (
+	IngressBpsTag = "shaping.ingressBps"
+	EgressBpsTag  = "shaping.egressBps"
+	BurstBytesTag = "shaping.burstBytes"
+)
+
+// Circuit tags carrying bandwidth limits, which the controller fills in from the service and dialing identity tags
+// above. Service limits apply to each circuit separately, identity limits are shared by all circuits of the identity
+// on a router.
+const (
+	CircuitTagServiceIngressBps  = "shaping.service.ingressBps"
+	CircuitTagServiceEgressBps   = "shaping.service.egressBps"
+	CircuitTagServiceBurstBytes  = "shaping.service.burstBytes"
+	CircuitTagIdentityIngressBps = "shaping.identity.ingressBps"
+	CircuitTagIdentityEgressBps  = "shaping.identity.egressBps"
+	CircuitTagIdentityBurstBytes = "shaping.identity.burstBytes"
+)
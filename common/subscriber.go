@@ -23,6 +23,7 @@ import (
 	"github.com/openziti/foundation/v2/concurrenz"
 	"ztna-core/ztna/common/pb/edge_ctrl_pb"
 	cmap "github.com/orcaman/concurrent-map/v2"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"sync"
 )
 
@@ -117,13 +118,15 @@ func (self *IdentitySubscription) Diff(rdm *RouterDataModel, sink DiffSink) {
 		DataStateConfig{}, DataStateConfigType{},
 		Identity{}, DataStateIdentity{},
 		Service{}, DataStateService{},
-		ServicePolicy{}, DataStateServicePolicy{},
+		ServicePolicy{}, DataStateServicePolicy{}, edge_ctrl_pb.DataState_Schedule{}, timestamppb.Timestamp{},
 		PostureCheck{}, DataStatePostureCheck{}, edge_ctrl_pb.DataState_PostureCheck_Domains_{}, edge_ctrl_pb.DataState_PostureCheck_Domains{},
 		edge_ctrl_pb.DataState_PostureCheck_Mac_{}, edge_ctrl_pb.DataState_PostureCheck_Mac{},
 		edge_ctrl_pb.DataState_PostureCheck_Mfa_{}, edge_ctrl_pb.DataState_PostureCheck_Mfa{},
 		edge_ctrl_pb.DataState_PostureCheck_OsList_{}, edge_ctrl_pb.DataState_PostureCheck_OsList{}, edge_ctrl_pb.DataState_PostureCheck_Os{},
 		edge_ctrl_pb.DataState_PostureCheck_Process_{}, edge_ctrl_pb.DataState_PostureCheck_Process{},
 		edge_ctrl_pb.DataState_PostureCheck_ProcessMulti_{}, edge_ctrl_pb.DataState_PostureCheck_ProcessMulti{},
+		edge_ctrl_pb.DataState_PostureCheck_SourceIp_{}, edge_ctrl_pb.DataState_PostureCheck_SourceIp{},
+		edge_ctrl_pb.DataState_PostureCheck_Attestation_{}, edge_ctrl_pb.DataState_PostureCheck_Attestation{},
 	), adapter)
 }
 
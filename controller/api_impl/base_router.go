@@ -23,6 +23,7 @@ import (
 	"ztna-core/ztna/controller/apierror"
 	"ztna-core/ztna/controller/change"
 	"ztna-core/ztna/controller/fields"
+	"ztna-core/ztna/controller/internal/permissions"
 	"ztna-core/ztna/controller/models"
 	"ztna-core/ztna/controller/network"
 	"ztna-core/ztna/controller/rest_model"
@@ -57,6 +58,15 @@ func modelToApi[T models.Entity](network *network.Network, rc api.RequestContext
 	return apiEntities, nil
 }
 
+// applyGrantScope restricts the query to the entities which the request's scoped grants allow reading, if the
+// request context may carry scoped grants
+func applyGrantScope(rc api.RequestContext, store boltz.Store, query ast.Query) error {
+	if scopedRc, ok := rc.(permissions.ScopeFilterSource); ok {
+		return permissions.ApplyReadScope(scopedRc, store, query)
+	}
+	return nil
+}
+
 func ListWithHandler[T models.Entity](n *network.Network, rc api.RequestContext, lister models.EntityRetriever[T], mapper ModelToApiMapper[T]) {
 	ListWithQueryF(n, rc, lister, mapper, lister.BasePreparedList)
 }
@@ -84,6 +94,10 @@ func ListWithQueryFAndCollector[T models.Entity](n *network.Network, rc api.Requ
 			return nil, err
 		}
 
+		if err = applyGrantScope(rc, lister.GetListStore(), query); err != nil {
+			return nil, err
+		}
+
 		result, err := qf(query)
 		if err != nil {
 			return nil, err
@@ -21,6 +21,7 @@ import (
 	"github.com/openziti/storage/boltz"
 	"ztna-core/ztna/controller/api"
 	"ztna-core/ztna/controller/change"
+	"ztna-core/ztna/controller/internal/permissions"
 	"ztna-core/ztna/controller/network"
 	"ztna-core/ztna/controller/rest_model"
 	"ztna-core/ztna/controller/rest_server/operations"
@@ -44,15 +45,15 @@ func NewCircuitRouter() *CircuitRouter {
 
 func (r *CircuitRouter) Register(fabricApi *operations.ZitiFabricAPI, wrapper RequestWrapper) {
 	fabricApi.CircuitDetailCircuitHandler = circuit.DetailCircuitHandlerFunc(func(params circuit.DetailCircuitParams) middleware.Responder {
-		return wrapper.WrapRequest(r.Detail, params.HTTPRequest, params.ID, "")
+		return wrapper.WrapRequest(r.Detail, params.HTTPRequest, params.ID, "", permissions.CanRead(EntityNameCircuit))
 	})
 
 	fabricApi.CircuitListCircuitsHandler = circuit.ListCircuitsHandlerFunc(func(params circuit.ListCircuitsParams) middleware.Responder {
-		return wrapper.WrapRequest(r.ListCircuits, params.HTTPRequest, "", "")
+		return wrapper.WrapRequest(r.ListCircuits, params.HTTPRequest, "", "", permissions.CanRead(EntityNameCircuit))
 	})
 
 	fabricApi.CircuitDeleteCircuitHandler = circuit.DeleteCircuitHandlerFunc(func(params circuit.DeleteCircuitParams) middleware.Responder {
-		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Delete(n, rc, params) }, params.HTTPRequest, params.ID, "")
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Delete(n, rc, params) }, params.HTTPRequest, params.ID, "", permissions.CanDelete(EntityNameCircuit))
 	})
 }
 
@@ -22,6 +22,7 @@ import (
 	"ztna-core/ztna/controller/api"
 	"ztna-core/ztna/controller/change"
 	"ztna-core/ztna/controller/fields"
+	"ztna-core/ztna/controller/internal/permissions"
 	"ztna-core/ztna/controller/network"
 	"ztna-core/ztna/controller/rest_model"
 	"ztna-core/ztna/controller/rest_server/operations"
@@ -45,19 +46,19 @@ func NewLinkRouter() *LinkRouter {
 
 func (r *LinkRouter) Register(fabricApi *operations.ZitiFabricAPI, wrapper RequestWrapper) {
 	fabricApi.LinkDetailLinkHandler = link.DetailLinkHandlerFunc(func(params link.DetailLinkParams) middleware.Responder {
-		return wrapper.WrapRequest(r.Detail, params.HTTPRequest, params.ID, "")
+		return wrapper.WrapRequest(r.Detail, params.HTTPRequest, params.ID, "", permissions.CanRead(EntityNameLink))
 	})
 
 	fabricApi.LinkListLinksHandler = link.ListLinksHandlerFunc(func(params link.ListLinksParams) middleware.Responder {
-		return wrapper.WrapRequest(r.ListLinks, params.HTTPRequest, "", "")
+		return wrapper.WrapRequest(r.ListLinks, params.HTTPRequest, "", "", permissions.CanRead(EntityNameLink))
 	})
 
 	fabricApi.LinkPatchLinkHandler = link.PatchLinkHandlerFunc(func(params link.PatchLinkParams) middleware.Responder {
-		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Patch(n, rc, params) }, params.HTTPRequest, params.ID, "")
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Patch(n, rc, params) }, params.HTTPRequest, params.ID, "", permissions.CanUpdate(EntityNameLink))
 	})
 
 	fabricApi.LinkDeleteLinkHandler = link.DeleteLinkHandlerFunc(func(params link.DeleteLinkParams) middleware.Responder {
-		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Delete(n, rc) }, params.HTTPRequest, params.ID, "")
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Delete(n, rc) }, params.HTTPRequest, params.ID, "", permissions.CanDelete(EntityNameLink))
 	})
 }
 
@@ -20,6 +20,7 @@ import (
 	"github.com/go-openapi/runtime/middleware"
 	"ztna-core/ztna/controller/api"
 	"ztna-core/ztna/controller/fields"
+	"ztna-core/ztna/controller/internal/permissions"
 	"ztna-core/ztna/controller/model"
 	"ztna-core/ztna/controller/network"
 	"ztna-core/ztna/controller/rest_server/operations"
@@ -43,31 +44,31 @@ func NewServiceRouter() *ServiceRouter {
 
 func (r *ServiceRouter) Register(fabricApi *operations.ZitiFabricAPI, wrapper RequestWrapper) {
 	fabricApi.ServiceDeleteServiceHandler = service.DeleteServiceHandlerFunc(func(params service.DeleteServiceParams) middleware.Responder {
-		return wrapper.WrapRequest(r.Delete, params.HTTPRequest, params.ID, "")
+		return wrapper.WrapRequest(r.Delete, params.HTTPRequest, params.ID, "", permissions.CanDelete(EntityNameService))
 	})
 
 	fabricApi.ServiceDetailServiceHandler = service.DetailServiceHandlerFunc(func(params service.DetailServiceParams) middleware.Responder {
-		return wrapper.WrapRequest(r.Detail, params.HTTPRequest, params.ID, "")
+		return wrapper.WrapRequest(r.Detail, params.HTTPRequest, params.ID, "", permissions.CanRead(EntityNameService))
 	})
 
 	fabricApi.ServiceListServicesHandler = service.ListServicesHandlerFunc(func(params service.ListServicesParams) middleware.Responder {
-		return wrapper.WrapRequest(r.ListServices, params.HTTPRequest, "", "")
+		return wrapper.WrapRequest(r.ListServices, params.HTTPRequest, "", "", permissions.CanRead(EntityNameService))
 	})
 
 	fabricApi.ServiceUpdateServiceHandler = service.UpdateServiceHandlerFunc(func(params service.UpdateServiceParams) middleware.Responder {
-		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Update(n, rc, params) }, params.HTTPRequest, params.ID, "")
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Update(n, rc, params) }, params.HTTPRequest, params.ID, "", permissions.CanUpdate(EntityNameService))
 	})
 
 	fabricApi.ServiceCreateServiceHandler = service.CreateServiceHandlerFunc(func(params service.CreateServiceParams) middleware.Responder {
-		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Create(n, rc, params) }, params.HTTPRequest, "", "")
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Create(n, rc, params) }, params.HTTPRequest, "", "", permissions.CanCreate(EntityNameService))
 	})
 
 	fabricApi.ServicePatchServiceHandler = service.PatchServiceHandlerFunc(func(params service.PatchServiceParams) middleware.Responder {
-		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Patch(n, rc, params) }, params.HTTPRequest, params.ID, "")
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Patch(n, rc, params) }, params.HTTPRequest, params.ID, "", permissions.CanUpdate(EntityNameService))
 	})
 
 	fabricApi.ServiceListServiceTerminatorsHandler = service.ListServiceTerminatorsHandlerFunc(func(params service.ListServiceTerminatorsParams) middleware.Responder {
-		return wrapper.WrapRequest(r.listManagementTerminators, params.HTTPRequest, params.ID, "")
+		return wrapper.WrapRequest(r.listManagementTerminators, params.HTTPRequest, params.ID, "", permissions.CanRead(EntityNameService))
 	})
 }
 
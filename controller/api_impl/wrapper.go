@@ -3,6 +3,7 @@ package api_impl
 import (
 	"github.com/go-openapi/runtime/middleware"
 	"ztna-core/ztna/controller/api"
+	"ztna-core/ztna/controller/internal/permissions"
 	"ztna-core/ztna/controller/network"
 	"net/http"
 )
@@ -10,7 +11,8 @@ import (
 type RequestHandler func(network *network.Network, rc api.RequestContext)
 
 type RequestWrapper interface {
-	WrapRequest(handler RequestHandler, request *http.Request, entityId, entitySubId string) middleware.Responder
+	// WrapRequest wraps a request handler. If no permissions are given, the request requires admin access
+	WrapRequest(handler RequestHandler, request *http.Request, entityId, entitySubId string, permissions ...permissions.Resolver) middleware.Responder
 	WrapHttpHandler(handler http.Handler) http.Handler
 	WrapWsHandler(handler http.Handler) http.Handler
 }
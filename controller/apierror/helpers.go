@@ -407,3 +407,12 @@ func NewClusterHasNoLeaderError() *errorz.ApiError {
 		Status:  ClusterHasNoLeaderStatus,
 	}
 }
+
+func NewOutsidePolicySchedule(cause error) *errorz.ApiError {
+	return &errorz.ApiError{
+		Cause:   cause,
+		Code:    OutsidePolicyScheduleCode,
+		Message: OutsidePolicyScheduleMessage,
+		Status:  OutsidePolicyScheduleStatus,
+	}
+}
@@ -214,4 +214,8 @@ const (
 	ClusterHasNoLeaderCode    string = "CLUSTER_NO_LEADER"
 	ClusterHasNoLeaderMessage string = "Cluster has no leader, unable to make model updates."
 	ClusterHasNoLeaderStatus  int    = http.StatusServiceUnavailable
+
+	OutsidePolicyScheduleCode    string = "OUTSIDE_POLICY_SCHEDULE"
+	OutsidePolicyScheduleMessage string = "No service policy granting access is currently within its schedule"
+	OutsidePolicyScheduleStatus  int    = http.StatusConflict
 )
@@ -0,0 +1,423 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package audit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/openziti/storage/ast"
+	"github.com/openziti/storage/boltz"
+	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
+	"ztna-core/ztna/controller/change"
+	"ztna-core/ztna/controller/db"
+)
+
+const (
+	ChangeTypeCreated = "created"
+	ChangeTypeUpdated = "updated"
+	ChangeTypeDeleted = "deleted"
+
+	AuthMethodCert = "cert"
+	AuthMethodOidc = "oidc"
+
+	RedactedValue = "<redacted>"
+)
+
+// fields which change on every update and would only add noise to the diffs
+var ignoredFields = map[string]struct{}{
+	"updatedAt": {},
+}
+
+// sensitiveFields are matched case-insensitively against field names at any depth. Matching values are redacted
+var sensitiveFields = []string{"password", "salt", "secret", "token", "jwt", "privatekey"}
+
+// FieldChange holds the before and after value of a changed top level entity field
+type FieldChange struct {
+	Before any `json:"before"`
+	After  any `json:"after"`
+}
+
+// Auditor appends a record to the audit log for each entity change. It runs as an entity constraint, so records are
+// written in the same transaction as the changes they describe
+type Auditor struct {
+	config *Config
+	stores *db.Stores
+}
+
+func NewAuditor(config *Config, stores *db.Stores) *Auditor {
+	return &Auditor{
+		config: config,
+		stores: stores,
+	}
+}
+
+// Register adds the auditor to every store whose entity type isn't excluded
+func (self *Auditor) Register() {
+	for _, store := range self.stores.GetStores() {
+		entityType := store.GetEntityType()
+		if entityType == db.EntityTypeAuditRecords || self.config.IsExcluded(entityType) {
+			continue
+		}
+		store.AddUntypedEntityConstraint(self)
+	}
+}
+
+func (self *Auditor) ProcessPreCommit(state boltz.UntypedEntityChangeState) error {
+	if state.IsParentEvent() {
+		return nil
+	}
+
+	var changeType string
+	switch state.GetChangeType() {
+	case boltz.EntityCreated:
+		changeType = ChangeTypeCreated
+	case boltz.EntityUpdated:
+		changeType = ChangeTypeUpdated
+	case boltz.EntityDeleted:
+		changeType = ChangeTypeDeleted
+	default:
+		return nil
+	}
+
+	changes, err := Diff(state.GetInitialState(), state.GetFinalState())
+	if err != nil {
+		return errors.Wrapf(err, "unable to compute audit diff for %s %s", state.GetStore().GetEntityType(), state.GetEntityId())
+	}
+
+	if changeType == ChangeTypeUpdated && len(changes) == 0 {
+		return nil
+	}
+
+	changesJson, err := json.Marshal(changes)
+	if err != nil {
+		return err
+	}
+
+	record := &db.AuditRecord{
+		ChangeType: changeType,
+		EntityType: state.GetStore().GetEntityType(),
+		EntityId:   state.GetEntityId(),
+		Changes:    string(changesJson),
+	}
+
+	tx := state.GetCtx().Tx()
+	changeCtx := change.FromContext(state.GetCtx().Context())
+	self.fillFromChangeContext(tx, record, changeCtx)
+
+	// the change time is set when the command is dispatched, so records, and therefore hashes, match on every node.
+	// Only changes made outside of commands, which aren't replicated, fall back to the local clock
+	record.Timestamp = changeCtx.GetTimestamp()
+	if record.Timestamp.IsZero() {
+		record.Timestamp = time.Now().UTC()
+	}
+
+	return self.Append(state.GetCtx(), record)
+}
+
+func (self *Auditor) ProcessPostCommit(boltz.UntypedEntityChangeState) {}
+
+func (self *Auditor) fillFromChangeContext(tx *bbolt.Tx, record *db.AuditRecord, changeCtx *change.Context) {
+	if changeCtx == nil {
+		record.ActorType = change.AuthorTypeUnattributed
+		return
+	}
+
+	author := changeCtx.GetAuthor()
+	record.ActorType = author.Type
+	record.ActorId = author.Id
+	record.ActorName = author.Name
+
+	source := changeCtx.GetSource()
+	record.Api = source.Auth
+	record.SourceType = source.Type
+	record.Method = source.Method
+	record.RemoteAddr = source.RemoteAddr
+	record.LocalAddr = source.LocalAddr
+	record.TraceId = changeCtx.Attributes[change.TraceIdKey]
+	record.AuthMethod = self.getAuthMethod(tx, changeCtx)
+}
+
+func (self *Auditor) getAuthMethod(tx *bbolt.Tx, changeCtx *change.Context) string {
+	authenticatorId := changeCtx.Attributes[change.SourceAuthenticator]
+	if authenticatorId == AuthMethodOidc {
+		return AuthMethodOidc
+	}
+
+	if authenticatorId != "" {
+		if authenticator, found, err := self.stores.Authenticator.FindById(tx, authenticatorId); err == nil && found {
+			return authenticator.Type
+		}
+	}
+
+	switch changeCtx.Attributes[change.AuthorTypeKey] {
+	case change.AuthorTypeCert, change.AuthorTypeRouter:
+		return AuthMethodCert
+	}
+	return ""
+}
+
+// Append links the record to the end of the chain and stores it
+func (self *Auditor) Append(ctx boltz.MutateContext, record *db.AuditRecord) error {
+	tx := ctx.Tx()
+	sequence, prevHash := self.stores.AuditRecord.GetChainHead(tx)
+
+	record.Sequence = sequence + 1
+	record.Id = db.AuditRecordId(record.Sequence)
+	if record.Timestamp.IsZero() {
+		record.Timestamp = time.Now().UTC()
+	}
+	record.PrevHash = prevHash
+	record.Hash = Hash(record)
+
+	if err := self.stores.AuditRecord.Create(ctx, record); err != nil {
+		return errors.Wrap(err, "unable to write audit record")
+	}
+
+	return self.stores.AuditRecord.SetChainHead(tx, record.Sequence, record.Hash)
+}
+
+// VerifyResult describes the outcome of walking the audit chain
+type VerifyResult struct {
+	Valid          bool   `json:"valid"`
+	RecordsChecked int64  `json:"recordsChecked"`
+	FirstSequence  int64  `json:"firstSequence"`
+	LastSequence   int64  `json:"lastSequence"`
+	BrokenAt       string `json:"brokenAt,omitempty"`
+	Reason         string `json:"reason,omitempty"`
+}
+
+// Verify walks the stored chain in sequence order, checking each record's hash and its link to the previous record.
+// The oldest remaining record is trusted as the start of the chain, since older records may have been pruned
+func Verify(tx *bbolt.Tx, store db.AuditRecordStore) (*VerifyResult, error) {
+	result := &VerifyResult{Valid: true}
+
+	var prev *db.AuditRecord
+	for cursor := store.IterateIds(tx, ast.BoolNodeTrue); cursor.IsValid(); cursor.Next() {
+		record, err := store.LoadById(tx, string(cursor.Current()))
+		if err != nil {
+			return nil, err
+		}
+
+		if reason := checkLink(prev, record); reason != "" {
+			result.Valid = false
+			result.BrokenAt = record.Id
+			result.Reason = reason
+			return result, nil
+		}
+
+		if prev == nil {
+			result.FirstSequence = record.Sequence
+		}
+		result.LastSequence = record.Sequence
+		result.RecordsChecked++
+		prev = record
+	}
+
+	headSequence, headHash := store.GetChainHead(tx)
+	if prev != nil && (headSequence != prev.Sequence || headHash != prev.Hash) {
+		result.Valid = false
+		result.BrokenAt = db.AuditRecordId(headSequence)
+		result.Reason = "chain head does not match the last record, records have been removed from the end of the chain"
+	}
+
+	return result, nil
+}
+
+// VerifyRecords checks that the given records, ordered by sequence, form an unbroken chain. It returns the id of the
+// first record which fails the check, along with the reason, or empty strings if the chain is intact
+func VerifyRecords(records []*db.AuditRecord) (string, string) {
+	var prev *db.AuditRecord
+	for _, record := range records {
+		if reason := checkLink(prev, record); reason != "" {
+			return record.Id, reason
+		}
+		prev = record
+	}
+	return "", ""
+}
+
+func checkLink(prev, record *db.AuditRecord) string {
+	if record.Id != db.AuditRecordId(record.Sequence) {
+		return "record id does not match sequence"
+	}
+	if hash := Hash(record); hash != record.Hash {
+		return "record hash mismatch, record has been modified"
+	}
+	if prev != nil {
+		if record.Sequence != prev.Sequence+1 {
+			return fmt.Sprintf("sequence gap, expected %d, found %d", prev.Sequence+1, record.Sequence)
+		}
+		if record.PrevHash != prev.Hash {
+			return "previous hash mismatch, chain has been altered"
+		}
+	}
+	return ""
+}
+
+// hashInput defines the fields covered by a record's hash. Field order is fixed by the struct, so the encoding is stable
+type hashInput struct {
+	Sequence   int64  `json:"sequence"`
+	Timestamp  string `json:"timestamp"`
+	ChangeType string `json:"changeType"`
+	EntityType string `json:"entityType"`
+	EntityId   string `json:"entityId"`
+	ActorType  string `json:"actorType"`
+	ActorId    string `json:"actorId"`
+	ActorName  string `json:"actorName"`
+	AuthMethod string `json:"authMethod"`
+	Api        string `json:"api"`
+	SourceType string `json:"sourceType"`
+	Method     string `json:"method"`
+	RemoteAddr string `json:"remoteAddr"`
+	LocalAddr  string `json:"localAddr"`
+	TraceId    string `json:"traceId"`
+	Changes    string `json:"changes"`
+	PrevHash   string `json:"prevHash"`
+}
+
+// Hash returns the hex encoded SHA-256 hash of the record contents, including the hash of the previous record
+func Hash(record *db.AuditRecord) string {
+	input := &hashInput{
+		Sequence:   record.Sequence,
+		Timestamp:  record.Timestamp.UTC().Format(time.RFC3339Nano),
+		ChangeType: record.ChangeType,
+		EntityType: record.EntityType,
+		EntityId:   record.EntityId,
+		ActorType:  record.ActorType,
+		ActorId:    record.ActorId,
+		ActorName:  record.ActorName,
+		AuthMethod: record.AuthMethod,
+		Api:        record.Api,
+		SourceType: record.SourceType,
+		Method:     record.Method,
+		RemoteAddr: record.RemoteAddr,
+		LocalAddr:  record.LocalAddr,
+		TraceId:    record.TraceId,
+		Changes:    record.Changes,
+		PrevHash:   record.PrevHash,
+	}
+
+	// marshalling a struct of strings and ints can't fail
+	encoded, _ := json.Marshal(input)
+	sum := sha256.Sum256(encoded)
+	return hex.EncodeToString(sum[:])
+}
+
+// Diff returns the top level fields which differ between the two entity states. Either state may be nil, in which
+// case every field of the other state is reported. Sensitive values are redacted
+func Diff(before, after any) (map[string]*FieldChange, error) {
+	beforeFields, err := toFieldMap(before)
+	if err != nil {
+		return nil, err
+	}
+
+	afterFields, err := toFieldMap(after)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]*FieldChange{}
+	for k, v := range beforeFields {
+		if _, ignored := ignoredFields[k]; ignored {
+			continue
+		}
+		if afterV, found := afterFields[k]; !found || !reflect.DeepEqual(v, afterV) {
+			result[k] = &FieldChange{
+				Before: redact(k, v),
+				After:  redact(k, afterV),
+			}
+		}
+	}
+
+	for k, v := range afterFields {
+		if _, ignored := ignoredFields[k]; ignored {
+			continue
+		}
+		if _, found := beforeFields[k]; !found {
+			result[k] = &FieldChange{
+				After: redact(k, v),
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func toFieldMap(entity any) (map[string]any, error) {
+	if entity == nil {
+		return nil, nil
+	}
+
+	if v := reflect.ValueOf(entity); v.Kind() == reflect.Pointer && v.IsNil() {
+		return nil, nil
+	}
+
+	encoded, err := json.Marshal(entity)
+	if err != nil {
+		return nil, err
+	}
+
+	var result map[string]any
+	if err = json.Unmarshal(encoded, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func isSensitive(field string) bool {
+	field = strings.ToLower(field)
+	for _, sensitive := range sensitiveFields {
+		if strings.Contains(field, sensitive) {
+			return true
+		}
+	}
+	return false
+}
+
+func redact(field string, value any) any {
+	if value == nil {
+		return nil
+	}
+
+	if isSensitive(field) {
+		return RedactedValue
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		result := make(map[string]any, len(v))
+		for k, child := range v {
+			result[k] = redact(k, child)
+		}
+		return result
+	case []any:
+		result := make([]any, len(v))
+		for i, child := range v {
+			result[i] = redact("", child)
+		}
+		return result
+	}
+
+	return value
+}
@@ -0,0 +1,129 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package audit
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/openziti/storage/boltz"
+	"github.com/stretchr/testify/require"
+	"ztna-core/ztna/controller/db"
+)
+
+func TestDiff(t *testing.T) {
+	req := require.New(t)
+
+	before := &db.Authenticator{
+		BaseExtEntity: boltz.BaseExtEntity{Id: "a1", UpdatedAt: time.Now()},
+		Type:          "updb",
+		IdentityId:    "i1",
+	}
+	before.SubType = &db.AuthenticatorUpdb{Username: "admin", Password: "old", Salt: "s1"}
+
+	after := &db.Authenticator{
+		BaseExtEntity: boltz.BaseExtEntity{Id: "a1", UpdatedAt: time.Now().Add(time.Second)},
+		Type:          "updb",
+		IdentityId:    "i2",
+	}
+	after.SubType = &db.AuthenticatorUpdb{Username: "admin", Password: "new", Salt: "s2"}
+
+	changes, err := Diff(before, after)
+	req.NoError(err)
+	req.Len(changes, 2)
+
+	req.Equal("i1", changes["identityId"].Before)
+	req.Equal("i2", changes["identityId"].After)
+
+	subType, ok := changes["subType"].After.(map[string]any)
+	req.True(ok)
+	req.Equal("admin", subType["username"])
+	req.Equal(RedactedValue, subType["password"])
+	req.Equal(RedactedValue, subType["salt"])
+
+	var nilAuthenticator *db.Authenticator
+	changes, err = Diff(nilAuthenticator, after)
+	req.NoError(err)
+	req.Nil(changes["id"].Before)
+	req.Equal("a1", changes["id"].After)
+	req.NotContains(changes, "updatedAt")
+}
+
+func newTestChain(count int) []*db.AuditRecord {
+	var result []*db.AuditRecord
+	prevHash := ""
+	for i := 1; i <= count; i++ {
+		record := &db.AuditRecord{
+			Sequence:   int64(i),
+			Timestamp:  time.Now().UTC(),
+			ChangeType: ChangeTypeUpdated,
+			EntityType: db.EntityTypeIdentities,
+			EntityId:   "i1",
+			ActorType:  "identity",
+			ActorId:    "admin",
+			Changes:    `{"name":{"before":"a","after":"b"}}`,
+			PrevHash:   prevHash,
+		}
+		record.Id = db.AuditRecordId(record.Sequence)
+		record.Hash = Hash(record)
+		prevHash = record.Hash
+		result = append(result, record)
+	}
+	return result
+}
+
+func TestVerifyRecords(t *testing.T) {
+	req := require.New(t)
+
+	records := newTestChain(5)
+	id, reason := VerifyRecords(records)
+	req.Equal("", id, reason)
+
+	// a pruned prefix still verifies, as the oldest remaining record anchors the chain
+	id, _ = VerifyRecords(records[2:])
+	req.Equal("", id)
+
+	records[2].ActorId = "someone-else"
+	id, reason = VerifyRecords(records)
+	req.Equal(records[2].Id, id)
+	req.Contains(reason, "modified")
+
+	records = newTestChain(5)
+	records[2].Changes = `{}`
+	records[2].Hash = Hash(records[2])
+	id, reason = VerifyRecords(records)
+	req.Equal(records[3].Id, id)
+	req.Contains(reason, "previous hash")
+
+	records = newTestChain(5)
+	id, reason = VerifyRecords(append(records[:2:2], records[3:]...))
+	req.Equal(records[3].Id, id)
+	req.Contains(reason, "sequence gap")
+}
+
+func TestHashStable(t *testing.T) {
+	req := require.New(t)
+
+	record := newTestChain(1)[0]
+	encoded, err := json.Marshal(record)
+	req.NoError(err)
+
+	decoded := &db.AuditRecord{}
+	req.NoError(json.Unmarshal(encoded, decoded))
+	req.Equal(record.Hash, Hash(decoded))
+}
@@ -0,0 +1,112 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package audit
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	DefaultPruneInterval = time.Hour
+)
+
+// DefaultExcludedEntityTypes are high volume entity types which are created and removed by clients as part of normal
+// operation, rather than by administrators
+var DefaultExcludedEntityTypes = []string{
+	"apiSessions",
+	"apiSessionCertificates",
+	"sessions",
+	"eventualEvents",
+}
+
+// Config controls the audit log of entity changes
+type Config struct {
+	// Enabled - if false, no audit records are written
+	Enabled bool
+
+	// Exclude - entity types whose changes are not recorded
+	Exclude []string
+
+	// MaxAge - how long audit records are kept. If zero, records are kept forever
+	MaxAge time.Duration
+
+	// PruneInterval - how often records older than MaxAge are removed
+	PruneInterval time.Duration
+}
+
+func (self *Config) SetDefaults() {
+	self.Enabled = true
+	self.Exclude = append([]string(nil), DefaultExcludedEntityTypes...)
+	self.MaxAge = 0
+	self.PruneInterval = DefaultPruneInterval
+}
+
+func (self *Config) IsExcluded(entityType string) bool {
+	for _, excluded := range self.Exclude {
+		if excluded == entityType {
+			return true
+		}
+	}
+	return false
+}
+
+func LoadConfig(cfg *Config, cfgmap map[interface{}]interface{}) error {
+	if value, found := cfgmap["enabled"]; found {
+		cfg.Enabled = strings.EqualFold("true", fmt.Sprintf("%v", value))
+	}
+
+	if value, found := cfgmap["exclude"]; found {
+		list, ok := value.([]interface{})
+		if !ok {
+			return errors.Errorf("invalid type for audit.exclude, should be a list of entity types instead of %T", value)
+		}
+		cfg.Exclude = nil
+		for _, v := range list {
+			entityType, ok := v.(string)
+			if !ok || entityType == "" {
+				return errors.Errorf("invalid value %v in audit.exclude, must be an entity type", v)
+			}
+			cfg.Exclude = append(cfg.Exclude, entityType)
+		}
+	}
+
+	if value, found := cfgmap["maxAge"]; found {
+		var err error
+		if cfg.MaxAge, err = time.ParseDuration(fmt.Sprintf("%v", value)); err != nil {
+			return errors.Wrapf(err, "invalid value %v for audit.maxAge", value)
+		}
+		if cfg.MaxAge < 0 {
+			return errors.Errorf("invalid value %v for audit.maxAge, must not be negative", value)
+		}
+	}
+
+	if value, found := cfgmap["pruneInterval"]; found {
+		var err error
+		if cfg.PruneInterval, err = time.ParseDuration(fmt.Sprintf("%v", value)); err != nil {
+			return errors.Wrapf(err, "invalid value %v for audit.pruneInterval", value)
+		}
+		if cfg.PruneInterval < time.Minute {
+			return errors.Errorf("invalid value %v for audit.pruneInterval, must be at least %v", value, time.Minute)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,344 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package carevocation
+
+import (
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/pkg/errors"
+	"ztna-core/ztna/controller/event"
+)
+
+const InspectTarget = "ca-revocation"
+
+// CA identifies the CA which issued a client certificate
+type CA struct {
+	Id   string
+	Name string
+}
+
+// RevokedError is returned by Checker.Check if the client certificate has been revoked
+type RevokedError struct {
+	Serial string
+	Source string
+}
+
+func (self *RevokedError) Error() string {
+	return fmt.Sprintf("certificate with serial %v has been revoked according to %v", self.Serial, self.Source)
+}
+
+// Checker checks client certificates issued by third party CAs against the CRLs and OCSP responders of those CAs.
+// CRLs are downloaded the first time they're needed, then cached and refreshed in the background
+type Checker struct {
+	config      *Config
+	handler     event.CaRevocationEventHandler
+	client      *http.Client
+	closeNotify <-chan struct{}
+
+	lock  sync.Mutex
+	crls  map[string]*crlEntry
+	ocsp  map[string]*ocspEntry
+	cas   map[string]*caState
+	clock func() time.Time
+}
+
+type caState struct {
+	ca            CA
+	lastOcspCheck time.Time
+	lastOcspError string
+}
+
+func NewChecker(config *Config, handler event.CaRevocationEventHandler, closeNotify <-chan struct{}) *Checker {
+	result := &Checker{
+		config:      config,
+		handler:     handler,
+		client:      &http.Client{Timeout: config.Timeout},
+		closeNotify: closeNotify,
+		crls:        map[string]*crlEntry{},
+		ocsp:        map[string]*ocspEntry{},
+		cas:         map[string]*caState{},
+		clock:       time.Now,
+	}
+
+	if config.Enabled {
+		go result.run()
+	}
+
+	return result
+}
+
+func (self *Checker) IsEnabled() bool {
+	return self.config.Enabled
+}
+
+// Check returns an error if the leaf certificate of the verified chain has been revoked or, if the checker is
+// configured to fail closed, if its revocation status can't be determined. chain[0] must be the client certificate
+// and chain[1] its issuer
+func (self *Checker) Check(ca CA, chain []*x509.Certificate) error {
+	if !self.config.Enabled || len(chain) < 2 {
+		return nil
+	}
+
+	cert := chain[0]
+	issuer := chain[1]
+	self.getCaState(ca)
+
+	determined := false
+
+	for _, url := range self.crlUrls(ca, cert) {
+		entry := self.getCrlEntry(ca, url, issuer)
+		known, revoked := entry.isRevoked(cert, self.clock())
+		if revoked {
+			self.notifyRevoked(ca, url, cert)
+			return &RevokedError{Serial: cert.SerialNumber.String(), Source: "CRL " + url}
+		}
+		determined = determined || known
+	}
+
+	if self.config.OcspEnabled && len(cert.OCSPServer) > 0 {
+		revoked, url, err := self.checkOcsp(ca, cert, issuer)
+		if err == nil && revoked {
+			self.notifyRevoked(ca, url, cert)
+			return &RevokedError{Serial: cert.SerialNumber.String(), Source: "OCSP responder " + url}
+		}
+		determined = determined || err == nil
+	}
+
+	if !determined && self.config.FailClosed {
+		return errors.Errorf("revocation status of certificate with serial %v from CA %v could not be determined",
+			cert.SerialNumber, ca.Name)
+	}
+
+	return nil
+}
+
+// crlUrls returns the configured CRL url for the CA, if there is one, otherwise the http(s) CRL distribution points
+// in the certificate
+func (self *Checker) crlUrls(ca CA, cert *x509.Certificate) []string {
+	if url, ok := self.config.CrlUrls[ca.Id]; ok {
+		return []string{url}
+	}
+	if url, ok := self.config.CrlUrls[ca.Name]; ok {
+		return []string{url}
+	}
+
+	var result []string
+	for _, url := range cert.CRLDistributionPoints {
+		if strings.HasPrefix(url, "http://") || strings.HasPrefix(url, "https://") {
+			result = append(result, url)
+		}
+	}
+	return result
+}
+
+func (self *Checker) getCaState(ca CA) *caState {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	state, ok := self.cas[ca.Id]
+	if !ok {
+		state = &caState{}
+		self.cas[ca.Id] = state
+	}
+	state.ca = ca
+	return state
+}
+
+func (self *Checker) getCrlEntry(ca CA, url string, issuer *x509.Certificate) *crlEntry {
+	key := ca.Id + "|" + url
+
+	self.lock.Lock()
+	entry, ok := self.crls[key]
+	if !ok {
+		entry = &crlEntry{
+			ca:     ca,
+			url:    url,
+			issuer: issuer,
+		}
+		self.crls[key] = entry
+	}
+	self.lock.Unlock()
+
+	if entry.needsRefresh(self.clock(), self.config.RefreshInterval) {
+		self.refreshCrl(entry)
+	}
+
+	return entry
+}
+
+func (self *Checker) run() {
+	ticker := time.NewTicker(MinRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			self.refreshStale()
+		case <-self.closeNotify:
+			return
+		}
+	}
+}
+
+func (self *Checker) refreshStale() {
+	now := self.clock()
+
+	self.lock.Lock()
+	var stale []*crlEntry
+	for _, entry := range self.crls {
+		if entry.needsRefresh(now, self.config.RefreshInterval) {
+			stale = append(stale, entry)
+		}
+	}
+
+	for key, entry := range self.ocsp {
+		if !entry.valid(now) {
+			delete(self.ocsp, key)
+		}
+	}
+	self.lock.Unlock()
+
+	for _, entry := range stale {
+		self.refreshCrl(entry)
+	}
+}
+
+func (self *Checker) refreshCrl(entry *crlEntry) {
+	entry.loadLock.Lock()
+	defer entry.loadLock.Unlock()
+
+	// another goroutine may have refreshed it while we were waiting for the lock
+	now := self.clock()
+	if !entry.needsRefresh(now, self.config.RefreshInterval) {
+		return
+	}
+
+	log := pfxlog.Logger().WithField("caId", entry.ca.Id).WithField("url", entry.url)
+
+	revokedCount, err := entry.refresh(self.client, self.config.MaxCrlSize, now)
+	if err != nil {
+		log.WithError(err).Error("failed to refresh CRL")
+		evt := event.NewCaRevocationEvent(event.CaRevocationCrlRefreshFailed, entry.ca.Id, entry.ca.Name)
+		evt.Url = entry.url
+		evt.Error = err.Error()
+		self.dispatch(evt)
+		return
+	}
+
+	log.WithField("revokedCount", revokedCount).Debug("refreshed CRL")
+	evt := event.NewCaRevocationEvent(event.CaRevocationCrlRefreshed, entry.ca.Id, entry.ca.Name)
+	evt.Url = entry.url
+	evt.RevokedCount = revokedCount
+	self.dispatch(evt)
+}
+
+func (self *Checker) notifyRevoked(ca CA, url string, cert *x509.Certificate) {
+	pfxlog.Logger().WithField("caId", ca.Id).WithField("url", url).WithField("serial", cert.SerialNumber.String()).
+		Warn("rejecting revoked client certificate")
+	evt := event.NewCaRevocationEvent(event.CaRevocationCertRevoked, ca.Id, ca.Name)
+	evt.Url = url
+	evt.Serial = cert.SerialNumber.String()
+	self.dispatch(evt)
+}
+
+func (self *Checker) dispatch(evt *event.CaRevocationEvent) {
+	if self.handler != nil {
+		self.handler.AcceptCaRevocationEvent(evt)
+	}
+}
+
+// CaStatus is the revocation status of a CA, as reported by the ca-revocation inspection
+type CaStatus struct {
+	CaId          string       `json:"caId"`
+	CaName        string       `json:"caName"`
+	Crls          []*CrlStatus `json:"crls"`
+	LastOcspCheck *time.Time   `json:"lastOcspCheck,omitempty"`
+	LastOcspError string       `json:"lastOcspError,omitempty"`
+}
+
+type CrlStatus struct {
+	Url          string     `json:"url"`
+	ThisUpdate   *time.Time `json:"thisUpdate,omitempty"`
+	NextUpdate   *time.Time `json:"nextUpdate,omitempty"`
+	FetchedAt    *time.Time `json:"fetchedAt,omitempty"`
+	RevokedCount int        `json:"revokedCount"`
+	LastError    string     `json:"lastError,omitempty"`
+	LastErrorAt  *time.Time `json:"lastErrorAt,omitempty"`
+}
+
+// Status returns the revocation status of every CA which has been used to authenticate, ordered by CA name
+func (self *Checker) Status() []*CaStatus {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	statusMap := map[string]*CaStatus{}
+	for caId, state := range self.cas {
+		status := &CaStatus{
+			CaId:          caId,
+			CaName:        state.ca.Name,
+			Crls:          []*CrlStatus{},
+			LastOcspError: state.lastOcspError,
+		}
+		if !state.lastOcspCheck.IsZero() {
+			lastCheck := state.lastOcspCheck
+			status.LastOcspCheck = &lastCheck
+		}
+		statusMap[caId] = status
+	}
+
+	for _, entry := range self.crls {
+		if status, ok := statusMap[entry.ca.Id]; ok {
+			status.Crls = append(status.Crls, entry.status())
+		}
+	}
+
+	var result []*CaStatus
+	for _, status := range statusMap {
+		sort.Slice(status.Crls, func(i, j int) bool {
+			return status.Crls[i].Url < status.Crls[j].Url
+		})
+		result = append(result, status)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].CaName < result[j].CaName
+	})
+
+	return result
+}
+
+// Inspect implements the ca-revocation inspection target
+func (self *Checker) Inspect(val string) (bool, *string, error) {
+	if val != InspectTarget {
+		return false, nil, nil
+	}
+
+	js, err := json.Marshal(self.Status())
+	if err != nil {
+		return true, nil, err
+	}
+	result := string(js)
+	return true, &result, nil
+}
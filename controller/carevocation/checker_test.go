@@ -0,0 +1,323 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package carevocation
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ocsp"
+	"ztna-core/ztna/controller/event"
+)
+
+type testCa struct {
+	cert *x509.Certificate
+	key  crypto.Signer
+}
+
+func newTestCa(t *testing.T) *testCa {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return &testCa{cert: cert, key: key}
+}
+
+func (self *testCa) issue(t *testing.T, serial int64, crlUrl, ocspUrl string) *x509.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "client"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	if crlUrl != "" {
+		template.CRLDistributionPoints = []string{crlUrl}
+	}
+	if ocspUrl != "" {
+		template.OCSPServer = []string{ocspUrl}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, self.cert, key.Public(), self.key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert
+}
+
+func (self *testCa) crl(t *testing.T, serials ...int64) []byte {
+	template := &x509.RevocationList{
+		Number:     big.NewInt(time.Now().UnixNano()),
+		ThisUpdate: time.Now().Add(-time.Minute),
+		NextUpdate: time.Now().Add(time.Hour),
+	}
+	for _, serial := range serials {
+		template.RevokedCertificateEntries = append(template.RevokedCertificateEntries, x509.RevocationListEntry{
+			SerialNumber:   big.NewInt(serial),
+			RevocationTime: time.Now().Add(-time.Minute),
+		})
+	}
+	der, err := x509.CreateRevocationList(rand.Reader, template, self.cert, self.key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: der})
+}
+
+func newTestConfig() *Config {
+	config := &Config{}
+	config.SetDefaults()
+	config.Enabled = true
+	config.Timeout = time.Second
+	return config
+}
+
+func newTestChecker(config *Config, handler event.CaRevocationEventHandler) *Checker {
+	// skip NewChecker so no background refresh runs during tests
+	return &Checker{
+		config:  config,
+		handler: handler,
+		client:  &http.Client{Timeout: config.Timeout},
+		crls:    map[string]*crlEntry{},
+		ocsp:    map[string]*ocspEntry{},
+		cas:     map[string]*caState{},
+		clock:   time.Now,
+	}
+}
+
+type eventCollector struct {
+	events []*event.CaRevocationEvent
+}
+
+func (self *eventCollector) AcceptCaRevocationEvent(evt *event.CaRevocationEvent) {
+	self.events = append(self.events, evt)
+}
+
+func (self *eventCollector) types() []event.CaRevocationEventType {
+	var result []event.CaRevocationEventType
+	for _, evt := range self.events {
+		result = append(result, evt.EventType)
+	}
+	return result
+}
+
+func TestLoadConfig(t *testing.T) {
+	req := require.New(t)
+
+	config := &Config{}
+	config.SetDefaults()
+	req.NoError(LoadConfig(config, map[interface{}]interface{}{
+		"enabled":         true,
+		"refreshInterval": "30m",
+		"ocsp":            true,
+		"failClosed":      true,
+		"crlUrls": map[interface{}]interface{}{
+			"corp-ca": "http://pki.example.com/corp.crl",
+		},
+	}))
+	req.True(config.Enabled)
+	req.True(config.OcspEnabled)
+	req.True(config.FailClosed)
+	req.Equal(30*time.Minute, config.RefreshInterval)
+	req.Equal(DefaultTimeout, config.Timeout)
+	req.Equal("http://pki.example.com/corp.crl", config.CrlUrls["corp-ca"])
+
+	req.Error(LoadConfig(config, map[interface{}]interface{}{"refreshInterval": "1s"}))
+	req.Error(LoadConfig(config, map[interface{}]interface{}{"crlUrls": "http://pki.example.com/corp.crl"}))
+}
+
+func TestCheckCrl(t *testing.T) {
+	req := require.New(t)
+
+	ca := newTestCa(t)
+	var crl atomic.Value
+	crl.Store(ca.crl(t, 2))
+	var downloads atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		downloads.Add(1)
+		_, _ = w.Write(crl.Load().([]byte))
+	}))
+	defer server.Close()
+
+	events := &eventCollector{}
+	checker := newTestChecker(newTestConfig(), events)
+	testCa := CA{Id: "ca1", Name: "corp"}
+
+	good := ca.issue(t, 1, server.URL, "")
+	revoked := ca.issue(t, 2, server.URL, "")
+
+	req.NoError(checker.Check(testCa, []*x509.Certificate{good, ca.cert}))
+
+	err := checker.Check(testCa, []*x509.Certificate{revoked, ca.cert})
+	req.IsType(&RevokedError{}, err)
+	req.Equal(int32(1), downloads.Load())
+
+	// revoke the good cert and force a refresh
+	crl.Store(ca.crl(t, 1, 2))
+	checker.clock = func() time.Time { return time.Now().Add(2 * time.Hour) }
+	checker.refreshStale()
+	req.Equal(int32(2), downloads.Load())
+	checker.clock = time.Now
+
+	req.Error(checker.Check(testCa, []*x509.Certificate{good, ca.cert}))
+	req.Equal([]event.CaRevocationEventType{
+		event.CaRevocationCrlRefreshed,
+		event.CaRevocationCertRevoked,
+		event.CaRevocationCrlRefreshed,
+		event.CaRevocationCertRevoked,
+	}, events.types())
+
+	status := checker.Status()
+	req.Len(status, 1)
+	req.Equal("corp", status[0].CaName)
+	req.Len(status[0].Crls, 1)
+	req.Equal(2, status[0].Crls[0].RevokedCount)
+	req.NotNil(status[0].Crls[0].NextUpdate)
+
+	handled, result, err := checker.Inspect(InspectTarget)
+	req.True(handled)
+	req.NoError(err)
+	var inspected []*CaStatus
+	req.NoError(json.Unmarshal([]byte(*result), &inspected))
+	req.Equal("ca1", inspected[0].CaId)
+}
+
+func TestCheckCrlFailures(t *testing.T) {
+	req := require.New(t)
+
+	ca := newTestCa(t)
+	otherCa := newTestCa(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// signed by the wrong CA
+		_, _ = w.Write(otherCa.crl(t, 1))
+	}))
+	defer server.Close()
+
+	events := &eventCollector{}
+	config := newTestConfig()
+	checker := newTestChecker(config, events)
+	testCa := CA{Id: "ca1", Name: "corp"}
+	cert := ca.issue(t, 1, server.URL, "")
+
+	// fail open by default
+	req.NoError(checker.Check(testCa, []*x509.Certificate{cert, ca.cert}))
+	req.Equal([]event.CaRevocationEventType{event.CaRevocationCrlRefreshFailed}, events.types())
+	req.Contains(checker.Status()[0].Crls[0].LastError, "not signed by issuer")
+
+	config.FailClosed = true
+	req.ErrorContains(checker.Check(testCa, []*x509.Certificate{cert, ca.cert}), "could not be determined")
+
+	// failed downloads aren't retried on every authentication
+	req.Len(events.events, 1)
+}
+
+func TestCheckConfiguredCrlUrl(t *testing.T) {
+	req := require.New(t)
+
+	ca := newTestCa(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(ca.crl(t, 1))
+	}))
+	defer server.Close()
+
+	config := newTestConfig()
+	config.CrlUrls["corp"] = server.URL
+	checker := newTestChecker(config, nil)
+
+	// no distribution point in the cert, so the configured url is used
+	cert := ca.issue(t, 1, "", "")
+	req.Error(checker.Check(CA{Id: "ca1", Name: "corp"}, []*x509.Certificate{cert, ca.cert}))
+	req.NoError(checker.Check(CA{Id: "ca2", Name: "other"}, []*x509.Certificate{cert, ca.cert}))
+}
+
+func TestCheckOcsp(t *testing.T) {
+	req := require.New(t)
+
+	ca := newTestCa(t)
+	var queries atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries.Add(1)
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		ocspReq, err := ocsp.ParseRequest(body)
+		require.NoError(t, err)
+
+		status := ocsp.Good
+		if ocspReq.SerialNumber.Int64() == 2 {
+			status = ocsp.Revoked
+		}
+
+		resp, err := ocsp.CreateResponse(ca.cert, ca.cert, ocsp.Response{
+			Status:       status,
+			SerialNumber: ocspReq.SerialNumber,
+			ThisUpdate:   time.Now().Add(-time.Minute),
+			NextUpdate:   time.Now().Add(time.Hour),
+			RevokedAt:    time.Now().Add(-time.Minute),
+		}, ca.key)
+		require.NoError(t, err)
+		_, _ = w.Write(resp)
+	}))
+	defer server.Close()
+
+	config := newTestConfig()
+	config.OcspEnabled = true
+	config.FailClosed = true
+	checker := newTestChecker(config, nil)
+	testCa := CA{Id: "ca1", Name: "corp"}
+
+	good := ca.issue(t, 1, "", server.URL)
+	revoked := ca.issue(t, 2, "", server.URL)
+
+	req.NoError(checker.Check(testCa, []*x509.Certificate{good, ca.cert}))
+	req.NoError(checker.Check(testCa, []*x509.Certificate{good, ca.cert}))
+	req.IsType(&RevokedError{}, checker.Check(testCa, []*x509.Certificate{revoked, ca.cert}))
+	req.Equal(int32(2), queries.Load())
+	req.NotNil(checker.Status()[0].LastOcspCheck)
+}
@@ -0,0 +1,124 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package carevocation
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	DefaultRefreshInterval = time.Hour
+	DefaultTimeout         = 10 * time.Second
+	DefaultMaxCrlSize      = 16 * 1024 * 1024
+
+	MinRefreshInterval = time.Minute
+)
+
+// Config controls revocation checking of client certificates issued by third party CAs
+type Config struct {
+	// Enabled - if false, no revocation checks are done
+	Enabled bool
+
+	// RefreshInterval - how often CRLs are re-fetched. CRLs are also re-fetched once their next update time passes
+	RefreshInterval time.Duration
+
+	// Timeout - the timeout for CRL downloads and OCSP requests
+	Timeout time.Duration
+
+	// OcspEnabled - if true, the OCSP responders listed in client certificates are queried
+	OcspEnabled bool
+
+	// FailClosed - if true, certificates are rejected if their revocation status can't be determined, because no
+	// CRL could be loaded and no OCSP responder gave an answer
+	FailClosed bool
+
+	// CrlUrls - CRL urls by CA id or name. If a CA has a configured url, it is used instead of the CRL
+	// distribution points in the client certificates
+	CrlUrls map[string]string
+
+	// MaxCrlSize - the largest CRL, in bytes, which will be downloaded
+	MaxCrlSize int64
+}
+
+func (self *Config) SetDefaults() {
+	self.Enabled = false
+	self.RefreshInterval = DefaultRefreshInterval
+	self.Timeout = DefaultTimeout
+	self.OcspEnabled = false
+	self.FailClosed = false
+	self.CrlUrls = map[string]string{}
+	self.MaxCrlSize = DefaultMaxCrlSize
+}
+
+func LoadConfig(cfg *Config, cfgmap map[interface{}]interface{}) error {
+	if value, found := cfgmap["enabled"]; found {
+		cfg.Enabled = strings.EqualFold("true", fmt.Sprintf("%v", value))
+	}
+
+	if value, found := cfgmap["refreshInterval"]; found {
+		var err error
+		if cfg.RefreshInterval, err = time.ParseDuration(fmt.Sprintf("%v", value)); err != nil {
+			return errors.Wrapf(err, "invalid value %v for caRevocation.refreshInterval", value)
+		}
+		if cfg.RefreshInterval < MinRefreshInterval {
+			return errors.Errorf("invalid value %v for caRevocation.refreshInterval, must be at least %v", value, MinRefreshInterval)
+		}
+	}
+
+	if value, found := cfgmap["timeout"]; found {
+		var err error
+		if cfg.Timeout, err = time.ParseDuration(fmt.Sprintf("%v", value)); err != nil {
+			return errors.Wrapf(err, "invalid value %v for caRevocation.timeout", value)
+		}
+	}
+
+	if value, found := cfgmap["ocsp"]; found {
+		cfg.OcspEnabled = strings.EqualFold("true", fmt.Sprintf("%v", value))
+	}
+
+	if value, found := cfgmap["failClosed"]; found {
+		cfg.FailClosed = strings.EqualFold("true", fmt.Sprintf("%v", value))
+	}
+
+	if value, found := cfgmap["maxCrlSize"]; found {
+		if intVal, ok := value.(int); ok && intVal > 0 {
+			cfg.MaxCrlSize = int64(intVal)
+		} else {
+			return errors.Errorf("invalid value %v for caRevocation.maxCrlSize, must be a positive integer", value)
+		}
+	}
+
+	if value, found := cfgmap["crlUrls"]; found {
+		submap, ok := value.(map[interface{}]interface{})
+		if !ok {
+			return errors.Errorf("invalid type for caRevocation.crlUrls, should be map instead of %T", value)
+		}
+		for k, v := range submap {
+			url, ok := v.(string)
+			if !ok || url == "" {
+				return errors.Errorf("invalid value %v for caRevocation.crlUrls.%v, must be a url", v, k)
+			}
+			cfg.CrlUrls[fmt.Sprintf("%v", k)] = url
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,172 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package carevocation
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// failedRefreshRetryInterval is how long to wait before retrying a CRL download which failed
+const failedRefreshRetryInterval = time.Minute
+
+type crlEntry struct {
+	ca     CA
+	url    string
+	issuer *x509.Certificate
+
+	// loadLock serializes downloads, so concurrent authentications don't all fetch the same CRL
+	loadLock sync.Mutex
+
+	lock        sync.RWMutex
+	revoked     map[string]struct{}
+	thisUpdate  time.Time
+	nextUpdate  time.Time
+	fetchedAt   time.Time
+	lastError   error
+	lastErrorAt time.Time
+}
+
+// needsRefresh returns true if the CRL has never been loaded, is older than the refresh interval, or has passed
+// its next update time. Failed downloads are retried no more than once per failedRefreshRetryInterval
+func (self *crlEntry) needsRefresh(now time.Time, refreshInterval time.Duration) bool {
+	self.lock.RLock()
+	defer self.lock.RUnlock()
+
+	if !self.lastErrorAt.IsZero() && self.lastErrorAt.After(self.fetchedAt) &&
+		now.Sub(self.lastErrorAt) < failedRefreshRetryInterval {
+		return false
+	}
+
+	if self.fetchedAt.IsZero() || now.Sub(self.fetchedAt) >= refreshInterval {
+		return true
+	}
+
+	return !self.nextUpdate.IsZero() && now.After(self.nextUpdate)
+}
+
+// isRevoked reports whether the certificate is on the CRL. known is false if no CRL has been loaded, or if the
+// loaded CRL has expired, in which case it can still be used to reject revoked certificates but not to vouch for
+// others
+func (self *crlEntry) isRevoked(cert *x509.Certificate, now time.Time) (known bool, revoked bool) {
+	self.lock.RLock()
+	defer self.lock.RUnlock()
+
+	if self.revoked == nil {
+		return false, false
+	}
+
+	_, revoked = self.revoked[cert.SerialNumber.String()]
+	known = self.nextUpdate.IsZero() || now.Before(self.nextUpdate)
+	return known, revoked
+}
+
+// refresh downloads, parses and verifies the CRL. On failure, the previously loaded CRL is kept
+func (self *crlEntry) refresh(client *http.Client, maxSize int64, now time.Time) (int, error) {
+	crl, err := self.fetch(client, maxSize)
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if err != nil {
+		self.lastError = err
+		self.lastErrorAt = now
+		return 0, err
+	}
+
+	revoked := make(map[string]struct{}, len(crl.RevokedCertificateEntries))
+	for _, entry := range crl.RevokedCertificateEntries {
+		revoked[entry.SerialNumber.String()] = struct{}{}
+	}
+
+	self.revoked = revoked
+	self.thisUpdate = crl.ThisUpdate
+	self.nextUpdate = crl.NextUpdate
+	self.fetchedAt = now
+	self.lastError = nil
+
+	return len(revoked), nil
+}
+
+func (self *crlEntry) fetch(client *http.Client, maxSize int64) (*x509.RevocationList, error) {
+	resp, err := client.Get(self.url)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to download CRL from %v", self.url)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("unable to download CRL from %v, status %v", self.url, resp.Status)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxSize+1))
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to download CRL from %v", self.url)
+	}
+
+	if int64(len(body)) > maxSize {
+		return nil, errors.Errorf("CRL from %v is larger than the maximum size of %v bytes", self.url, maxSize)
+	}
+
+	if block, _ := pem.Decode(body); block != nil && block.Type == "X509 CRL" {
+		body = block.Bytes
+	}
+
+	crl, err := x509.ParseRevocationList(body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to parse CRL from %v", self.url)
+	}
+
+	if err = crl.CheckSignatureFrom(self.issuer); err != nil {
+		return nil, errors.Wrapf(err, "CRL from %v is not signed by issuer %v", self.url, self.issuer.Subject)
+	}
+
+	return crl, nil
+}
+
+func (self *crlEntry) status() *CrlStatus {
+	self.lock.RLock()
+	defer self.lock.RUnlock()
+
+	result := &CrlStatus{
+		Url:          self.url,
+		RevokedCount: len(self.revoked),
+	}
+
+	if !self.fetchedAt.IsZero() {
+		thisUpdate, nextUpdate, fetchedAt := self.thisUpdate, self.nextUpdate, self.fetchedAt
+		result.ThisUpdate = &thisUpdate
+		result.FetchedAt = &fetchedAt
+		if !nextUpdate.IsZero() {
+			result.NextUpdate = &nextUpdate
+		}
+	}
+
+	if self.lastError != nil {
+		lastErrorAt := self.lastErrorAt
+		result.LastError = self.lastError.Error()
+		result.LastErrorAt = &lastErrorAt
+	}
+
+	return result
+}
@@ -0,0 +1,143 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package carevocation
+
+import (
+	"bytes"
+	"crypto/x509"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ocsp"
+	"ztna-core/ztna/controller/event"
+)
+
+// maxOcspResponseSize is the largest OCSP response which will be read
+const maxOcspResponseSize = 64 * 1024
+
+// defaultOcspCacheDuration is how long responses without a next update time are cached
+const defaultOcspCacheDuration = 5 * time.Minute
+
+type ocspEntry struct {
+	revoked bool
+	url     string
+	expires time.Time
+}
+
+func (self *ocspEntry) valid(now time.Time) bool {
+	return now.Before(self.expires)
+}
+
+// checkOcsp queries the OCSP responders listed in the certificate, in order, until one of them gives a good or
+// revoked answer. Answers are cached until their next update time
+func (self *Checker) checkOcsp(ca CA, cert, issuer *x509.Certificate) (bool, string, error) {
+	key := string(issuer.RawSubjectPublicKeyInfo) + "|" + cert.SerialNumber.String()
+	now := self.clock()
+
+	self.lock.Lock()
+	entry, ok := self.ocsp[key]
+	self.lock.Unlock()
+
+	if ok && entry.valid(now) {
+		return entry.revoked, entry.url, nil
+	}
+
+	request, err := ocsp.CreateRequest(cert, issuer, nil)
+	if err != nil {
+		return false, "", errors.Wrap(err, "unable to create OCSP request")
+	}
+
+	var lastErr error
+	for _, url := range cert.OCSPServer {
+		resp, err := self.queryOcsp(url, request, cert, issuer)
+		if err == nil && resp.Status == ocsp.Unknown {
+			err = errors.Errorf("OCSP responder %v doesn't know certificate with serial %v", url, cert.SerialNumber)
+		}
+
+		if err != nil {
+			pfxlog.Logger().WithField("caId", ca.Id).WithField("url", url).WithError(err).Error("OCSP check failed")
+			evt := event.NewCaRevocationEvent(event.CaRevocationOcspFailed, ca.Id, ca.Name)
+			evt.Url = url
+			evt.Error = err.Error()
+			self.dispatch(evt)
+			lastErr = err
+			continue
+		}
+
+		expires := resp.NextUpdate
+		if expires.IsZero() {
+			expires = now.Add(defaultOcspCacheDuration)
+		}
+
+		entry = &ocspEntry{
+			revoked: resp.Status == ocsp.Revoked,
+			url:     url,
+			expires: expires,
+		}
+
+		self.lock.Lock()
+		self.ocsp[key] = entry
+		self.setOcspResult(ca, now, nil)
+		self.lock.Unlock()
+
+		return entry.revoked, url, nil
+	}
+
+	self.lock.Lock()
+	self.setOcspResult(ca, now, lastErr)
+	self.lock.Unlock()
+
+	return false, "", lastErr
+}
+
+// setOcspResult records the outcome of an OCSP check for the CA status. Must be called with the lock held
+func (self *Checker) setOcspResult(ca CA, now time.Time, err error) {
+	if state, ok := self.cas[ca.Id]; ok {
+		state.lastOcspCheck = now
+		state.lastOcspError = ""
+		if err != nil {
+			state.lastOcspError = err.Error()
+		}
+	}
+}
+
+func (self *Checker) queryOcsp(url string, request []byte, cert, issuer *x509.Certificate) (*ocsp.Response, error) {
+	httpResp, err := self.client.Post(url, "application/ocsp-request", bytes.NewReader(request))
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query OCSP responder %v", url)
+	}
+	defer func() { _ = httpResp.Body.Close() }()
+
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("unable to query OCSP responder %v, status %v", url, httpResp.Status)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(httpResp.Body, maxOcspResponseSize))
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read response from OCSP responder %v", url)
+	}
+
+	resp, err := ocsp.ParseResponseForCert(body, cert, issuer)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid response from OCSP responder %v", url)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,71 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package certstatus
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	DefaultRefreshInterval = time.Hour
+	MinRefreshInterval     = time.Minute
+)
+
+// Config controls the CRL and OCSP responder for certificates issued by the controller
+type Config struct {
+	// Enabled - if false, the CRL and OCSP endpoints aren't served
+	Enabled bool
+
+	// RefreshInterval - how often the CRL is regenerated. The CRL next update time is two refresh intervals out,
+	// so relying parties which fetch late still have a valid CRL
+	RefreshInterval time.Duration
+
+	// EmbedUrls - if true, the CRL and OCSP urls are embedded in newly issued identity certificates
+	EmbedUrls bool
+}
+
+func (self *Config) SetDefaults() {
+	self.Enabled = true
+	self.RefreshInterval = DefaultRefreshInterval
+	self.EmbedUrls = false
+}
+
+func LoadConfig(cfg *Config, cfgmap map[interface{}]interface{}) error {
+	if value, found := cfgmap["enabled"]; found {
+		cfg.Enabled = strings.EqualFold("true", fmt.Sprintf("%v", value))
+	}
+
+	if value, found := cfgmap["refreshInterval"]; found {
+		var err error
+		if cfg.RefreshInterval, err = time.ParseDuration(fmt.Sprintf("%v", value)); err != nil {
+			return errors.Wrapf(err, "invalid value %v for certStatus.refreshInterval", value)
+		}
+		if cfg.RefreshInterval < MinRefreshInterval {
+			return errors.Errorf("invalid value %v for certStatus.refreshInterval, must be at least %v", value, MinRefreshInterval)
+		}
+	}
+
+	if value, found := cfgmap["embedUrls"]; found {
+		cfg.EmbedUrls = strings.EqualFold("true", fmt.Sprintf("%v", value))
+	}
+
+	return nil
+}
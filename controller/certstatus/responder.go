@@ -0,0 +1,318 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package certstatus
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ocsp"
+)
+
+const (
+	WellKnownCrlPath  = "/.well-known/ziti/crl"
+	WellKnownOcspPath = "/.well-known/ziti/ocsp"
+
+	maxOcspRequestSize = 16 * 1024
+)
+
+// RevokedCert is a revoked certificate issued by the controller
+type RevokedCert struct {
+	Serial    *big.Int
+	RevokedAt time.Time
+	ExpiresAt time.Time
+}
+
+// RevocationSource provides the revoked certificates, and knows which certificates were issued by the controller
+type RevocationSource interface {
+	ListRevokedCerts() ([]*RevokedCert, error)
+	// GetRevokedCert returns nil if the certificate with the given serial hasn't been revoked
+	GetRevokedCert(serial *big.Int) (*RevokedCert, error)
+	// IsIssuedCert returns true if the certificate with the given serial was issued by the controller and is
+	// still in use
+	IsIssuedCert(serial *big.Int) (bool, error)
+}
+
+// Issuer is the CA certificate and key which sign identity certificates, and so also sign the CRL and OCSP responses
+type Issuer interface {
+	Cert() *x509.Certificate
+	Signer() crypto.Signer
+}
+
+// Responder serves a CRL and answers OCSP requests for certificates issued by the controller. The CRL is
+// regenerated every refresh interval, and on the next request after a certificate is revoked
+type Responder struct {
+	config *Config
+	issuer Issuer
+	source RevocationSource
+	clock  func() time.Time
+
+	lock        sync.Mutex
+	crl         []byte
+	number      int64
+	generatedAt time.Time
+	nextUpdate  time.Time
+	stale       bool
+}
+
+func NewResponder(config *Config, issuer Issuer, source RevocationSource) *Responder {
+	return &Responder{
+		config: config,
+		issuer: issuer,
+		source: source,
+		clock:  time.Now,
+	}
+}
+
+// Urls returns the CRL and OCSP urls for the given API address
+func Urls(apiAddress string) (crlUrl string, ocspUrl string) {
+	return "https://" + apiAddress + WellKnownCrlPath, "https://" + apiAddress + WellKnownOcspPath
+}
+
+// Invalidate causes the CRL to be regenerated on the next request. Should be called when a certificate is revoked
+func (self *Responder) Invalidate() {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	self.stale = true
+}
+
+// GetCrl returns the DER encoded CRL, regenerating it if it's stale or older than the refresh interval
+func (self *Responder) GetCrl() ([]byte, time.Time, error) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	now := self.clock()
+	if self.crl == nil || self.stale || now.Sub(self.generatedAt) >= self.config.RefreshInterval {
+		if err := self.generateCrl(now); err != nil {
+			return nil, time.Time{}, err
+		}
+	}
+
+	return self.crl, self.nextUpdate, nil
+}
+
+// generateCrl must be called with the lock held
+func (self *Responder) generateCrl(now time.Time) error {
+	revokedCerts, err := self.source.ListRevokedCerts()
+	if err != nil {
+		return errors.Wrap(err, "unable to list revoked certificates")
+	}
+
+	// CRL numbers must increase, and time based numbers also increase across controllers
+	number := max(now.UnixMilli(), self.number+1)
+
+	template := &x509.RevocationList{
+		Number:     big.NewInt(number),
+		ThisUpdate: now,
+		NextUpdate: now.Add(2 * self.config.RefreshInterval),
+	}
+
+	for _, revoked := range revokedCerts {
+		if revoked.ExpiresAt.Before(now) {
+			continue
+		}
+		template.RevokedCertificateEntries = append(template.RevokedCertificateEntries, x509.RevocationListEntry{
+			SerialNumber:   revoked.Serial,
+			RevocationTime: revoked.RevokedAt,
+		})
+	}
+
+	crl, err := x509.CreateRevocationList(rand.Reader, template, self.issuer.Cert(), self.issuer.Signer())
+	if err != nil {
+		return errors.Wrap(err, "unable to sign CRL")
+	}
+
+	self.crl = crl
+	self.number = number
+	self.generatedAt = now
+	self.nextUpdate = template.NextUpdate
+	self.stale = false
+
+	pfxlog.Logger().WithField("revokedCount", len(template.RevokedCertificateEntries)).Debug("generated CRL")
+
+	return nil
+}
+
+// IsResponderPath returns true if the path is one served by the Responder
+func IsResponderPath(path string) bool {
+	return path == WellKnownCrlPath || path == WellKnownOcspPath || strings.HasPrefix(path, WellKnownOcspPath+"/")
+}
+
+func (self *Responder) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == WellKnownCrlPath {
+		self.serveCrl(rw, r)
+	} else {
+		self.serveOcsp(rw, r)
+	}
+}
+
+// serveCrl writes the CRL, DER encoded unless PEM is requested with ?format=pem
+func (self *Responder) serveCrl(rw http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		rw.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	crl, nextUpdate, err := self.GetCrl()
+	if err != nil {
+		pfxlog.Logger().WithError(err).Error("unable to generate CRL")
+		rw.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+
+	contentType := "application/pkix-crl"
+	if r.URL.Query().Get("format") == "pem" {
+		crl = pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: crl})
+		contentType = "application/x-pem-file"
+	}
+
+	rw.Header().Set("Content-Type", contentType)
+	rw.Header().Set("Expires", nextUpdate.UTC().Format(http.TimeFormat))
+	rw.Header().Set("Cache-Control", fmt.Sprintf("max-age=%d", int(self.config.RefreshInterval.Seconds())))
+	rw.WriteHeader(http.StatusOK)
+	_, _ = rw.Write(crl)
+}
+
+// serveOcsp answers OCSP requests sent either as a POST body or base64 encoded in the path of a GET, per RFC 6960
+func (self *Responder) serveOcsp(rw http.ResponseWriter, r *http.Request) {
+	var body []byte
+	var err error
+
+	switch r.Method {
+	case http.MethodPost:
+		body, err = io.ReadAll(io.LimitReader(r.Body, maxOcspRequestSize))
+	case http.MethodGet:
+		var encoded string
+		if encoded, err = url.PathUnescape(strings.TrimPrefix(r.URL.Path, WellKnownOcspPath+"/")); err == nil {
+			body, err = base64.StdEncoding.DecodeString(encoded)
+		}
+	default:
+		rw.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	var resp []byte
+	if err != nil {
+		resp = ocsp.MalformedRequestErrorResponse
+	} else {
+		resp = self.respond(body)
+	}
+
+	rw.Header().Set("Content-Type", "application/ocsp-response")
+	rw.WriteHeader(http.StatusOK)
+	_, _ = rw.Write(resp)
+}
+
+func (self *Responder) respond(body []byte) []byte {
+	log := pfxlog.Logger()
+
+	req, err := ocsp.ParseRequest(body)
+	if err != nil {
+		return ocsp.MalformedRequestErrorResponse
+	}
+
+	issuerCert := self.issuer.Cert()
+	keyHash, err := publicKeyHash(issuerCert, req.HashAlgorithm)
+	if err != nil || !bytes.Equal(keyHash, req.IssuerKeyHash) {
+		return ocsp.UnauthorizedErrorResponse
+	}
+
+	nameHash := req.HashAlgorithm.New()
+	nameHash.Write(issuerCert.RawSubject)
+	if !bytes.Equal(nameHash.Sum(nil), req.IssuerNameHash) {
+		return ocsp.UnauthorizedErrorResponse
+	}
+
+	revoked, err := self.source.GetRevokedCert(req.SerialNumber)
+	if err != nil {
+		log.WithError(err).WithField("serial", req.SerialNumber.String()).Error("unable to look up revocation")
+		return ocsp.InternalErrorErrorResponse
+	}
+
+	// only vouch for certificates the controller knows it issued
+	status := ocsp.Revoked
+	if revoked == nil {
+		issued, err := self.source.IsIssuedCert(req.SerialNumber)
+		if err != nil {
+			log.WithError(err).WithField("serial", req.SerialNumber.String()).Error("unable to look up issued certificate")
+			return ocsp.InternalErrorErrorResponse
+		}
+		if issued {
+			status = ocsp.Good
+		} else {
+			status = ocsp.Unknown
+		}
+	}
+
+	now := self.clock()
+	template := ocsp.Response{
+		Status:       status,
+		SerialNumber: req.SerialNumber,
+		ThisUpdate:   now,
+		NextUpdate:   now.Add(self.config.RefreshInterval),
+		IssuerHash:   req.HashAlgorithm,
+	}
+
+	if revoked != nil {
+		template.Status = ocsp.Revoked
+		template.RevokedAt = revoked.RevokedAt
+		template.RevocationReason = ocsp.Unspecified
+	}
+
+	resp, err := ocsp.CreateResponse(issuerCert, issuerCert, template, self.issuer.Signer())
+	if err != nil {
+		log.WithError(err).Error("unable to sign OCSP response")
+		return ocsp.InternalErrorErrorResponse
+	}
+
+	return resp
+}
+
+// publicKeyHash returns the hash of the issuer's public key, as used in OCSP requests to identify the issuer
+func publicKeyHash(cert *x509.Certificate, hash crypto.Hash) ([]byte, error) {
+	if !hash.Available() {
+		return nil, errors.Errorf("unsupported hash algorithm %v", hash)
+	}
+
+	var spki struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}
+	if _, err := asn1.Unmarshal(cert.RawSubjectPublicKeyInfo, &spki); err != nil {
+		return nil, err
+	}
+
+	h := hash.New()
+	h.Write(spki.PublicKey.RightAlign())
+	return h.Sum(nil), nil
+}
@@ -0,0 +1,218 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package certstatus
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ocsp"
+)
+
+type testIssuer struct {
+	cert *x509.Certificate
+	key  crypto.Signer
+}
+
+func (self *testIssuer) Cert() *x509.Certificate {
+	return self.cert
+}
+
+func (self *testIssuer) Signer() crypto.Signer {
+	return self.key
+}
+
+func newTestIssuer(t *testing.T) *testIssuer {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "signing-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return &testIssuer{cert: cert, key: key}
+}
+
+type testSource struct {
+	revoked map[string]*RevokedCert
+	issued  map[string]bool
+	lists   int
+}
+
+func (self *testSource) revoke(serial int64, expiresAt time.Time) {
+	self.revoked[big.NewInt(serial).String()] = &RevokedCert{
+		Serial:    big.NewInt(serial),
+		RevokedAt: time.Now().Add(-time.Minute).Truncate(time.Second),
+		ExpiresAt: expiresAt,
+	}
+}
+
+func (self *testSource) ListRevokedCerts() ([]*RevokedCert, error) {
+	self.lists++
+	var result []*RevokedCert
+	for _, v := range self.revoked {
+		result = append(result, v)
+	}
+	return result, nil
+}
+
+func (self *testSource) GetRevokedCert(serial *big.Int) (*RevokedCert, error) {
+	return self.revoked[serial.String()], nil
+}
+
+func (self *testSource) IsIssuedCert(serial *big.Int) (bool, error) {
+	return self.issued[serial.String()], nil
+}
+
+func newTestResponder(t *testing.T) (*Responder, *testIssuer, *testSource) {
+	config := &Config{}
+	config.SetDefaults()
+	issuer := newTestIssuer(t)
+	source := &testSource{revoked: map[string]*RevokedCert{}, issued: map[string]bool{}}
+	return NewResponder(config, issuer, source), issuer, source
+}
+
+func TestCrl(t *testing.T) {
+	req := require.New(t)
+
+	responder, issuer, source := newTestResponder(t)
+	source.revoke(10, time.Now().Add(time.Hour))
+	source.revoke(11, time.Now().Add(-time.Hour))
+
+	server := httptest.NewServer(responder)
+	defer server.Close()
+
+	getCrl := func() *x509.RevocationList {
+		resp, err := http.Get(server.URL + WellKnownCrlPath)
+		req.NoError(err)
+		defer func() { _ = resp.Body.Close() }()
+		req.Equal(http.StatusOK, resp.StatusCode)
+		req.Equal("application/pkix-crl", resp.Header.Get("Content-Type"))
+		body, err := io.ReadAll(resp.Body)
+		req.NoError(err)
+		crl, err := x509.ParseRevocationList(body)
+		req.NoError(err)
+		req.NoError(crl.CheckSignatureFrom(issuer.cert))
+		return crl
+	}
+
+	// expired certs are left off
+	crl := getCrl()
+	req.Len(crl.RevokedCertificateEntries, 1)
+	req.Equal(int64(10), crl.RevokedCertificateEntries[0].SerialNumber.Int64())
+	req.True(crl.NextUpdate.After(time.Now().Add(time.Hour)))
+
+	// served from cache until invalidated
+	source.revoke(12, time.Now().Add(time.Hour))
+	req.Len(getCrl().RevokedCertificateEntries, 1)
+	req.Equal(1, source.lists)
+
+	responder.Invalidate()
+	next := getCrl()
+	req.Len(next.RevokedCertificateEntries, 2)
+	req.Equal(1, next.Number.Cmp(crl.Number))
+
+	// regenerated once the refresh interval passes
+	responder.clock = func() time.Time { return time.Now().Add(2 * time.Hour) }
+	_, _, err := responder.GetCrl()
+	req.NoError(err)
+	req.Equal(3, source.lists)
+}
+
+func TestOcsp(t *testing.T) {
+	req := require.New(t)
+
+	responder, issuer, source := newTestResponder(t)
+	source.revoke(10, time.Now().Add(time.Hour))
+	source.issued["1"] = true
+
+	server := httptest.NewServer(responder)
+	defer server.Close()
+
+	newCert := func(serial int64) *x509.Certificate {
+		return &x509.Certificate{SerialNumber: big.NewInt(serial)}
+	}
+
+	query := func(serial int64, useGet bool) *ocsp.Response {
+		ocspReq, err := ocsp.CreateRequest(newCert(serial), issuer.cert, nil)
+		req.NoError(err)
+
+		var resp *http.Response
+		if useGet {
+			resp, err = http.Get(server.URL + WellKnownOcspPath + "/" + url.PathEscape(base64.StdEncoding.EncodeToString(ocspReq)))
+		} else {
+			resp, err = http.Post(server.URL+WellKnownOcspPath, "application/ocsp-request", bytes.NewReader(ocspReq))
+		}
+		req.NoError(err)
+		defer func() { _ = resp.Body.Close() }()
+		body, err := io.ReadAll(resp.Body)
+		req.NoError(err)
+
+		result, err := ocsp.ParseResponseForCert(body, newCert(serial), issuer.cert)
+		req.NoError(err)
+		return result
+	}
+
+	req.Equal(ocsp.Good, query(1, false).Status)
+	req.Equal(ocsp.Revoked, query(10, false).Status)
+	req.Equal(ocsp.Revoked, query(10, true).Status)
+
+	// serials the controller didn't issue aren't vouched for
+	req.Equal(ocsp.Unknown, query(2, false).Status)
+
+	// requests for other issuers are refused
+	otherIssuer := newTestIssuer(t)
+	ocspReq, err := ocsp.CreateRequest(newCert(1), otherIssuer.cert, nil)
+	req.NoError(err)
+	req.Equal(ocsp.UnauthorizedErrorResponse, responder.respond(ocspReq))
+
+	// as are requests which match the issuer's key but not its name
+	renamedIssuer := *issuer.cert
+	renamedIssuer.RawSubject, err = asn1.Marshal(pkix.Name{CommonName: "other-ca"}.ToRDNSequence())
+	req.NoError(err)
+	ocspReq, err = ocsp.CreateRequest(newCert(1), &renamedIssuer, nil)
+	req.NoError(err)
+	req.Equal(ocsp.UnauthorizedErrorResponse, responder.respond(ocspReq))
+
+	req.Equal(ocsp.MalformedRequestErrorResponse, responder.respond([]byte("garbage")))
+}
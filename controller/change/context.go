@@ -19,6 +19,7 @@ package change
 import (
 	"context"
 	"github.com/openziti/storage/boltz"
+	"time"
 	"ztna-core/ztna/common/pb/cmd_pb"
 )
 
@@ -36,6 +37,12 @@ const (
 	SourceMethod  = "src.method"
 	SourceLocal   = "src.local"
 	SourceRemote  = "src.remote"
+
+	SourceAuthenticator = "src.authenticator"
+
+	// TimestampKey holds the time the change was dispatched. It travels with the command, so every node applying
+	// the change sees the same time
+	TimestampKey = "timestamp"
 )
 
 type AuthorType string
@@ -125,6 +132,31 @@ func (self *Context) SetSourceRemote(val string) *Context {
 	return self
 }
 
+func (self *Context) SetSourceAuthenticator(val string) *Context {
+	self.Attributes[SourceAuthenticator] = val
+	return self
+}
+
+// SetTimestampIfMissing records the given time as the time of the change, unless a time is already recorded
+func (self *Context) SetTimestampIfMissing(val time.Time) *Context {
+	if _, found := self.Attributes[TimestampKey]; !found {
+		self.Attributes[TimestampKey] = val.UTC().Format(time.RFC3339Nano)
+	}
+	return self
+}
+
+// GetTimestamp returns the time of the change, or the zero time if none is recorded
+func (self *Context) GetTimestamp() time.Time {
+	if self == nil {
+		return time.Time{}
+	}
+	result, err := time.Parse(time.RFC3339Nano, self.Attributes[TimestampKey])
+	if err != nil {
+		return time.Time{}
+	}
+	return result
+}
+
 func (self *Context) GetAuthor() *Author {
 	if self == nil {
 		return nil
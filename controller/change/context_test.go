@@ -0,0 +1,44 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package change
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimestampTravelsWithContext(t *testing.T) {
+	req := require.New(t)
+
+	ctx := New()
+	req.True(ctx.GetTimestamp().IsZero())
+
+	dispatched := time.Date(2024, 5, 1, 12, 30, 15, 123456789, time.UTC)
+	ctx.SetTimestampIfMissing(dispatched)
+
+	// a later stamp, such as the one applied on the leader for a forwarded command, doesn't replace the original
+	ctx.SetTimestampIfMissing(dispatched.Add(time.Second))
+	req.True(dispatched.Equal(ctx.GetTimestamp()))
+
+	applied := FromProtoBuf(ctx.ToProtoBuf())
+	req.True(dispatched.Equal(applied.GetTimestamp()))
+
+	var nilCtx *Context
+	req.True(nilCtx.GetTimestamp().IsZero())
+}
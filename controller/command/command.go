@@ -25,6 +25,7 @@ import (
 	"ztna-core/ztna/controller/change"
 	"github.com/sirupsen/logrus"
 	"reflect"
+	"time"
 )
 
 // Command instances represent actions to be taken by the fabric controller. They are serializable,
@@ -104,6 +105,7 @@ func (self *LocalDispatcher) Dispatch(command Command) error {
 	if changeCtx == nil {
 		changeCtx = change.New().SetSourceType("unattributed").SetChangeAuthorType(change.AuthorTypeUnattributed)
 	}
+	changeCtx.SetTimestampIfMissing(time.Now())
 
 	if self.EncodeDecodeCommands {
 		bytes, err := command.Encode()
@@ -25,7 +25,11 @@ import (
 	"github.com/michaelquigley/pfxlog"
 	nfpem "github.com/openziti/foundation/v2/pem"
 	"github.com/openziti/identity"
+	"ztna-core/ztna/controller/audit"
+	"ztna-core/ztna/controller/carevocation"
+	"ztna-core/ztna/controller/certstatus"
 	"ztna-core/ztna/controller/command"
+	"ztna-core/ztna/controller/internal/permissions"
 	"github.com/pkg/errors"
 	"net"
 	"net/url"
@@ -108,9 +112,19 @@ type EdgeConfig struct {
 	caPemsOnce           sync.Once
 	Totp                 Totp
 	AuthRateLimiter      command.AdaptiveRateLimiterConfig
+	CaRevocation         carevocation.Config
+	CertStatus           certstatus.Config
+	AdminRoles           []*permissions.Role
+	Audit                audit.Config
+	GeoIp                GeoIp
 	caCerts              []*x509.Certificate
 }
 
+// GeoIp configures the country database used by SOURCE_IP posture checks
+type GeoIp struct {
+	DbFile string
+}
+
 type HttpTimeouts struct {
 	ReadTimeoutDuration       time.Duration
 	ReadHeaderTimeoutDuration time.Duration
@@ -473,6 +487,92 @@ func (c *EdgeConfig) loadAuthRateLimiterConfig(cfgmap map[interface{}]interface{
 	return nil
 }
 
+func (c *EdgeConfig) loadCaRevocationConfig(cfgmap map[interface{}]interface{}) error {
+	c.CaRevocation.SetDefaults()
+
+	if value, found := cfgmap["caRevocation"]; found {
+		if submap, ok := value.(map[interface{}]interface{}); ok {
+			if err := carevocation.LoadConfig(&c.CaRevocation, submap); err != nil {
+				return err
+			}
+		} else {
+			return errors.Errorf("invalid type for caRevocation, should be map instead of %T", value)
+		}
+	}
+
+	return nil
+}
+
+func (c *EdgeConfig) loadCertStatusConfig(cfgmap map[interface{}]interface{}) error {
+	c.CertStatus.SetDefaults()
+
+	if value, found := cfgmap["certStatus"]; found {
+		if submap, ok := value.(map[interface{}]interface{}); ok {
+			if err := certstatus.LoadConfig(&c.CertStatus, submap); err != nil {
+				return err
+			}
+		} else {
+			return errors.Errorf("invalid type for certStatus, should be map instead of %T", value)
+		}
+	}
+
+	return nil
+}
+
+func (c *EdgeConfig) loadAuditConfig(cfgmap map[interface{}]interface{}) error {
+	c.Audit.SetDefaults()
+
+	if value, found := cfgmap["audit"]; found {
+		if submap, ok := value.(map[interface{}]interface{}); ok {
+			if err := audit.LoadConfig(&c.Audit, submap); err != nil {
+				return err
+			}
+		} else {
+			return errors.Errorf("invalid type for audit, should be map instead of %T", value)
+		}
+	}
+
+	return nil
+}
+
+func (c *EdgeConfig) loadGeoIpConfig(cfgmap map[interface{}]interface{}) error {
+	value, found := cfgmap["geoIp"]
+	if !found {
+		return nil
+	}
+
+	submap, ok := value.(map[interface{}]interface{})
+	if !ok {
+		return errors.Errorf("invalid type for geoIp, should be map instead of %T", value)
+	}
+
+	if value, found = submap["dbFile"]; found {
+		dbFile, ok := value.(string)
+		if !ok {
+			return errors.Errorf("invalid type for geoIp.dbFile, should be string instead of %T", value)
+		}
+
+		if _, err := os.Stat(dbFile); err != nil {
+			return errors.Wrapf(err, "invalid geoIp.dbFile [%s]", dbFile)
+		}
+		c.GeoIp.DbFile = dbFile
+	}
+
+	return nil
+}
+
+func (c *EdgeConfig) loadAdminRolesConfig(cfgmap map[interface{}]interface{}) error {
+	if value, found := cfgmap["adminRoles"]; found {
+		roles, err := permissions.LoadRoles(value)
+		if err != nil {
+			return err
+		}
+		c.AdminRoles = roles
+	}
+
+	return nil
+}
+
 func (c *EdgeConfig) loadIdentityStatusConfig(cfgmap map[interface{}]interface{}) error {
 	c.IdentityStatusConfig.ScanInterval = DefaultIdentityOnlineStatusScanInterval
 	c.IdentityStatusConfig.UnknownTimeout = DefaultIdentityOnlineStatusUnknownTimeout
@@ -565,6 +665,26 @@ func LoadEdgeConfigFromMap(configMap map[interface{}]interface{}) (*EdgeConfig,
 		return nil, err
 	}
 
+	if err = edgeConfig.loadCaRevocationConfig(edgeConfigMap); err != nil {
+		return nil, err
+	}
+
+	if err = edgeConfig.loadCertStatusConfig(edgeConfigMap); err != nil {
+		return nil, err
+	}
+
+	if err = edgeConfig.loadAdminRolesConfig(edgeConfigMap); err != nil {
+		return nil, err
+	}
+
+	if err = edgeConfig.loadAuditConfig(edgeConfigMap); err != nil {
+		return nil, err
+	}
+
+	if err = edgeConfig.loadGeoIpConfig(edgeConfigMap); err != nil {
+		return nil, err
+	}
+
 	return edgeConfig, nil
 }
 
@@ -53,6 +53,8 @@ import (
 	"ztna-core/ztna/controller/xctrl"
 	"ztna-core/ztna/controller/xmgmt"
 	"ztna-core/ztna/controller/xt"
+	"ztna-core/ztna/controller/xt_latency"
+	"ztna-core/ztna/controller/xt_leastcircuits"
 	"ztna-core/ztna/controller/xt_random"
 	"ztna-core/ztna/controller/xt_smartrouting"
 	"ztna-core/ztna/controller/xt_sticky"
@@ -508,6 +510,8 @@ func (c *Controller) registerXts() {
 	xt.GlobalRegistry().RegisterFactory(xt_random.NewFactory())
 	xt.GlobalRegistry().RegisterFactory(xt_weighted.NewFactory())
 	xt.GlobalRegistry().RegisterFactory(xt_sticky.NewFactory())
+	xt.GlobalRegistry().RegisterFactory(xt_leastcircuits.NewFactory())
+	xt.GlobalRegistry().RegisterFactory(xt_latency.NewFactory())
 }
 
 func (c *Controller) registerComponents() error {
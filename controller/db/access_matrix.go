@@ -0,0 +1,413 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package db
+
+import (
+	"encoding/csv"
+	"io"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/openziti/storage/boltz"
+	"go.etcd.io/bbolt"
+)
+
+// AccessMatrixEntry is the access of one identity to one service for one policy type. PostureChecks holds the
+// alternative sets of posture checks which must pass, one per granting policy, and is empty if any granting policy
+// has none. EdgeRouters are those available to both the identity and the service, OfflineEdgeRouters those of them
+// which are disabled or, if known, not connected.
+type AccessMatrixEntry struct {
+	IdentityId         string     `json:"identityId"`
+	IdentityName       string     `json:"identityName"`
+	ServiceId          string     `json:"serviceId"`
+	ServiceName        string     `json:"serviceName"`
+	Type               string     `json:"type"`
+	Policies           []string   `json:"policies"`
+	PostureChecks      [][]string `json:"postureChecks"`
+	EdgeRouters        []string   `json:"edgeRouters"`
+	OfflineEdgeRouters []string   `json:"offlineEdgeRouters,omitempty"`
+}
+
+func (self *AccessMatrixEntry) key() string {
+	return self.IdentityId + "/" + self.ServiceId + "/" + self.Type
+}
+
+func (self *AccessMatrixEntry) sortKey() string {
+	return self.IdentityName + "\x00" + self.ServiceName + "\x00" + self.Type
+}
+
+// IsReachable returns true if at least one of the common edge routers is online
+func (self *AccessMatrixEntry) IsReachable() bool {
+	return len(self.EdgeRouters) > len(self.OfflineEdgeRouters)
+}
+
+// AccessMatrix is the complete identity x service x policy type access of a network
+type AccessMatrix struct {
+	Entries []*AccessMatrixEntry `json:"entries"`
+}
+
+var accessMatrixCsvHeader = []string{"identityId", "identityName", "serviceId", "serviceName", "type", "policies", "postureChecks", "edgeRouters", "offlineEdgeRouters"}
+
+// accessMatrixCsvListColumns is the index of the first list column. The columns before it identify the entry
+const accessMatrixCsvListColumns = 5
+
+// WriteCsv writes the matrix as CSV, one row per entry. Lists are separated by ';', posture check sets are joined by
+// '+' and separated by '|'.
+func (self *AccessMatrix) WriteCsv(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(accessMatrixCsvHeader); err != nil {
+		return err
+	}
+	for _, entry := range self.Entries {
+		if err := writer.Write(entry.csvRecord()); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func (self *AccessMatrixEntry) csvRecord() []string {
+	return []string{
+		self.IdentityId,
+		self.IdentityName,
+		self.ServiceId,
+		self.ServiceName,
+		self.Type,
+		strings.Join(self.Policies, ";"),
+		joinPostureCheckSets(self.PostureChecks),
+		strings.Join(self.EdgeRouters, ";"),
+		strings.Join(self.OfflineEdgeRouters, ";"),
+	}
+}
+
+func joinPostureCheckSets(sets [][]string) string {
+	var result []string
+	for _, set := range sets {
+		result = append(result, strings.Join(set, "+"))
+	}
+	return strings.Join(result, "|")
+}
+
+// AccessMatrixRouters returns the names of the edge routers available to both the identity and the service, and
+// those of them which are offline
+type AccessMatrixRouters func(identityId, serviceId string) (edgeRouters []string, offline []string)
+
+// AccessMatrixBuilder accumulates the access granted by service policies. BuildAccessMatrix feeds it from the
+// denormalized policy links and policy simulations from roles evaluated against the proposed changes, so both
+// produce the same matrix for the same policies.
+type AccessMatrixBuilder struct {
+	entries map[string]*accessMatrixPending
+}
+
+type accessMatrixPending struct {
+	entry         *AccessMatrixEntry
+	unconditional bool
+	postureChecks map[string][]string
+}
+
+func NewAccessMatrixBuilder() *AccessMatrixBuilder {
+	return &AccessMatrixBuilder{
+		entries: map[string]*accessMatrixPending{},
+	}
+}
+
+// Grant records that the named policy gives the identity access of the given type to the service, provided the
+// posture checks pass
+func (self *AccessMatrixBuilder) Grant(identityId, identityName, serviceId, serviceName, policyType, policyName string, postureChecks []string) {
+	entry := &AccessMatrixEntry{
+		IdentityId: identityId,
+		ServiceId:  serviceId,
+		Type:       policyType,
+	}
+
+	p := self.entries[entry.key()]
+	if p == nil {
+		entry.IdentityName = identityName
+		entry.ServiceName = serviceName
+		p = &accessMatrixPending{entry: entry, postureChecks: map[string][]string{}}
+		self.entries[entry.key()] = p
+	}
+
+	p.entry.Policies = append(p.entry.Policies, policyName)
+	if len(postureChecks) == 0 {
+		p.unconditional = true
+	} else {
+		checks := slices.Clone(postureChecks)
+		sort.Strings(checks)
+		p.postureChecks[strings.Join(checks, "\x00")] = checks
+	}
+}
+
+// Build returns the matrix of the access granted so far, ordered by identity name, service name and type
+func (self *AccessMatrixBuilder) Build(routers AccessMatrixRouters) *AccessMatrix {
+	result := &AccessMatrix{}
+	for _, p := range self.entries {
+		entry := p.entry
+		sort.Strings(entry.Policies)
+
+		if !p.unconditional {
+			for _, checks := range p.postureChecks {
+				entry.PostureChecks = append(entry.PostureChecks, checks)
+			}
+			sort.Slice(entry.PostureChecks, func(i, j int) bool {
+				return joinPostureCheckSets(entry.PostureChecks[i:i+1]) < joinPostureCheckSets(entry.PostureChecks[j:j+1])
+			})
+		}
+
+		entry.EdgeRouters, entry.OfflineEdgeRouters = routers(entry.IdentityId, entry.ServiceId)
+		sort.Strings(entry.EdgeRouters)
+		sort.Strings(entry.OfflineEdgeRouters)
+
+		result.Entries = append(result.Entries, entry)
+	}
+
+	sort.Slice(result.Entries, func(i, j int) bool {
+		return result.Entries[i].sortKey() < result.Entries[j].sortKey()
+	})
+
+	return result
+}
+
+// BuildAccessMatrix computes the access matrix from the denormalized policy links, so it reflects what the
+// controller enforces. Service policies outside their schedule at the given time grant nothing. If isOnline is nil,
+// only disabled edge routers are reported as offline.
+func BuildAccessMatrix(tx *bbolt.Tx, stores *Stores, at time.Time, isOnline func(edgeRouterId string) bool) (*AccessMatrix, error) {
+	names := newAccessMatrixNames(tx)
+	builder := NewAccessMatrixBuilder()
+
+	policyIds, _, err := stores.ServicePolicy.QueryIds(tx, "true limit none")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, policyId := range policyIds {
+		policy, err := stores.ServicePolicy.LoadById(tx, policyId)
+		if err != nil {
+			return nil, err
+		}
+
+		if !policy.Schedule.IsActive(at) {
+			continue
+		}
+
+		var checks []string
+		for _, checkId := range stores.ServicePolicy.GetRelatedEntitiesIdList(tx, policyId, EntityTypePostureChecks) {
+			checks = append(checks, names.get(stores.PostureCheck, checkId))
+		}
+
+		serviceIds := stores.ServicePolicy.GetRelatedEntitiesIdList(tx, policyId, EntityTypeServices)
+		for _, identityId := range stores.ServicePolicy.GetRelatedEntitiesIdList(tx, policyId, EntityTypeIdentities) {
+			for _, serviceId := range serviceIds {
+				builder.Grant(identityId, names.get(stores.Identity, identityId), serviceId, names.get(stores.EdgeService, serviceId),
+					string(policy.PolicyType), policy.Name, checks)
+			}
+		}
+	}
+
+	identityRouters := map[string][]string{}
+	serviceRouters := map[string]map[string]struct{}{}
+	offlineRouters := map[string]bool{}
+
+	isOffline := func(routerId string) bool {
+		offline, found := offlineRouters[routerId]
+		if !found {
+			disabled := boltz.FieldToBool(stores.Router.GetSymbol(FieldRouterDisabled).Eval(tx, []byte(routerId)))
+			offline = (disabled != nil && *disabled) || (isOnline != nil && !isOnline(routerId))
+			offlineRouters[routerId] = offline
+		}
+		return offline
+	}
+
+	result := builder.Build(func(identityId, serviceId string) ([]string, []string) {
+		routers, found := identityRouters[identityId]
+		if !found {
+			routers = stores.Identity.GetRelatedEntitiesIdList(tx, identityId, EntityTypeRouters)
+			identityRouters[identityId] = routers
+		}
+
+		svcRouters, found := serviceRouters[serviceId]
+		if !found {
+			svcRouters = map[string]struct{}{}
+			for _, routerId := range stores.EdgeService.GetRelatedEntitiesIdList(tx, serviceId, FieldEdgeRouters) {
+				svcRouters[routerId] = struct{}{}
+			}
+			serviceRouters[serviceId] = svcRouters
+		}
+
+		var common, offline []string
+		for _, routerId := range routers {
+			if _, isCommon := svcRouters[routerId]; isCommon {
+				name := names.get(stores.EdgeRouter, routerId)
+				common = append(common, name)
+				if isOffline(routerId) {
+					offline = append(offline, name)
+				}
+			}
+		}
+		return common, offline
+	})
+
+	return result, nil
+}
+
+// accessMatrixNames caches entity names by id
+type accessMatrixNames struct {
+	tx    *bbolt.Tx
+	names map[string]string
+}
+
+func newAccessMatrixNames(tx *bbolt.Tx) *accessMatrixNames {
+	return &accessMatrixNames{
+		tx:    tx,
+		names: map[string]string{},
+	}
+}
+
+func (self *accessMatrixNames) get(store boltz.Store, id string) string {
+	key := store.GetEntityType() + "/" + id
+	if name, found := self.names[key]; found {
+		return name
+	}
+
+	name := id
+	if symbol := store.GetSymbol(FieldName); symbol != nil {
+		if _, val := symbol.Eval(self.tx, []byte(id)); val != nil {
+			name = string(val)
+		}
+	}
+	self.names[key] = name
+	return name
+}
+
+const (
+	AccessMatrixAdded   = "added"
+	AccessMatrixRemoved = "removed"
+	AccessMatrixChanged = "changed"
+)
+
+// AccessMatrixChange is an entry which differs between two matrices. Fields lists the changed columns of a changed
+// entry.
+type AccessMatrixChange struct {
+	Change string             `json:"change"`
+	Fields []string           `json:"fields,omitempty"`
+	Before *AccessMatrixEntry `json:"before,omitempty"`
+	After  *AccessMatrixEntry `json:"after,omitempty"`
+}
+
+func (self *AccessMatrixChange) entry() *AccessMatrixEntry {
+	if self.After != nil {
+		return self.After
+	}
+	return self.Before
+}
+
+// AccessMatrixDiff holds the changed entries, ordered by identity name, service name and type, along with totals
+type AccessMatrixDiff struct {
+	Changes            []*AccessMatrixChange `json:"changes"`
+	Added              int                   `json:"added"`
+	Removed            int                   `json:"removed"`
+	Changed            int                   `json:"changed"`
+	IdentitiesAffected int                   `json:"identitiesAffected"`
+	ServicesAffected   int                   `json:"servicesAffected"`
+}
+
+// DiffAccessMatrix compares two matrices, matching entries by identity id, service id and type
+func DiffAccessMatrix(before, after *AccessMatrix) *AccessMatrixDiff {
+	result := &AccessMatrixDiff{}
+
+	afterByKey := map[string]*AccessMatrixEntry{}
+	for _, entry := range after.Entries {
+		afterByKey[entry.key()] = entry
+	}
+
+	beforeKeys := map[string]struct{}{}
+	for _, prev := range before.Entries {
+		beforeKeys[prev.key()] = struct{}{}
+		next, found := afterByKey[prev.key()]
+		if !found {
+			result.Changes = append(result.Changes, &AccessMatrixChange{Change: AccessMatrixRemoved, Before: prev})
+			result.Removed++
+		} else if fields := changedAccessMatrixFields(prev, next); len(fields) > 0 {
+			result.Changes = append(result.Changes, &AccessMatrixChange{Change: AccessMatrixChanged, Fields: fields, Before: prev, After: next})
+			result.Changed++
+		}
+	}
+
+	for _, next := range after.Entries {
+		if _, found := beforeKeys[next.key()]; !found {
+			result.Changes = append(result.Changes, &AccessMatrixChange{Change: AccessMatrixAdded, After: next})
+			result.Added++
+		}
+	}
+
+	sort.SliceStable(result.Changes, func(i, j int) bool {
+		return result.Changes[i].entry().sortKey() < result.Changes[j].entry().sortKey()
+	})
+
+	identities := map[string]struct{}{}
+	services := map[string]struct{}{}
+	for _, change := range result.Changes {
+		identities[change.entry().IdentityId] = struct{}{}
+		services[change.entry().ServiceId] = struct{}{}
+	}
+	result.IdentitiesAffected = len(identities)
+	result.ServicesAffected = len(services)
+
+	return result
+}
+
+func changedAccessMatrixFields(before, after *AccessMatrixEntry) []string {
+	var result []string
+	prev, next := before.csvRecord(), after.csvRecord()
+	for i := accessMatrixCsvListColumns; i < len(accessMatrixCsvHeader); i++ {
+		if prev[i] != next[i] {
+			result = append(result, accessMatrixCsvHeader[i])
+		}
+	}
+	return result
+}
+
+// WriteCsv writes the diff as CSV. Each change is a row with the change type followed by the entry columns, changed
+// entries are written as their new values followed by the old values of the list columns.
+func (self *AccessMatrixDiff) WriteCsv(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	header := append([]string{"change"}, accessMatrixCsvHeader...)
+	for _, column := range accessMatrixCsvHeader[accessMatrixCsvListColumns:] {
+		header = append(header, "previous"+strings.ToUpper(column[:1])+column[1:])
+	}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	for _, change := range self.Changes {
+		record := append([]string{change.Change}, change.entry().csvRecord()...)
+		if change.Change == AccessMatrixChanged {
+			record = append(record, change.Before.csvRecord()[accessMatrixCsvListColumns:]...)
+		} else {
+			record = append(record, make([]string, len(accessMatrixCsvHeader)-accessMatrixCsvListColumns)...)
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
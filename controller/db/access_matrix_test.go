@@ -0,0 +1,156 @@
+package db
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/storage/boltztest"
+	"go.etcd.io/bbolt"
+	"ztna-core/ztna/common/eid"
+)
+
+func Test_AccessMatrix(t *testing.T) {
+	ctx := NewTestContext(t)
+	defer ctx.Cleanup()
+	ctx.Init()
+
+	t.Run("test access matrix", ctx.testAccessMatrix)
+}
+
+func (ctx *TestContext) buildAccessMatrix() *AccessMatrix {
+	var result *AccessMatrix
+	err := ctx.GetDb().View(func(tx *bbolt.Tx) error {
+		var err error
+		result, err = BuildAccessMatrix(tx, ctx.stores, time.Now(), nil)
+		return err
+	})
+	ctx.NoError(err)
+	return result
+}
+
+func (ctx *TestContext) testAccessMatrix(_ *testing.T) {
+	ctx.CleanupAll()
+
+	identityTypeId := ctx.getIdentityTypeId()
+	alice := newIdentity("alice", identityTypeId, "eng")
+	boltztest.RequireCreate(ctx, alice)
+	bob := newIdentity("bob", identityTypeId, "ops")
+	boltztest.RequireCreate(ctx, bob)
+
+	web := newEdgeService("web", "internal")
+	boltztest.RequireCreate(ctx, web)
+
+	er1 := newEdgeRouter("er1", "public")
+	boltztest.RequireCreate(ctx, er1)
+	er2 := newEdgeRouter("er2", "private")
+	boltztest.RequireCreate(ctx, er2)
+
+	mfa := &PostureCheck{
+		BaseExtEntity:  boltz.BaseExtEntity{Id: eid.New()},
+		Name:           "mfa",
+		TypeId:         PostureCheckTypeMFA,
+		RoleAttributes: []string{"strict"},
+		SubType:        newPostureCheckMfa(),
+	}
+	boltztest.RequireCreate(ctx, mfa)
+
+	erp := newEdgeRouterPolicy("all-routers")
+	erp.IdentityRoles = []string{AllRole}
+	erp.EdgeRouterRoles = []string{AllRole}
+	boltztest.RequireCreate(ctx, erp)
+
+	serp := newServiceEdgeRouterPolicy("public-routers")
+	serp.ServiceRoles = []string{AllRole}
+	serp.EdgeRouterRoles = []string{roleRef("public")}
+	boltztest.RequireCreate(ctx, serp)
+
+	dial := &ServicePolicy{
+		BaseExtEntity:     boltz.BaseExtEntity{Id: eid.New()},
+		Name:              "eng-dial",
+		PolicyType:        PolicyTypeDial,
+		Semantic:          SemanticAllOf,
+		IdentityRoles:     []string{roleRef("eng")},
+		ServiceRoles:      []string{entityRef(web.Id)},
+		PostureCheckRoles: []string{roleRef("strict")},
+	}
+	boltztest.RequireCreate(ctx, dial)
+
+	bind := &ServicePolicy{
+		BaseExtEntity: boltz.BaseExtEntity{Id: eid.New()},
+		Name:          "ops-bind",
+		PolicyType:    PolicyTypeBind,
+		Semantic:      SemanticAllOf,
+		IdentityRoles: []string{entityRef(bob.Id)},
+		ServiceRoles:  []string{roleRef("internal")},
+	}
+	boltztest.RequireCreate(ctx, bind)
+
+	before := ctx.buildAccessMatrix()
+	ctx.Equal(2, len(before.Entries))
+
+	entry := before.Entries[0]
+	ctx.Equal("alice", entry.IdentityName)
+	ctx.Equal("web", entry.ServiceName)
+	ctx.Equal(PolicyTypeDialName, entry.Type)
+	ctx.Equal([]string{"eng-dial"}, entry.Policies)
+	ctx.Equal([][]string{{"mfa"}}, entry.PostureChecks)
+	ctx.Equal([]string{"er1"}, entry.EdgeRouters)
+
+	entry = before.Entries[1]
+	ctx.Equal("bob", entry.IdentityName)
+	ctx.Equal(PolicyTypeBindName, entry.Type)
+	ctx.Equal(0, len(entry.PostureChecks))
+
+	buf := &bytes.Buffer{}
+	ctx.NoError(before.WriteCsv(buf))
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	ctx.Equal(3, len(lines))
+	ctx.Equal(alice.Id+",alice,"+web.Id+",web,Dial,eng-dial,mfa,er1,", lines[1])
+
+	// give bob dial access and move the service to all routers
+	bob.RoleAttributes = []string{"ops", "eng"}
+	boltztest.RequireUpdate(ctx, bob)
+	serp.EdgeRouterRoles = []string{AllRole}
+	boltztest.RequireUpdate(ctx, serp)
+
+	after := ctx.buildAccessMatrix()
+	ctx.Equal(3, len(after.Entries))
+
+	diff := DiffAccessMatrix(before, after)
+	ctx.Equal(3, len(diff.Changes))
+	ctx.Equal(1, diff.Added)
+	ctx.Equal(2, diff.Changed)
+	ctx.Equal(2, diff.IdentitiesAffected)
+	ctx.Equal(1, diff.ServicesAffected)
+
+	ctx.Equal(AccessMatrixChanged, diff.Changes[0].Change)
+	ctx.Equal("alice", diff.Changes[0].After.IdentityName)
+	ctx.Equal([]string{"edgeRouters"}, diff.Changes[0].Fields)
+	ctx.Equal([]string{"er1", "er2"}, diff.Changes[0].After.EdgeRouters)
+
+	ctx.Equal(AccessMatrixChanged, diff.Changes[1].Change)
+	ctx.Equal(PolicyTypeBindName, diff.Changes[1].After.Type)
+
+	ctx.Equal(AccessMatrixAdded, diff.Changes[2].Change)
+	ctx.Equal("bob", diff.Changes[2].After.IdentityName)
+	ctx.Equal(PolicyTypeDialName, diff.Changes[2].After.Type)
+	ctx.Nil(diff.Changes[2].Before)
+
+	// disabled routers are offline and policies outside their schedule grant nothing
+	er2.Disabled = true
+	boltztest.RequireUpdate(ctx, er2)
+	expired := time.Now().Add(-time.Hour)
+	dial.Schedule = &ServicePolicySchedule{NotAfter: &expired}
+	boltztest.RequireUpdate(ctx, dial)
+
+	scheduled := ctx.buildAccessMatrix()
+	ctx.Equal(1, len(scheduled.Entries))
+	entry = scheduled.Entries[0]
+	ctx.Equal(PolicyTypeBindName, entry.Type)
+	ctx.Equal([]string{"er1", "er2"}, entry.EdgeRouters)
+	ctx.Equal([]string{"er2"}, entry.OfflineEdgeRouters)
+	ctx.True(entry.IsReachable())
+}
@@ -0,0 +1,193 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package db
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/openziti/storage/ast"
+	"github.com/openziti/storage/boltz"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	FieldAuditRecordSequence   = "sequence"
+	FieldAuditRecordTimestamp  = "timestamp"
+	FieldAuditRecordChangeType = "changeType"
+	FieldAuditRecordEntityType = "entityType"
+	FieldAuditRecordEntityId   = "entityId"
+	FieldAuditRecordActorType  = "actorType"
+	FieldAuditRecordActorId    = "actorId"
+	FieldAuditRecordActorName  = "actorName"
+	FieldAuditRecordAuthMethod = "authMethod"
+	FieldAuditRecordApi        = "api"
+	FieldAuditRecordSourceType = "sourceType"
+	FieldAuditRecordMethod     = "method"
+	FieldAuditRecordRemoteAddr = "remoteAddr"
+	FieldAuditRecordLocalAddr  = "localAddr"
+	FieldAuditRecordTraceId    = "traceId"
+	FieldAuditRecordChanges    = "changes"
+	FieldAuditRecordPrevHash   = "prevHash"
+	FieldAuditRecordHash       = "hash"
+
+	auditChainBucket        = "auditChain"
+	auditChainFieldSequence = "sequence"
+	auditChainFieldHash     = "hash"
+)
+
+// AuditRecordId returns the id of the audit record with the given sequence number. Ids are zero padded, so they
+// sort in chain order
+func AuditRecordId(sequence int64) string {
+	return fmt.Sprintf("%020d", sequence)
+}
+
+// AuditRecord is an entry in the hash chained audit log. Each record holds the hash of its predecessor, so removing
+// or modifying a record breaks the chain
+type AuditRecord struct {
+	boltz.BaseExtEntity
+	Sequence   int64     `json:"sequence"`
+	Timestamp  time.Time `json:"timestamp"`
+	ChangeType string    `json:"changeType"`
+	EntityType string    `json:"entityType"`
+	EntityId   string    `json:"entityId"`
+	ActorType  string    `json:"actorType"`
+	ActorId    string    `json:"actorId"`
+	ActorName  string    `json:"actorName"`
+	AuthMethod string    `json:"authMethod"`
+	Api        string    `json:"api"`
+	SourceType string    `json:"sourceType"`
+	Method     string    `json:"method"`
+	RemoteAddr string    `json:"remoteAddr"`
+	LocalAddr  string    `json:"localAddr"`
+	TraceId    string    `json:"traceId"`
+	Changes    string    `json:"changes"`
+	PrevHash   string    `json:"prevHash"`
+	Hash       string    `json:"hash"`
+}
+
+func (entity *AuditRecord) GetEntityType() string {
+	return EntityTypeAuditRecords
+}
+
+var _ AuditRecordStore = (*auditRecordStoreImpl)(nil)
+
+type AuditRecordStore interface {
+	Store[*AuditRecord]
+
+	// GetChainHead returns the sequence number and hash of the most recently appended record
+	GetChainHead(tx *bbolt.Tx) (int64, string)
+
+	// SetChainHead records the sequence number and hash of the most recently appended record
+	SetChainHead(tx *bbolt.Tx, sequence int64, hash string) error
+}
+
+func newAuditRecordStore(stores *stores) *auditRecordStoreImpl {
+	store := &auditRecordStoreImpl{}
+	store.baseStore = newBaseStore[*AuditRecord](stores, store)
+	store.InitImpl(store)
+	return store
+}
+
+type auditRecordStoreImpl struct {
+	*baseStore[*AuditRecord]
+}
+
+func (store *auditRecordStoreImpl) initializeLocal() {
+	store.AddExtEntitySymbols()
+	store.AddSymbol(FieldAuditRecordSequence, ast.NodeTypeInt64)
+	store.AddSymbol(FieldAuditRecordTimestamp, ast.NodeTypeDatetime)
+	store.AddSymbol(FieldAuditRecordChangeType, ast.NodeTypeString)
+	store.AddSymbol(FieldAuditRecordEntityType, ast.NodeTypeString)
+	store.AddSymbol(FieldAuditRecordEntityId, ast.NodeTypeString)
+	store.AddSymbol(FieldAuditRecordActorType, ast.NodeTypeString)
+	store.AddSymbol(FieldAuditRecordActorId, ast.NodeTypeString)
+	store.AddSymbol(FieldAuditRecordActorName, ast.NodeTypeString)
+	store.AddSymbol(FieldAuditRecordAuthMethod, ast.NodeTypeString)
+	store.AddSymbol(FieldAuditRecordApi, ast.NodeTypeString)
+	store.AddSymbol(FieldAuditRecordSourceType, ast.NodeTypeString)
+	store.AddSymbol(FieldAuditRecordMethod, ast.NodeTypeString)
+	store.AddSymbol(FieldAuditRecordRemoteAddr, ast.NodeTypeString)
+	store.AddSymbol(FieldAuditRecordLocalAddr, ast.NodeTypeString)
+	store.AddSymbol(FieldAuditRecordTraceId, ast.NodeTypeString)
+	store.AddSymbol(FieldAuditRecordHash, ast.NodeTypeString)
+}
+
+func (store *auditRecordStoreImpl) initializeLinked() {}
+
+func (store *auditRecordStoreImpl) NewEntity() *AuditRecord {
+	return &AuditRecord{}
+}
+
+func (store *auditRecordStoreImpl) FillEntity(entity *AuditRecord, bucket *boltz.TypedBucket) {
+	entity.LoadBaseValues(bucket)
+	entity.Sequence = bucket.GetInt64WithDefault(FieldAuditRecordSequence, 0)
+	entity.Timestamp = bucket.GetTimeOrError(FieldAuditRecordTimestamp)
+	entity.ChangeType = bucket.GetStringOrError(FieldAuditRecordChangeType)
+	entity.EntityType = bucket.GetStringOrError(FieldAuditRecordEntityType)
+	entity.EntityId = bucket.GetStringOrError(FieldAuditRecordEntityId)
+	entity.ActorType = bucket.GetStringWithDefault(FieldAuditRecordActorType, "")
+	entity.ActorId = bucket.GetStringWithDefault(FieldAuditRecordActorId, "")
+	entity.ActorName = bucket.GetStringWithDefault(FieldAuditRecordActorName, "")
+	entity.AuthMethod = bucket.GetStringWithDefault(FieldAuditRecordAuthMethod, "")
+	entity.Api = bucket.GetStringWithDefault(FieldAuditRecordApi, "")
+	entity.SourceType = bucket.GetStringWithDefault(FieldAuditRecordSourceType, "")
+	entity.Method = bucket.GetStringWithDefault(FieldAuditRecordMethod, "")
+	entity.RemoteAddr = bucket.GetStringWithDefault(FieldAuditRecordRemoteAddr, "")
+	entity.LocalAddr = bucket.GetStringWithDefault(FieldAuditRecordLocalAddr, "")
+	entity.TraceId = bucket.GetStringWithDefault(FieldAuditRecordTraceId, "")
+	entity.Changes = bucket.GetStringWithDefault(FieldAuditRecordChanges, "")
+	entity.PrevHash = bucket.GetStringWithDefault(FieldAuditRecordPrevHash, "")
+	entity.Hash = bucket.GetStringOrError(FieldAuditRecordHash)
+}
+
+func (store *auditRecordStoreImpl) PersistEntity(entity *AuditRecord, ctx *boltz.PersistContext) {
+	entity.SetBaseValues(ctx)
+	ctx.SetInt64(FieldAuditRecordSequence, entity.Sequence)
+	ctx.SetTimeP(FieldAuditRecordTimestamp, &entity.Timestamp)
+	ctx.SetString(FieldAuditRecordChangeType, entity.ChangeType)
+	ctx.SetString(FieldAuditRecordEntityType, entity.EntityType)
+	ctx.SetString(FieldAuditRecordEntityId, entity.EntityId)
+	ctx.SetString(FieldAuditRecordActorType, entity.ActorType)
+	ctx.SetString(FieldAuditRecordActorId, entity.ActorId)
+	ctx.SetString(FieldAuditRecordActorName, entity.ActorName)
+	ctx.SetString(FieldAuditRecordAuthMethod, entity.AuthMethod)
+	ctx.SetString(FieldAuditRecordApi, entity.Api)
+	ctx.SetString(FieldAuditRecordSourceType, entity.SourceType)
+	ctx.SetString(FieldAuditRecordMethod, entity.Method)
+	ctx.SetString(FieldAuditRecordRemoteAddr, entity.RemoteAddr)
+	ctx.SetString(FieldAuditRecordLocalAddr, entity.LocalAddr)
+	ctx.SetString(FieldAuditRecordTraceId, entity.TraceId)
+	ctx.SetString(FieldAuditRecordChanges, entity.Changes)
+	ctx.SetString(FieldAuditRecordPrevHash, entity.PrevHash)
+	ctx.SetString(FieldAuditRecordHash, entity.Hash)
+}
+
+func (store *auditRecordStoreImpl) GetChainHead(tx *bbolt.Tx) (int64, string) {
+	bucket := boltz.Path(tx, RootBucket, MetadataBucket, auditChainBucket)
+	if bucket == nil {
+		return 0, ""
+	}
+	return bucket.GetInt64WithDefault(auditChainFieldSequence, 0), bucket.GetStringWithDefault(auditChainFieldHash, "")
+}
+
+func (store *auditRecordStoreImpl) SetChainHead(tx *bbolt.Tx, sequence int64, hash string) error {
+	bucket := boltz.GetOrCreatePath(tx, RootBucket, MetadataBucket, auditChainBucket)
+	bucket.SetInt64(auditChainFieldSequence, sequence, nil)
+	bucket.SetString(auditChainFieldHash, hash, nil)
+	return bucket.Err
+}
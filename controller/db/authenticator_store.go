@@ -17,6 +17,8 @@
 package db
 
 import (
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
 	"github.com/michaelquigley/pfxlog"
 	"github.com/openziti/foundation/v2/errorz"
@@ -133,6 +135,7 @@ func newAuthenticatorStore(stores *stores) *authenticatorStoreImpl {
 	store := &authenticatorStoreImpl{}
 	store.baseStore = newBaseStore[*Authenticator](stores, store)
 	store.InitImpl(store)
+	store.AddEntityConstraint(store)
 	return store
 }
 
@@ -223,6 +226,55 @@ func (store *authenticatorStoreImpl) PersistEntity(entity *Authenticator, ctx *b
 	}
 }
 
+// ProcessPreCommit revokes network issued certificates when their authenticator is deleted or the certificate is
+// replaced, so they show up in the CRL and OCSP responses
+func (store *authenticatorStoreImpl) ProcessPreCommit(state *boltz.EntityChangeState[*Authenticator]) error {
+	if state.ChangeType == boltz.EntityCreated || state.InitialState == nil {
+		return nil
+	}
+
+	initialCert, ok := state.InitialState.SubType.(*AuthenticatorCert)
+	if !ok || !initialCert.IsIssuedByNetwork || initialCert.Pem == "" {
+		return nil
+	}
+
+	if state.ChangeType == boltz.EntityUpdated && state.FinalState != nil {
+		if finalCert, ok := state.FinalState.SubType.(*AuthenticatorCert); ok && finalCert.Pem == initialCert.Pem {
+			return nil
+		}
+	}
+
+	return store.revokeCertPem(state.Ctx, initialCert.Pem)
+}
+
+func (store *authenticatorStoreImpl) ProcessPostCommit(_ *boltz.EntityChangeState[*Authenticator]) {
+	/* does nothing */
+}
+
+func (store *authenticatorStoreImpl) revokeCertPem(ctx boltz.MutateContext, certPem string) error {
+	block, _ := pem.Decode([]byte(certPem))
+	if block == nil {
+		pfxlog.Logger().Warn("unable to decode certificate pem of network issued authenticator, not revoking")
+		return nil
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		pfxlog.Logger().WithError(err).Warn("unable to parse certificate of network issued authenticator, not revoking")
+		return nil
+	}
+
+	id := CertRevocationId(cert.SerialNumber)
+	if store.stores.revocation.IsEntityPresent(ctx.Tx(), id) {
+		return nil
+	}
+
+	return store.stores.revocation.Create(ctx, &Revocation{
+		BaseExtEntity: *boltz.NewExtEntity(id, nil),
+		ExpiresAt:     cert.NotAfter,
+	})
+}
+
 func (store *authenticatorStoreImpl) DeleteById(ctx boltz.MutateContext, id string) error {
 	err := store.baseStore.DeleteById(ctx, id)
 
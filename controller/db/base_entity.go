@@ -24,6 +24,7 @@ import (
 const (
 	EntityTypeApiSessions               = "apiSessions"
 	EntityTypeApiSessionCertificates    = "apiSessionCertificates"
+	EntityTypeAuditRecords              = "auditRecords"
 	EntityTypeAuthPolicies              = "authPolicies"
 	EntityTypeEventualEvents            = "eventualEvents"
 	EntityTypeCas                       = "cas"
@@ -39,6 +39,8 @@ func (m *Migrations) initialize(step *boltz.MigrationStep) int {
 	m.createInterceptV1ConfigType(step)
 	m.createHostV1ConfigType(step)
 	m.addProcessMultiPostureCheck(step)
+	m.addSourceIpPostureCheck(step)
+	m.addAttestationPostureCheck(step)
 	step.SetError(m.stores.ConfigType.Create(step.Ctx, hostV2ConfigType))
 	m.addSystemAuthPolicies(step)
 
@@ -24,7 +24,7 @@ import (
 )
 
 const (
-	CurrentDbVersion = 37
+	CurrentDbVersion = 39
 	FieldVersion     = "version"
 )
 
@@ -178,6 +178,14 @@ func (m *Migrations) migrate(step *boltz.MigrationStep) int {
 		m.setAuthenticatorIsIssuedByNetwork(step)
 	}
 
+	if step.CurrentVersion < 38 {
+		m.addSourceIpPostureCheck(step)
+	}
+
+	if step.CurrentVersion < 39 {
+		m.addAttestationPostureCheck(step)
+	}
+
 	// current version
 	if step.CurrentVersion <= CurrentDbVersion {
 		return CurrentDbVersion
@@ -0,0 +1,61 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package db
+
+import (
+	"github.com/openziti/storage/boltz"
+)
+
+const (
+	FieldPostureCheckAttestationPublicKeys            = "publicKeys"
+	FieldPostureCheckAttestationMaxAgeSeconds         = "maxAgeSeconds"
+	FieldPostureCheckAttestationRequireDiskEncryption = "requireDiskEncryption"
+	FieldPostureCheckAttestationRequireFirewall       = "requireFirewall"
+	FieldPostureCheckAttestationEdrAgent              = "edrAgent"
+	FieldPostureCheckAttestationMinEdrVersion         = "minEdrVersion"
+)
+
+type PostureCheckAttestation struct {
+	PublicKeys            []string `json:"publicKeys"`
+	MaxAgeSeconds         int64    `json:"maxAgeSeconds"`
+	RequireDiskEncryption bool     `json:"requireDiskEncryption"`
+	RequireFirewall       bool     `json:"requireFirewall"`
+	EdrAgent              string   `json:"edrAgent"`
+	MinEdrVersion         string   `json:"minEdrVersion"`
+}
+
+func newPostureCheckAttestation() PostureCheckSubType {
+	return &PostureCheckAttestation{}
+}
+
+func (entity *PostureCheckAttestation) LoadValues(bucket *boltz.TypedBucket) {
+	entity.PublicKeys = bucket.GetStringList(FieldPostureCheckAttestationPublicKeys)
+	entity.MaxAgeSeconds = bucket.GetInt64WithDefault(FieldPostureCheckAttestationMaxAgeSeconds, 0)
+	entity.RequireDiskEncryption = bucket.GetBoolWithDefault(FieldPostureCheckAttestationRequireDiskEncryption, false)
+	entity.RequireFirewall = bucket.GetBoolWithDefault(FieldPostureCheckAttestationRequireFirewall, false)
+	entity.EdrAgent = bucket.GetStringWithDefault(FieldPostureCheckAttestationEdrAgent, "")
+	entity.MinEdrVersion = bucket.GetStringWithDefault(FieldPostureCheckAttestationMinEdrVersion, "")
+}
+
+func (entity *PostureCheckAttestation) SetValues(ctx *boltz.PersistContext, bucket *boltz.TypedBucket) {
+	bucket.SetStringList(FieldPostureCheckAttestationPublicKeys, entity.PublicKeys, ctx.FieldChecker)
+	bucket.SetInt64(FieldPostureCheckAttestationMaxAgeSeconds, entity.MaxAgeSeconds, ctx.FieldChecker)
+	bucket.SetBool(FieldPostureCheckAttestationRequireDiskEncryption, entity.RequireDiskEncryption, ctx.FieldChecker)
+	bucket.SetBool(FieldPostureCheckAttestationRequireFirewall, entity.RequireFirewall, ctx.FieldChecker)
+	bucket.SetString(FieldPostureCheckAttestationEdrAgent, entity.EdrAgent, ctx.FieldChecker)
+	bucket.SetString(FieldPostureCheckAttestationMinEdrVersion, entity.MinEdrVersion, ctx.FieldChecker)
+}
@@ -39,6 +39,8 @@ const (
 	PostureCheckTypeProcessMulti = "PROCESS_MULTI"
 	PostureCheckTypeMAC          = "MAC"
 	PostureCheckTypeMFA          = "MFA"
+	PostureCheckTypeSourceIp     = "SOURCE_IP"
+	PostureCheckTypeAttestation  = "ATTESTATION"
 )
 
 var postureCheckSubTypeMap = map[string]newPostureCheckSubType{
@@ -48,6 +50,8 @@ var postureCheckSubTypeMap = map[string]newPostureCheckSubType{
 	PostureCheckTypeProcessMulti: newPostureCheckProcessMulti,
 	PostureCheckTypeMAC:          newPostureCheckMacAddresses,
 	PostureCheckTypeMFA:          newPostureCheckMfa,
+	PostureCheckTypeSourceIp:     newPostureCheckSourceIp,
+	PostureCheckTypeAttestation:  newPostureCheckAttestation,
 }
 
 type newPostureCheckSubType func() PostureCheckSubType
@@ -19,13 +19,23 @@ package db
 import (
 	"github.com/openziti/storage/ast"
 	"github.com/openziti/storage/boltz"
+	"math/big"
 	"time"
 )
 
 const (
 	FieldRevocationExpiresAt = "expiresAt"
+
+	// CertRevocationIdPrefix prefixes the ids of revocations of network issued certificates. Other revocations
+	// are keyed by token id or identity id
+	CertRevocationIdPrefix = "cert:"
 )
 
+// CertRevocationId returns the id of the revocation for the network issued certificate with the given serial number
+func CertRevocationId(serial *big.Int) string {
+	return CertRevocationIdPrefix + serial.Text(16)
+}
+
 type Revocation struct {
 	boltz.BaseExtEntity
 	ExpiresAt time.Time `json:"expiresAt"`
@@ -7,7 +7,9 @@ import (
 	"github.com/openziti/storage/ast"
 	"github.com/openziti/storage/boltz"
 	"ztna-core/ztna/common/pb/edge_ctrl_pb"
+	"ztna-core/ztna/common/schedule"
 	"sort"
+	"time"
 )
 
 type PolicyType string
@@ -45,7 +47,14 @@ func GetPolicyTypeForId(policyTypeId int32) PolicyType {
 }
 
 const (
-	FieldServicePolicyType = "type"
+	FieldServicePolicyType              = "type"
+	FieldServicePolicySchedule          = "schedule"
+	FieldServicePolicyScheduled         = "scheduled"
+	FieldServicePolicyScheduleTimezone  = "scheduleTimezone"
+	FieldServicePolicyScheduleDays      = "scheduleDays"
+	FieldServicePolicyScheduleWindows   = "scheduleWindows"
+	FieldServicePolicyScheduleNotBefore = "scheduleNotBefore"
+	FieldServicePolicyScheduleNotAfter  = "scheduleNotAfter"
 
 	PolicyTypeInvalidName = "Invalid"
 	PolicyTypeDialName    = "Dial"
@@ -64,6 +73,32 @@ type ServicePolicy struct {
 	IdentityRoles     []string   `json:"identityRoles"`
 	ServiceRoles      []string   `json:"serviceRoles"`
 	PostureCheckRoles []string   `json:"postureCheckRoles"`
+
+	Schedule *ServicePolicySchedule `json:"schedule,omitempty"`
+}
+
+// ServicePolicySchedule limits when a service policy is in force. Days and windows are kept in their string form,
+// see the schedule package for the format.
+type ServicePolicySchedule struct {
+	Timezone  string     `json:"timezone"`
+	Days      []string   `json:"days"`
+	Windows   []string   `json:"windows"`
+	NotBefore *time.Time `json:"notBefore"`
+	NotAfter  *time.Time `json:"notAfter"`
+}
+
+func (self *ServicePolicySchedule) Parse() (*schedule.Schedule, error) {
+	if self == nil {
+		return nil, nil
+	}
+	return schedule.Parse(self.Timezone, self.Days, self.Windows, self.NotBefore, self.NotAfter)
+}
+
+// IsActive reports whether the schedule is in force at the given time. A nil schedule is always in force, one which
+// can't be parsed never is
+func (self *ServicePolicySchedule) IsActive(t time.Time) bool {
+	policySchedule, err := self.Parse()
+	return err == nil && policySchedule.IsActive(t)
 }
 
 func (entity *ServicePolicy) GetName() string {
@@ -100,6 +135,7 @@ type servicePolicyStoreImpl struct {
 	indexName        boltz.ReadIndex
 	symbolPolicyType boltz.EntitySymbol
 	symbolSemantic   boltz.EntitySymbol
+	symbolScheduled  boltz.EntitySymbol
 
 	symbolIdentityRoles     boltz.EntitySetSymbol
 	symbolServiceRoles      boltz.EntitySetSymbol
@@ -128,6 +164,10 @@ func (store *servicePolicyStoreImpl) initializeLocal() {
 	store.indexName = store.addUniqueNameField()
 	store.symbolPolicyType = store.AddSymbol(FieldServicePolicyType, ast.NodeTypeInt64)
 	store.symbolSemantic = store.AddSymbol(FieldSemantic, ast.NodeTypeString)
+	store.symbolScheduled = store.AddSymbol(FieldServicePolicyScheduled, ast.NodeTypeBool)
+	store.AddSymbol(FieldServicePolicyScheduleTimezone, ast.NodeTypeString)
+	store.AddSymbol(FieldServicePolicyScheduleNotBefore, ast.NodeTypeDatetime)
+	store.AddSymbol(FieldServicePolicyScheduleNotAfter, ast.NodeTypeDatetime)
 
 	store.symbolIdentityRoles = store.AddPublicSetSymbol(FieldIdentityRoles, ast.NodeTypeString)
 	store.symbolServiceRoles = store.AddPublicSetSymbol(FieldServiceRoles, ast.NodeTypeString)
@@ -155,6 +195,16 @@ func (store *servicePolicyStoreImpl) FillEntity(entity *ServicePolicy, bucket *b
 	entity.IdentityRoles = bucket.GetStringList(FieldIdentityRoles)
 	entity.ServiceRoles = bucket.GetStringList(FieldServiceRoles)
 	entity.PostureCheckRoles = bucket.GetStringList(FieldPostureCheckRoles)
+
+	if bucket.GetBoolWithDefault(FieldServicePolicyScheduled, false) {
+		entity.Schedule = &ServicePolicySchedule{
+			Timezone:  bucket.GetStringWithDefault(FieldServicePolicyScheduleTimezone, ""),
+			Days:      bucket.GetStringList(FieldServicePolicyScheduleDays),
+			Windows:   bucket.GetStringList(FieldServicePolicyScheduleWindows),
+			NotBefore: bucket.GetTime(FieldServicePolicyScheduleNotBefore),
+			NotAfter:  bucket.GetTime(FieldServicePolicyScheduleNotAfter),
+		}
+	}
 }
 
 func (store *servicePolicyStoreImpl) PersistEntity(entity *ServicePolicy, ctx *boltz.PersistContext) {
@@ -190,10 +240,30 @@ func (store *servicePolicyStoreImpl) PersistEntity(entity *ServicePolicy, ctx *b
 		return
 	}
 
+	if ctx.ProceedWithSet(FieldServicePolicySchedule) {
+		if _, err := entity.Schedule.Parse(); err != nil {
+			ctx.Bucket.SetError(errorz.NewFieldError(err.Error(), FieldServicePolicySchedule, entity.Schedule))
+			return
+		}
+	}
+
 	entity.SetBaseValues(ctx)
 	ctx.SetRequiredString(FieldName, entity.Name)
 	ctx.SetInt32(FieldServicePolicyType, entity.PolicyType.Id())
 	ctx.SetRequiredString(FieldSemantic, entity.Semantic)
+
+	if ctx.ProceedWithSet(FieldServicePolicySchedule) {
+		scheduleValue := entity.Schedule
+		if scheduleValue == nil {
+			scheduleValue = &ServicePolicySchedule{}
+		}
+		ctx.Bucket.SetBool(FieldServicePolicyScheduled, entity.Schedule != nil, nil)
+		ctx.Bucket.SetString(FieldServicePolicyScheduleTimezone, scheduleValue.Timezone, nil)
+		ctx.Bucket.SetStringList(FieldServicePolicyScheduleDays, scheduleValue.Days, nil)
+		ctx.Bucket.SetStringList(FieldServicePolicyScheduleWindows, scheduleValue.Windows, nil)
+		ctx.Bucket.SetTimeP(FieldServicePolicyScheduleNotBefore, scheduleValue.NotBefore, nil)
+		ctx.Bucket.SetTimeP(FieldServicePolicyScheduleNotAfter, scheduleValue.NotAfter, nil)
+	}
 	servicePolicyStore := ctx.Store.(*servicePolicyStoreImpl)
 
 	sort.Strings(entity.ServiceRoles)
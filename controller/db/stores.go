@@ -115,6 +115,7 @@ type Stores struct {
 	Index                   boltz.Store
 	Session                 SessionStore
 	Revocation              RevocationStore
+	AuditRecord             AuditRecordStore
 	ServiceEdgeRouterPolicy ServiceEdgeRouterPolicyStore
 	ServicePolicy           ServicePolicyStore
 	TransitRouter           TransitRouterStore
@@ -187,6 +188,18 @@ func (stores *Stores) GetStoreForEntity(entity boltz.Entity) boltz.Store {
 	return stores.storeMap[key]
 }
 
+// GetStoresForEntityType returns the stores for the given entity type. Child stores share the entity type of their
+// parent store, so there may be more than one
+func (stores *Stores) GetStoresForEntityType(entityType string) []boltz.Store {
+	var result []boltz.Store
+	for _, store := range stores.storeMap {
+		if store.GetEntityType() == entityType {
+			result = append(result, store)
+		}
+	}
+	return result
+}
+
 func (stores *Stores) GetStores() []boltz.Store {
 	var result []boltz.Store
 	for _, store := range stores.storeMap {
@@ -215,6 +228,7 @@ type stores struct {
 	identity                *identityStoreImpl
 	identityType            *IdentityTypeStoreImpl
 	revocation              *revocationStoreImpl
+	auditRecord             *auditRecordStoreImpl
 	serviceEdgeRouterPolicy *serviceEdgeRouterPolicyStoreImpl
 	servicePolicy           *servicePolicyStoreImpl
 	session                 *sessionStoreImpl
@@ -273,6 +287,7 @@ func InitStores(db boltz.Db, rateLimiter rate.RateLimiter, signingCert *x509.Cer
 	internalStores.identityType = newIdentityTypeStore(internalStores)
 	internalStores.enrollment = newEnrollmentStore(internalStores)
 	internalStores.revocation = newRevocationStore(internalStores)
+	internalStores.auditRecord = newAuditRecordStore(internalStores)
 	internalStores.serviceEdgeRouterPolicy = newServiceEdgeRouterPolicyStore(internalStores)
 	internalStores.servicePolicy = newServicePolicyStore(internalStores)
 	internalStores.session = newSessionStore(internalStores)
@@ -303,6 +318,7 @@ func InitStores(db boltz.Db, rateLimiter rate.RateLimiter, signingCert *x509.Cer
 		Identity:                internalStores.identity,
 		IdentityType:            internalStores.identityType,
 		Revocation:              internalStores.revocation,
+		AuditRecord:             internalStores.auditRecord,
 		ServiceEdgeRouterPolicy: internalStores.serviceEdgeRouterPolicy,
 		ServicePolicy:           internalStores.servicePolicy,
 		Session:                 internalStores.session,
@@ -39,6 +39,7 @@ import (
 	"ztna-core/ztna/common/cert"
 	"ztna-core/ztna/common/eid"
 	"ztna-core/ztna/controller/api"
+	"ztna-core/ztna/controller/certstatus"
 	"ztna-core/ztna/controller/command"
 	"ztna-core/ztna/controller/config"
 	"ztna-core/ztna/controller/db"
@@ -116,6 +117,11 @@ type AppEnv struct {
 	ServerCert   *tls.Certificate
 
 	TraceManager *TraceManager
+
+	CertStatusResponder *certstatus.Responder
+
+	managementRoutes map[string]ManagementRouteHandler
+	clientRoutes     map[string]ManagementRouteHandler
 }
 
 func (ae *AppEnv) GetPeerControllerAddresses() []string {
@@ -596,6 +602,10 @@ func (ae *AppEnv) ProcessZtSession(rc *response.RequestContext, ztSession string
 		if rc.Identity.IsAdmin || rc.Identity.IsDefaultAdmin {
 			rc.ActivePermissions = append(rc.ActivePermissions, permissions.AdminPermission)
 		}
+
+		if !isPartialAuth {
+			ae.addGrants(rc)
+		}
 	}
 
 	return nil
@@ -674,6 +684,8 @@ func (ae *AppEnv) ProcessJwt(rc *response.RequestContext, token *jwt.Token) erro
 		rc.ActivePermissions = append(rc.ActivePermissions, permissions.AdminPermission)
 	}
 
+	ae.addGrants(rc)
+
 	return nil
 }
 
@@ -1089,11 +1101,9 @@ func (ae *AppEnv) IsAllowed(responderFunc func(ae *AppEnv, rc *response.RequestC
 			return
 		}
 
-		for _, permission := range permissions {
-			if !permission.IsAllowed(rc.ActivePermissions...) {
-				rc.RespondWithApiError(errorz.NewUnauthorized())
-				return
-			}
+		if !ae.CheckPermissions(rc, permissions...) {
+			rc.RespondWithApiError(errorz.NewUnauthorized())
+			return
 		}
 
 		responderFunc(ae, rc)
@@ -0,0 +1,193 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package env
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/storage/ast"
+	"go.etcd.io/bbolt"
+	"ztna-core/ztna/controller/db"
+	"ztna-core/ztna/controller/internal/permissions"
+	"ztna-core/ztna/controller/response"
+)
+
+// addGrants adds the grants of the admin roles held by the request identity. Admins already have full access and
+// don't need grants
+func (ae *AppEnv) addGrants(rc *response.RequestContext) {
+	if rc.Identity == nil || rc.Identity.IsAdmin || rc.Identity.IsDefaultAdmin {
+		return
+	}
+
+	edgeConfig := ae.GetConfig().Edge
+	if edgeConfig == nil {
+		return
+	}
+
+	for _, role := range edgeConfig.AdminRoles {
+		if role.AppliesTo(rc.Identity.Id, rc.Identity.Name, rc.Identity.RoleAttributes) {
+			for _, grant := range role.Grants {
+				rc.Grants = append(rc.Grants, grant)
+				rc.ActivePermissions = append(rc.ActivePermissions, grant.Permissions()...)
+			}
+		}
+	}
+}
+
+// CheckPermissions returns true if all the resolvers allow the request. For resolvers checking an entity type, access
+// granted by scoped grants is also checked against the entity being accessed and, for creates and updates, against
+// the tags and role attributes in the request body
+func (ae *AppEnv) CheckPermissions(rc *response.RequestContext, resolvers ...permissions.Resolver) bool {
+	for _, resolver := range resolvers {
+		if !resolver.IsAllowed(rc.ActivePermissions...) {
+			return false
+		}
+
+		if entityResolver, ok := resolver.(permissions.EntityResolver); ok {
+			rc.EnforceGrantScopes = true
+			scope := rc.GetScope(entityResolver.GetEntityType(), entityResolver.GetVerb())
+			if len(scope) > 0 && !ae.isInScope(rc, entityResolver.GetEntityType(), entityResolver.GetVerb(), scope) {
+				return false
+			}
+			if entityResolver.GetEntityType() == db.EntityTypeIdentities && !ae.isIdentityChangeAllowed(rc, entityResolver.GetVerb(), scope) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func (ae *AppEnv) isInScope(rc *response.RequestContext, entityType, verb string, scope []*permissions.Grant) bool {
+	// scopes are evaluated against stored entities, so entity types without a store can't be accessed via scoped grants
+	if len(ae.GetStores().GetStoresForEntityType(entityType)) == 0 {
+		return false
+	}
+
+	id, _ := rc.GetEntityId()
+	if id != "" && !ae.isEntityInScope(entityType, id, scope) {
+		return false
+	}
+
+	if verb != permissions.VerbCreate && verb != permissions.VerbUpdate {
+		return true
+	}
+
+	method := rc.Request.Method
+	replace := (method == http.MethodPost && id == "") || (method == http.MethodPut && strings.HasSuffix(rc.Request.URL.Path, "/"+id))
+	if !replace && method != http.MethodPatch {
+		return true
+	}
+
+	body := map[string]interface{}{}
+	if len(rc.Body) > 0 {
+		if err := json.Unmarshal(rc.Body, &body); err != nil {
+			return false
+		}
+	}
+
+	return permissions.ScopeAllowsValues(scope, body, !replace)
+}
+
+// CanReadAssociated returns true if the request may read the entity with the given id, which is being reached through
+// an association of an entity the request was already allowed to access. Requests not limited by grants may read it
+func (ae *AppEnv) CanReadAssociated(rc *response.RequestContext, entityType, id string) bool {
+	if !rc.EnforceGrantScopes {
+		return true
+	}
+
+	if !permissions.CanRead(entityType).IsAllowed(rc.ActivePermissions...) {
+		return false
+	}
+
+	scope := rc.GetScope(entityType, permissions.VerbRead)
+	return len(scope) == 0 || ae.isEntityInScope(entityType, id, scope)
+}
+
+// isIdentityChangeAllowed stops requests from identities which aren't admins from handing out admin rights, either
+// directly or by adding the role attributes of admin roles, regardless of whether their identity grants are scoped
+func (ae *AppEnv) isIdentityChangeAllowed(rc *response.RequestContext, verb string, scope []*permissions.Grant) bool {
+	if verb != permissions.VerbCreate && verb != permissions.VerbUpdate {
+		return true
+	}
+
+	if rc.Identity == nil || rc.Identity.IsAdmin || rc.Identity.IsDefaultAdmin || len(rc.Body) == 0 {
+		return true
+	}
+
+	// other identity updates, such as service config associations, don't send an identity in the body
+	body := map[string]interface{}{}
+	if err := json.Unmarshal(rc.Body, &body); err != nil {
+		return true
+	}
+
+	var adminRoles []*permissions.Role
+	if edgeConfig := ae.GetConfig().Edge; edgeConfig != nil {
+		adminRoles = edgeConfig.AdminRoles
+	}
+
+	currentIsAdmin := false
+	var currentAttributes []string
+	if id, _ := rc.GetEntityId(); id != "" {
+		if current, err := ae.Managers.Identity.Read(id); err == nil {
+			currentIsAdmin = current.IsAdmin
+			currentAttributes = current.RoleAttributes
+		}
+	}
+
+	return permissions.AllowsIdentityValues(scope, adminRoles, body, currentIsAdmin, currentAttributes)
+}
+
+// isEntityInScope evaluates the scope filter against the entity with the given id. The id comes from the request
+// path, so it's used to seek to the entity rather than being added to the filter
+func (ae *AppEnv) isEntityInScope(entityType, id string, scope []*permissions.Grant) bool {
+	filter := permissions.GetScopeFilter(scope)
+	entityCursor := func(*bbolt.Tx, bool) ast.SetCursor {
+		ids := ast.NewTreeSet(true)
+		ids.Add([]byte(id))
+		return ids.ToCursor()
+	}
+
+	found := false
+	err := ae.GetDb().View(func(tx *bbolt.Tx) error {
+		for _, store := range ae.GetStores().GetStoresForEntityType(entityType) {
+			if !store.IsEntityPresent(tx, id) {
+				continue
+			}
+			query, err := ast.Parse(store, filter)
+			if err != nil {
+				pfxlog.Logger().WithError(err).WithField("entityType", entityType).Debug("unable to parse grant scope")
+				continue
+			}
+			ids, _, err := store.QueryWithCursorC(tx, entityCursor, query)
+			if err != nil {
+				pfxlog.Logger().WithError(err).WithField("entityType", entityType).Debug("unable to evaluate grant scope")
+				continue
+			}
+			if len(ids) > 0 {
+				found = true
+				return nil
+			}
+		}
+		return nil
+	})
+
+	return err == nil && found
+}
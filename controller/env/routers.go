@@ -16,6 +16,11 @@
 
 package env
 
+import (
+	"strings"
+	"ztna-core/ztna/controller/response"
+)
+
 var routerFuncs []AddRouterFunc
 
 type AddRouterFunc func(ae *AppEnv)
@@ -31,3 +36,48 @@ func AddRouter(rf ApiRouter) {
 func GetRouters() []AddRouterFunc {
 	return routerFuncs
 }
+
+// ManagementRouteHandler serves a management or client api path which isn't part of the generated api
+type ManagementRouteHandler func(ae *AppEnv, rc *response.RequestContext)
+
+// AddManagementRoute registers a handler for a path relative to the management api base path. A path ending in a
+// slash also serves any path below it. Routes must be added during router registration, before the api is served
+func (ae *AppEnv) AddManagementRoute(path string, handler ManagementRouteHandler) {
+	if ae.managementRoutes == nil {
+		ae.managementRoutes = map[string]ManagementRouteHandler{}
+	}
+	ae.managementRoutes[path] = handler
+}
+
+// GetManagementRoute returns the handler for the given path, relative to the management api base path, or nil
+func (ae *AppEnv) GetManagementRoute(path string) ManagementRouteHandler {
+	return matchRoute(ae.managementRoutes, path)
+}
+
+// AddClientRoute registers a handler for a path relative to the client api base path, in the same manner as
+// AddManagementRoute
+func (ae *AppEnv) AddClientRoute(path string, handler ManagementRouteHandler) {
+	if ae.clientRoutes == nil {
+		ae.clientRoutes = map[string]ManagementRouteHandler{}
+	}
+	ae.clientRoutes[path] = handler
+}
+
+// GetClientRoute returns the handler for the given path, relative to the client api base path, or nil
+func (ae *AppEnv) GetClientRoute(path string) ManagementRouteHandler {
+	return matchRoute(ae.clientRoutes, path)
+}
+
+func matchRoute(routes map[string]ManagementRouteHandler, path string) ManagementRouteHandler {
+	if handler, ok := routes[path]; ok {
+		return handler
+	}
+
+	for route, handler := range routes {
+		if strings.HasSuffix(route, "/") && len(path) > len(route) && strings.HasPrefix(path, route) {
+			return handler
+		}
+	}
+
+	return nil
+}
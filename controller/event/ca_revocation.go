@@ -0,0 +1,97 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package event
+
+import (
+	"fmt"
+	"time"
+)
+
+type CaRevocationEventType string
+
+const (
+	CaRevocationEventsNs = "caRevocation"
+
+	CaRevocationCrlRefreshed     CaRevocationEventType = "crl.refreshed"
+	CaRevocationCrlRefreshFailed CaRevocationEventType = "crl.refresh.failed"
+	CaRevocationOcspFailed       CaRevocationEventType = "ocsp.failed"
+	CaRevocationCertRevoked      CaRevocationEventType = "cert.revoked"
+)
+
+// A CaRevocationEvent is emitted when revocation data for a third party CA is refreshed, or fails to refresh, and
+// when a client certificate is rejected because it has been revoked
+//
+// Valid values for event type are:
+//   - crl.refreshed - a CRL was downloaded and verified
+//   - crl.refresh.failed - a CRL couldn't be downloaded, parsed or verified
+//   - ocsp.failed - an OCSP responder couldn't be queried or returned an invalid response
+//   - cert.revoked - a client certificate was rejected because it has been revoked
+type CaRevocationEvent struct {
+	Namespace  string                `json:"namespace"`
+	EventType  CaRevocationEventType `json:"event_type"`
+	EventSrcId string                `json:"event_src_id"`
+	Timestamp  time.Time             `json:"timestamp"`
+
+	// The id of the CA
+	CaId string `json:"ca_id"`
+
+	// The name of the CA
+	CaName string `json:"ca_name"`
+
+	// The CRL or OCSP url
+	Url string `json:"url,omitempty"`
+
+	// The serial number of the revoked certificate, for cert.revoked events
+	Serial string `json:"serial,omitempty"`
+
+	// The number of revoked certificates in the CRL, for crl.refreshed events
+	RevokedCount int `json:"revoked_count,omitempty"`
+
+	// The error, for failure events
+	Error string `json:"error,omitempty"`
+}
+
+func (event *CaRevocationEvent) String() string {
+	return fmt.Sprintf("%v.%v time=%v caId=%v caName=%v url=%v serial=%v revokedCount=%v error=%v",
+		event.Namespace, event.EventType, event.Timestamp, event.CaId, event.CaName, event.Url, event.Serial,
+		event.RevokedCount, event.Error)
+}
+
+type CaRevocationEventHandler interface {
+	AcceptCaRevocationEvent(event *CaRevocationEvent)
+}
+
+type CaRevocationEventHandlerWrapper interface {
+	CaRevocationEventHandler
+	IsWrapping(value CaRevocationEventHandler) bool
+}
+
+type CaRevocationEventHandlerF func(event *CaRevocationEvent)
+
+func (f CaRevocationEventHandlerF) AcceptCaRevocationEvent(event *CaRevocationEvent) {
+	f(event)
+}
+
+func NewCaRevocationEvent(eventType CaRevocationEventType, caId, caName string) *CaRevocationEvent {
+	return &CaRevocationEvent{
+		Namespace: CaRevocationEventsNs,
+		EventType: eventType,
+		Timestamp: time.Now(),
+		CaId:      caId,
+		CaName:    caName,
+	}
+}
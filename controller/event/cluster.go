@@ -73,6 +73,11 @@ type ClusterEventHandler interface {
 	AcceptClusterEvent(event *ClusterEvent)
 }
 
+type ClusterEventHandlerWrapper interface {
+	ClusterEventHandler
+	IsWrapping(value ClusterEventHandler) bool
+}
+
 type ClusterEventHandlerF func(event *ClusterEvent)
 
 func (f ClusterEventHandlerF) AcceptClusterEvent(event *ClusterEvent) {
@@ -109,6 +109,9 @@ type Dispatcher interface {
 	AddClusterEventHandler(handler ClusterEventHandler)
 	RemoveClusterEventHandler(handler ClusterEventHandler)
 
+	AddCaRevocationEventHandler(handler CaRevocationEventHandler)
+	RemoveCaRevocationEventHandler(handler CaRevocationEventHandler)
+
 	AddEntityChangeEventHandler(handler EntityChangeEventHandler)
 	RemoveEntityChangeEventHandler(handler EntityChangeEventHandler)
 
@@ -125,6 +128,7 @@ type Dispatcher interface {
 	RemoveEntityCountEventHandler(handler EntityCountEventHandler)
 
 	ApiSessionEventHandler
+	CaRevocationEventHandler
 	CircuitEventHandler
 	ConnectEventHandler
 	ClusterEventHandler
@@ -134,3 +134,9 @@ func (d DispatcherMock) AddClusterEventHandler(ClusterEventHandler) {}
 func (d DispatcherMock) RemoveClusterEventHandler(ClusterEventHandler) {}
 
 func (d DispatcherMock) AcceptClusterEvent(*ClusterEvent) {}
+
+func (d DispatcherMock) AddCaRevocationEventHandler(CaRevocationEventHandler) {}
+
+func (d DispatcherMock) RemoveCaRevocationEventHandler(CaRevocationEventHandler) {}
+
+func (d DispatcherMock) AcceptCaRevocationEvent(*CaRevocationEvent) {}
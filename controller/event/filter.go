@@ -0,0 +1,345 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package event
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/openziti/storage/ast"
+	"github.com/pkg/errors"
+)
+
+// FilterOption is the subscription option which holds a filter expression
+const FilterOption = "filter"
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// A Filter is a boolean expression, using the standard ziti query language, which is evaluated against
+// events before they are handed to an event handler. Symbols refer to event fields by their go field
+// name or their json name, ex: serviceId or service_id. Map fields, such as tags, are addressed using
+// dotted names, ex: tags.env. Example:
+//
+//	serviceId = "x" and eventType in ["failed"]
+type Filter struct {
+	expr    string
+	query   ast.Query
+	symbols *filterSymbolTypes
+}
+
+// ParseFilterOption returns the Filter defined in the given subscription options for events of the same type
+// as prototype. If no filter is defined, nil is returned
+func ParseFilterOption(options map[string]interface{}, prototype interface{}) (*Filter, error) {
+	val, found := options[FilterOption]
+	if !found || val == nil {
+		return nil, nil
+	}
+
+	expr, ok := val.(string)
+	if !ok {
+		return nil, errors.Errorf("invalid %v value %v of type %T. must be string", FilterOption, val, val)
+	}
+
+	if strings.TrimSpace(expr) == "" {
+		return nil, nil
+	}
+
+	return NewFilter(expr, prototype)
+}
+
+// NewFilter parses the given expression for events of the same type as prototype
+func NewFilter(expr string, prototype interface{}) (*Filter, error) {
+	symbols := &filterSymbolTypes{
+		eventType: derefType(reflect.TypeOf(prototype)),
+		resolved:  map[string]*filterSymbol{},
+	}
+
+	if symbols.eventType.Kind() != reflect.Struct {
+		return nil, errors.Errorf("unable to filter events of type %v", reflect.TypeOf(prototype))
+	}
+
+	query, err := ast.Parse(symbols, expr)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid event filter '%v'", expr)
+	}
+
+	if len(query.GetSortFields()) > 0 || query.GetSkip() != nil || query.GetLimit() != nil {
+		return nil, errors.Errorf("invalid event filter '%v', sort, skip and limit are not supported", expr)
+	}
+
+	symbols.parsed = true
+
+	return &Filter{
+		expr:    expr,
+		query:   query,
+		symbols: symbols,
+	}, nil
+}
+
+// Matches returns true if the given event satisfies the filter expression
+func (self *Filter) Matches(evt interface{}) bool {
+	return self.query.EvalBool(&filterSymbols{
+		filterSymbolTypes: self.symbols,
+		value:             reflect.ValueOf(evt),
+	})
+}
+
+func (self *Filter) String() string {
+	return self.expr
+}
+
+// filterSymbol is the resolved path to an event field
+type filterSymbol struct {
+	nodeType ast.NodeType
+	path     []filterPathElement
+}
+
+type filterPathElement struct {
+	fieldIndex []int
+	mapKey     string
+}
+
+type filterSymbolTypes struct {
+	eventType reflect.Type
+	resolved  map[string]*filterSymbol
+	parsed    bool
+}
+
+func (self *filterSymbolTypes) GetSymbolType(name string) (ast.NodeType, bool) {
+	if symbol := self.getSymbol(name); symbol != nil {
+		return symbol.nodeType, true
+	}
+	return 0, false
+}
+
+func (self *filterSymbolTypes) GetSetSymbolTypes(string) ast.SymbolTypes {
+	return nil
+}
+
+func (self *filterSymbolTypes) IsSet(name string) (bool, bool) {
+	return false, self.getSymbol(name) != nil
+}
+
+// getSymbol resolves symbols while the filter is being parsed. Once parsing is complete the resolved map is
+// only read, so filters may be evaluated concurrently
+func (self *filterSymbolTypes) getSymbol(name string) *filterSymbol {
+	if symbol, found := self.resolved[name]; found {
+		return symbol
+	}
+	symbol := resolveFilterSymbol(self.eventType, name)
+	if !self.parsed {
+		self.resolved[name] = symbol
+	}
+	return symbol
+}
+
+func resolveFilterSymbol(t reflect.Type, name string) *filterSymbol {
+	result := &filterSymbol{}
+	parts := strings.Split(name, ".")
+
+	for len(parts) > 0 {
+		t = derefType(t)
+		switch t.Kind() {
+		case reflect.Struct:
+			if t == timeType {
+				return nil
+			}
+			field, found := findFilterField(t, parts[0])
+			if !found {
+				return nil
+			}
+			result.path = append(result.path, filterPathElement{fieldIndex: field.Index})
+			t = field.Type
+			parts = parts[1:]
+		case reflect.Map:
+			if t.Key().Kind() != reflect.String {
+				return nil
+			}
+			// map keys may themselves contain dots, ex: usage.ingress.rx
+			result.path = append(result.path, filterPathElement{mapKey: strings.Join(parts, ".")})
+			t = t.Elem()
+			parts = nil
+		default:
+			return nil
+		}
+	}
+
+	t = derefType(t)
+	switch {
+	case t == timeType:
+		result.nodeType = ast.NodeTypeDatetime
+	case t.Kind() == reflect.String || t.Kind() == reflect.Interface:
+		result.nodeType = ast.NodeTypeString
+	case t.Kind() == reflect.Bool:
+		result.nodeType = ast.NodeTypeBool
+	case t.Kind() >= reflect.Int && t.Kind() <= reflect.Uint64:
+		result.nodeType = ast.NodeTypeInt64
+	case t.Kind() == reflect.Float32 || t.Kind() == reflect.Float64:
+		result.nodeType = ast.NodeTypeFloat64
+	default:
+		return nil
+	}
+
+	return result
+}
+
+func findFilterField(t reflect.Type, name string) (reflect.StructField, bool) {
+	for _, field := range reflect.VisibleFields(t) {
+		if !field.IsExported() || field.Anonymous {
+			continue
+		}
+		jsonName, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if strings.EqualFold(field.Name, name) || jsonName == name {
+			return field, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+func derefType(t reflect.Type) reflect.Type {
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t
+}
+
+type filterSymbols struct {
+	*filterSymbolTypes
+	value reflect.Value
+}
+
+func (self *filterSymbols) eval(name string) (reflect.Value, bool) {
+	symbol := self.getSymbol(name)
+	if symbol == nil {
+		return reflect.Value{}, false
+	}
+
+	v := self.value
+	for _, elem := range symbol.path {
+		v = derefValue(v)
+		if !v.IsValid() {
+			return v, false
+		}
+		if elem.fieldIndex != nil {
+			var err error
+			if v, err = v.FieldByIndexErr(elem.fieldIndex); err != nil {
+				return reflect.Value{}, false
+			}
+		} else {
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+			v = v.MapIndex(reflect.ValueOf(elem.mapKey).Convert(v.Type().Key()))
+		}
+	}
+
+	v = derefValue(v)
+	return v, v.IsValid()
+}
+
+func derefValue(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+func (self *filterSymbols) EvalBool(name string) *bool {
+	if v, ok := self.eval(name); ok && v.Kind() == reflect.Bool {
+		result := v.Bool()
+		return &result
+	}
+	return nil
+}
+
+func (self *filterSymbols) EvalString(name string) *string {
+	v, ok := self.eval(name)
+	if !ok {
+		return nil
+	}
+	var result string
+	if v.Kind() == reflect.String {
+		result = v.String()
+	} else {
+		result = fmt.Sprintf("%v", v.Interface())
+	}
+	return &result
+}
+
+func (self *filterSymbols) EvalInt64(name string) *int64 {
+	v, ok := self.eval(name)
+	if !ok {
+		return nil
+	}
+	var result int64
+	switch {
+	case v.CanInt():
+		result = v.Int()
+	case v.CanUint():
+		result = int64(v.Uint())
+	case v.CanFloat():
+		result = int64(v.Float())
+	default:
+		return nil
+	}
+	return &result
+}
+
+func (self *filterSymbols) EvalFloat64(name string) *float64 {
+	v, ok := self.eval(name)
+	if !ok {
+		return nil
+	}
+	var result float64
+	switch {
+	case v.CanFloat():
+		result = v.Float()
+	case v.CanInt():
+		result = float64(v.Int())
+	case v.CanUint():
+		result = float64(v.Uint())
+	default:
+		return nil
+	}
+	return &result
+}
+
+func (self *filterSymbols) EvalDatetime(name string) *time.Time {
+	if v, ok := self.eval(name); ok && v.Type() == timeType {
+		result := v.Interface().(time.Time)
+		return &result
+	}
+	return nil
+}
+
+func (self *filterSymbols) IsNil(name string) bool {
+	_, ok := self.eval(name)
+	return !ok
+}
+
+func (self *filterSymbols) OpenSetCursor(string) ast.SetCursor {
+	return nil
+}
+
+func (self *filterSymbols) OpenSetCursorForQuery(string, ast.Query) ast.SetCursor {
+	return nil
+}
@@ -0,0 +1,104 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package event
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilter(t *testing.T) {
+	failureCause := "NO_TERMINATORS"
+	evt := &CircuitEvent{
+		Namespace:    CircuitEventsNs,
+		EventType:    CircuitFailed,
+		CircuitId:    "circuit1",
+		ServiceId:    "x",
+		Timestamp:    time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC),
+		LinkCount:    2,
+		FailureCause: &failureCause,
+		Path: CircuitPath{
+			IngressId: "router1",
+		},
+		Tags: map[string]string{
+			"env": "prod",
+		},
+	}
+
+	tests := []struct {
+		expr    string
+		matches bool
+	}{
+		{`serviceId = "x" and eventType in ["failed"]`, true},
+		{`serviceId = "x" and eventType in ["created", "deleted"]`, false},
+		{`service_id = "x"`, true},
+		{`tags.env = "prod"`, true},
+		{`tags.env != "prod"`, false},
+		{`tags.missing = "prod"`, false},
+		{`linkCount >= 2`, true},
+		{`linkCount > 2`, false},
+		{`failureCause contains "TERMINATOR"`, true},
+		{`path.ingressId = "router1"`, true},
+		{`duration = null`, true},
+		{`timestamp > datetime(2024-01-01T00:00:00Z)`, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expr, func(t *testing.T) {
+			req := require.New(t)
+			filter, err := NewFilter(test.expr, &CircuitEvent{})
+			req.NoError(err)
+			req.Equal(test.matches, filter.Matches(evt))
+		})
+	}
+}
+
+func TestFilterMapValues(t *testing.T) {
+	req := require.New(t)
+
+	filter, err := NewFilter(`usage.ingress.rx > 100 and sourceId = "router1"`, &UsageEventV3{})
+	req.NoError(err)
+
+	req.True(filter.Matches(&UsageEventV3{SourceId: "router1", Usage: map[string]uint64{"ingress.rx": 101}}))
+	req.False(filter.Matches(&UsageEventV3{SourceId: "router1", Usage: map[string]uint64{"ingress.rx": 100}}))
+	req.False(filter.Matches(&UsageEventV3{SourceId: "router1"}))
+}
+
+func TestFilterValidation(t *testing.T) {
+	req := require.New(t)
+
+	_, err := NewFilter(`unknownField = "x"`, &CircuitEvent{})
+	req.ErrorContains(err, "unknownField")
+
+	_, err = NewFilter(`serviceId = `, &CircuitEvent{})
+	req.Error(err)
+
+	_, err = NewFilter(`path = "x"`, &CircuitEvent{})
+	req.Error(err)
+
+	_, err = NewFilter(`serviceId = "x" limit 5`, &CircuitEvent{})
+	req.Error(err)
+
+	filter, err := ParseFilterOption(map[string]interface{}{}, &CircuitEvent{})
+	req.NoError(err)
+	req.Nil(filter)
+
+	_, err = ParseFilterOption(map[string]interface{}{FilterOption: 5}, &CircuitEvent{})
+	req.Error(err)
+}
@@ -42,3 +42,8 @@ func (event *ServiceEvent) String() string {
 type ServiceEventHandler interface {
 	AcceptServiceEvent(event *ServiceEvent)
 }
+
+type ServiceEventHandlerWrapper interface {
+	ServiceEventHandler
+	IsWrapping(value ServiceEventHandler) bool
+}
@@ -65,6 +65,7 @@ func NewDispatcher(closeNotify <-chan struct{}) *Dispatcher {
 	result.RegisterEventTypeFunctions(event.ClusterEventsNs, result.registerClusterEventHandler, result.unregisterClusterEventHandler)
 	result.RegisterEventTypeFunctions(event.ConnectEventNS, result.registerConnectEventHandler, result.unregisterConnectEventHandler)
 	result.RegisterEventTypeFunctions(event.SdkEventsNs, result.registerSdkEventHandler, result.unregisterSdkEventHandler)
+	result.RegisterEventTypeFunctions(event.CaRevocationEventsNs, result.registerCaRevocationEventHandler, result.unregisterCaRevocationEventHandler)
 
 	result.RegisterEventTypeFunctions(event.ApiSessionEventNS, result.registerApiSessionEventHandler, result.unregisterApiSessionEventHandler)
 	result.RegisterEventTypeFunctions(event.EntityCountEventNS, result.registerEntityCountEventHandler, result.unregisterEntityCountEventHandler)
@@ -74,9 +75,22 @@ func NewDispatcher(closeNotify <-chan struct{}) *Dispatcher {
 		return NewJsonFormatter(16, sink)
 	}))
 
+	for _, format := range []string{FormatCloudEvents, FormatCef, FormatLeef, FormatProtobuf} {
+		encoder, err := newEventEncoder(format, nil)
+		if err != nil {
+			panic(err)
+		}
+		result.RegisterFormatterFactory(format, event.FormatterFactoryF(func(sink event.FormattedEventSink) io.Closer {
+			return NewEncodingFormatter(16, sink, encoder)
+		}))
+	}
+
 	result.RegisterEventHandlerFactory("file", FileEventLoggerFactory{})
 	result.RegisterEventHandlerFactory("stdout", StdOutLoggerFactory{})
 	result.RegisterEventHandlerFactory("amqp", AMQPEventLoggerFactory{})
+	result.RegisterEventHandlerFactory("http", HTTPEventLoggerFactory{})
+	result.RegisterEventHandlerFactory("kafka", KafkaEventLoggerFactory{dispatcher: result})
+	result.RegisterEventHandlerFactory("nats", NATSEventLoggerFactory{dispatcher: result})
 
 	return result
 }
@@ -98,6 +112,7 @@ type Dispatcher struct {
 	clusterEventHandlers      concurrenz.CopyOnWriteSlice[event.ClusterEventHandler]
 	connectEventHandlers      concurrenz.CopyOnWriteSlice[event.ConnectEventHandler]
 	sdkEventHandlers          concurrenz.CopyOnWriteSlice[event.SdkEventHandler]
+	caRevocationEventHandlers concurrenz.CopyOnWriteSlice[event.CaRevocationEventHandler]
 
 	apiSessionEventHandlers  concurrenz.CopyOnWriteSlice[event.ApiSessionEventHandler]
 	entityCountEventHandlers concurrenz.CopyOnWriteSlice[*entityCountState]
@@ -144,6 +159,10 @@ func (self *Dispatcher) InitializeEdgeEvents(stores *db.Stores) {
 	}
 
 	for _, store := range self.stores.GetStores() {
+		// audit records are derived from entity changes, so they don't generate change events themselves
+		if store.GetEntityType() == db.EntityTypeAuditRecords {
+			continue
+		}
 		if _, found := fabricStores[store]; !found {
 			self.AddEntityChangeSource(store)
 		}
@@ -191,6 +210,7 @@ events:
       - type: fabric.circuits
         include:
           - created
+        filter: tags.env = "prod"
       - type: edge.sessions
         include:
           - created
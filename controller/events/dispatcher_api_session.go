@@ -106,7 +106,16 @@ func (self *Dispatcher) registerApiSessionEventHandler(val interface{}, config m
 		}
 	}
 
-	if len(includeList) == 0 || (len(includeList) == 2 && stringz.ContainsAll(includeList, event.ApiSessionEventTypeCreated, event.ApiSessionEventTypeDeleted)) {
+	filter, err := event.ParseFilterOption(config, &event.ApiSessionEvent{})
+	if err != nil {
+		return errors.Wrapf(err, "invalid %v filter", event.ApiSessionEventNS)
+	}
+
+	if len(includeList) == 2 && stringz.ContainsAll(includeList, event.ApiSessionEventTypeCreated, event.ApiSessionEventTypeDeleted) {
+		includeList = nil
+	}
+
+	if len(includeList) == 0 && filter == nil {
 		self.AddApiSessionEventHandler(handler)
 	} else {
 		for _, include := range includeList {
@@ -118,6 +127,7 @@ func (self *Dispatcher) registerApiSessionEventHandler(val interface{}, config m
 		self.AddApiSessionEventHandler(&apiSessionEventAdapter{
 			wrapped:     handler,
 			includeList: includeList,
+			filter:      filter,
 		})
 	}
 
@@ -133,10 +143,14 @@ func (self *Dispatcher) unregisterApiSessionEventHandler(val interface{}) {
 type apiSessionEventAdapter struct {
 	wrapped     event.ApiSessionEventHandler
 	includeList []string
+	filter      *event.Filter
 }
 
 func (adapter *apiSessionEventAdapter) AcceptApiSessionEvent(event *event.ApiSessionEvent) {
-	if stringz.Contains(adapter.includeList, event.EventType) {
+	if len(adapter.includeList) > 0 && !stringz.Contains(adapter.includeList, event.EventType) {
+		return
+	}
+	if adapter.filter == nil || adapter.filter.Matches(event) {
 		adapter.wrapped.AcceptApiSessionEvent(event)
 	}
 }
@@ -0,0 +1,99 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"github.com/pkg/errors"
+	"reflect"
+	"ztna-core/ztna/controller/event"
+)
+
+func (self *Dispatcher) AddCaRevocationEventHandler(handler event.CaRevocationEventHandler) {
+	self.caRevocationEventHandlers.Append(handler)
+}
+
+func (self *Dispatcher) RemoveCaRevocationEventHandler(handler event.CaRevocationEventHandler) {
+	self.caRevocationEventHandlers.DeleteIf(func(val event.CaRevocationEventHandler) bool {
+		if val == handler {
+			return true
+		}
+		if w, ok := val.(event.CaRevocationEventHandlerWrapper); ok {
+			return w.IsWrapping(handler)
+		}
+		return false
+	})
+}
+
+func (self *Dispatcher) AcceptCaRevocationEvent(event *event.CaRevocationEvent) {
+	event.EventSrcId = self.ctrlId
+	go func() {
+		for _, handler := range self.caRevocationEventHandlers.Value() {
+			handler.AcceptCaRevocationEvent(event)
+		}
+	}()
+}
+
+func (self *Dispatcher) registerCaRevocationEventHandler(val interface{}, config map[string]interface{}) error {
+	handler, ok := val.(event.CaRevocationEventHandler)
+
+	if !ok {
+		return errors.Errorf("type %v doesn't implement ztna-core/ztna/controller/event/CaRevocationEventHandler interface.", reflect.TypeOf(val))
+	}
+
+	filter, err := event.ParseFilterOption(config, &event.CaRevocationEvent{})
+	if err != nil {
+		return errors.Wrap(err, "invalid caRevocation filter")
+	}
+
+	if filter != nil {
+		handler = &filteredCaRevocationEventHandler{
+			filter:  filter,
+			wrapped: handler,
+		}
+	}
+
+	self.caRevocationEventHandlers.Append(handler)
+
+	return nil
+}
+
+func (self *Dispatcher) unregisterCaRevocationEventHandler(val interface{}) {
+	if handler, ok := val.(event.CaRevocationEventHandler); ok {
+		self.RemoveCaRevocationEventHandler(handler)
+	}
+}
+
+type filteredCaRevocationEventHandler struct {
+	filter  *event.Filter
+	wrapped event.CaRevocationEventHandler
+}
+
+func (self *filteredCaRevocationEventHandler) IsWrapping(value event.CaRevocationEventHandler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.CaRevocationEventHandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
+
+func (self *filteredCaRevocationEventHandler) AcceptCaRevocationEvent(evt *event.CaRevocationEvent) {
+	if self.filter.Matches(evt) {
+		self.wrapped.AcceptCaRevocationEvent(evt)
+	}
+}
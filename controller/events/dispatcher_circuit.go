@@ -67,12 +67,20 @@ func (self *Dispatcher) registerCircuitEventHandler(val interface{}, config map[
 		}
 	}
 
-	if len(includeList) == 0 {
+	filter, err := event.ParseFilterOption(config, &event.CircuitEvent{})
+	if err != nil {
+		return errors.Wrap(err, "invalid fabric.circuits filter")
+	}
+
+	if len(includeList) == 0 && filter == nil {
 		self.AddCircuitEventHandler(handler)
 		return nil
 	}
 
-	accepted := map[event.CircuitEventType]struct{}{}
+	var accepted map[event.CircuitEventType]struct{}
+	if len(includeList) > 0 {
+		accepted = map[event.CircuitEventType]struct{}{}
+	}
 	for _, include := range includeList {
 		found := false
 		for _, t := range event.CircuitEventTypes {
@@ -88,6 +96,7 @@ func (self *Dispatcher) registerCircuitEventHandler(val interface{}, config map[
 	}
 	result := &filteredCircuitEventHandler{
 		accepted: accepted,
+		filter:   filter,
 		wrapped:  handler,
 	}
 	self.AddCircuitEventHandler(result)
@@ -102,6 +111,7 @@ func (self *Dispatcher) unregisterCircuitEventHandler(val interface{}) {
 
 type filteredCircuitEventHandler struct {
 	accepted map[event.CircuitEventType]struct{}
+	filter   *event.Filter
 	wrapped  event.CircuitEventHandler
 }
 
@@ -116,7 +126,12 @@ func (self *filteredCircuitEventHandler) IsWrapping(value event.CircuitEventHand
 }
 
 func (self *filteredCircuitEventHandler) AcceptCircuitEvent(event *event.CircuitEvent) {
-	if _, found := self.accepted[event.EventType]; found {
+	if self.accepted != nil {
+		if _, found := self.accepted[event.EventType]; !found {
+			return
+		}
+	}
+	if self.filter == nil || self.filter.Matches(event) {
 		self.wrapped.AcceptCircuitEvent(event)
 	}
 }
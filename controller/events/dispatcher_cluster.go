@@ -27,7 +27,15 @@ func (self *Dispatcher) AddClusterEventHandler(handler event.ClusterEventHandler
 }
 
 func (self *Dispatcher) RemoveClusterEventHandler(handler event.ClusterEventHandler) {
-	self.clusterEventHandlers.Delete(handler)
+	self.clusterEventHandlers.DeleteIf(func(val event.ClusterEventHandler) bool {
+		if val == handler {
+			return true
+		}
+		if w, ok := val.(event.ClusterEventHandlerWrapper); ok {
+			return w.IsWrapping(handler)
+		}
+		return false
+	})
 }
 
 func (self *Dispatcher) AcceptClusterEvent(event *event.ClusterEvent) {
@@ -39,13 +47,25 @@ func (self *Dispatcher) AcceptClusterEvent(event *event.ClusterEvent) {
 	}()
 }
 
-func (self *Dispatcher) registerClusterEventHandler(val interface{}, _ map[string]interface{}) error {
+func (self *Dispatcher) registerClusterEventHandler(val interface{}, config map[string]interface{}) error {
 	handler, ok := val.(event.ClusterEventHandler)
 
 	if !ok {
 		return errors.Errorf("type %v doesn't implement ztna-core/ztna/controller/event/ClusterEventHandler interface.", reflect.TypeOf(val))
 	}
 
+	filter, err := event.ParseFilterOption(config, &event.ClusterEvent{})
+	if err != nil {
+		return errors.Wrap(err, "invalid cluster filter")
+	}
+
+	if filter != nil {
+		handler = &filteredClusterEventHandler{
+			filter:  filter,
+			wrapped: handler,
+		}
+	}
+
 	self.clusterEventHandlers.Append(handler)
 
 	return nil
@@ -56,3 +76,24 @@ func (self *Dispatcher) unregisterClusterEventHandler(val interface{}) {
 		self.RemoveClusterEventHandler(handler)
 	}
 }
+
+type filteredClusterEventHandler struct {
+	filter  *event.Filter
+	wrapped event.ClusterEventHandler
+}
+
+func (self *filteredClusterEventHandler) IsWrapping(value event.ClusterEventHandler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.ClusterEventHandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
+
+func (self *filteredClusterEventHandler) AcceptClusterEvent(evt *event.ClusterEvent) {
+	if self.filter.Matches(evt) {
+		self.wrapped.AcceptClusterEvent(evt)
+	}
+}
@@ -45,13 +45,25 @@ func (self *Dispatcher) AcceptConnectEvent(evt *event.ConnectEvent) {
 	}
 }
 
-func (self *Dispatcher) registerConnectEventHandler(val interface{}, _ map[string]interface{}) error {
+func (self *Dispatcher) registerConnectEventHandler(val interface{}, config map[string]interface{}) error {
 	handler, ok := val.(event.ConnectEventHandler)
 
 	if !ok {
 		return errors.Errorf("type %v doesn't implement ztna-core/ztna/controller/event/ConnectEventHandler interface.", reflect.TypeOf(val))
 	}
 
+	filter, err := event.ParseFilterOption(config, &event.ConnectEvent{})
+	if err != nil {
+		return errors.Wrap(err, "invalid connect filter")
+	}
+
+	if filter != nil {
+		handler = &filteredConnectEventHandler{
+			filter:  filter,
+			wrapped: handler,
+		}
+	}
+
 	self.AddConnectEventHandler(handler)
 	return nil
 }
@@ -61,3 +73,24 @@ func (self *Dispatcher) unregisterConnectEventHandler(val interface{}) {
 		self.RemoveConnectEventHandler(handler)
 	}
 }
+
+type filteredConnectEventHandler struct {
+	filter  *event.Filter
+	wrapped event.ConnectEventHandler
+}
+
+func (self *filteredConnectEventHandler) IsWrapping(value event.ConnectEventHandler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.ConnectEventHandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
+
+func (self *filteredConnectEventHandler) AcceptConnectEvent(evt *event.ConnectEvent) {
+	if self.filter.Matches(evt) {
+		self.wrapped.AcceptConnectEvent(evt)
+	}
+}
@@ -90,10 +90,16 @@ func (self *Dispatcher) registerEntityChangeEventHandler(val interface{}, option
 		}
 	}
 
+	eventFilter, err := event.ParseFilterOption(options, &event.EntityChangeEvent{})
+	if err != nil {
+		return errors.Wrap(err, "invalid entityChange filter")
+	}
+
 	filter := &entityChangeEventFilter{
 		EntityChangeEventHandler: handler,
 		propagateAlways:          propagateAlways,
 		includeParentEvents:      includeParentEvents,
+		filter:                   eventFilter,
 	}
 
 	if val, found := options["include"]; found {
@@ -339,6 +345,7 @@ type entityChangeEventFilter struct {
 	propagateAlways     bool
 	includeParentEvents bool
 	entityTypes         map[string]struct{}
+	filter              *event.Filter
 }
 
 func (self *entityChangeEventFilter) IsWrapping(value event.EntityChangeEventHandler) bool {
@@ -368,6 +375,11 @@ func (self *entityChangeEventFilter) AcceptEntityChangeEvent(evt *event.EntityCh
 		}
 	}
 
+	// commit events mark transaction boundaries, so they aren't subject to the filter expression
+	if self.filter != nil && evt.EventType != event.EntityChangeTypeCommitted && !self.filter.Matches(evt) {
+		return
+	}
+
 	if evt.EventType == event.EntityChangeTypeCommitted {
 		evt.IsParentEvent = nil
 		evt.EntityType = ""
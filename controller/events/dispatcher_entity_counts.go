@@ -25,11 +25,16 @@ import (
 )
 
 func (self *Dispatcher) AddEntityCountEventHandler(handler event.EntityCountEventHandler, interval time.Duration, onlyLeaderEvents bool) {
+	self.addEntityCountEventHandler(handler, interval, onlyLeaderEvents, nil)
+}
+
+func (self *Dispatcher) addEntityCountEventHandler(handler event.EntityCountEventHandler, interval time.Duration, onlyLeaderEvents bool, filter *event.Filter) {
 	self.entityCountEventHandlers.Append(&entityCountState{
 		handler:          handler,
 		onlyLeaderEvents: onlyLeaderEvents,
 		interval:         interval,
 		nextRun:          time.Now(),
+		filter:           filter,
 	})
 }
 
@@ -60,7 +65,9 @@ func (self *Dispatcher) generateEntityEvents() {
 						if event == nil {
 							event = self.generateEntityCountEvent()
 						}
-						state.handler.AcceptEntityCountEvent(event)
+						if state.filter == nil || state.filter.Matches(event) {
+							state.handler.AcceptEntityCountEvent(event)
+						}
 						state.nextRun = state.nextRun.Add(state.interval)
 					}
 				}
@@ -120,7 +127,12 @@ func (self *Dispatcher) registerEntityCountEventHandler(val interface{}, config
 		}
 	}
 
-	self.AddEntityCountEventHandler(handler, interval, !propagateAlways)
+	filter, err := event.ParseFilterOption(config, &event.EntityCountEvent{})
+	if err != nil {
+		return errors.Wrap(err, "invalid edge.entityCounts filter")
+	}
+
+	self.addEntityCountEventHandler(handler, interval, !propagateAlways, filter)
 
 	return nil
 }
@@ -136,4 +148,5 @@ type entityCountState struct {
 	onlyLeaderEvents bool
 	interval         time.Duration
 	nextRun          time.Time
+	filter           *event.Filter
 }
@@ -0,0 +1,87 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"ztna-core/ztna/controller/event"
+)
+
+type testCircuitEventHandler struct {
+	events chan *event.CircuitEvent
+}
+
+func (self *testCircuitEventHandler) AcceptCircuitEvent(evt *event.CircuitEvent) {
+	self.events <- evt
+}
+
+func Test_SubscriptionFilter(t *testing.T) {
+	req := require.New(t)
+
+	closeNotify := make(chan struct{})
+	defer close(closeNotify)
+	dispatcher := NewDispatcher(closeNotify)
+
+	handler := &testCircuitEventHandler{
+		events: make(chan *event.CircuitEvent, 10),
+	}
+
+	err := dispatcher.ProcessSubscriptions(handler, []*event.Subscription{
+		{
+			Type: event.CircuitEventsNs,
+			Options: map[string]interface{}{
+				"include": []interface{}{"created", "failed"},
+				"filter":  `serviceId = "x" and tags.env = "prod"`,
+			},
+		},
+	})
+	req.NoError(err)
+
+	dispatcher.AcceptCircuitEvent(&event.CircuitEvent{CircuitId: "1", EventType: event.CircuitFailed, ServiceId: "y"})
+	dispatcher.AcceptCircuitEvent(&event.CircuitEvent{CircuitId: "2", EventType: event.CircuitDeleted, ServiceId: "x", Tags: map[string]string{"env": "prod"}})
+	dispatcher.AcceptCircuitEvent(&event.CircuitEvent{CircuitId: "3", EventType: event.CircuitFailed, ServiceId: "x", Tags: map[string]string{"env": "dev"}})
+	dispatcher.AcceptCircuitEvent(&event.CircuitEvent{CircuitId: "4", EventType: event.CircuitFailed, ServiceId: "x", Tags: map[string]string{"env": "prod"}})
+
+	select {
+	case evt := <-handler.events:
+		req.Equal("4", evt.CircuitId)
+	case <-time.After(time.Second):
+		req.Fail("expected event to pass filter")
+	}
+
+	select {
+	case evt := <-handler.events:
+		req.Failf("unexpected event", "circuit %v should have been filtered", evt.CircuitId)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	dispatcher.RemoveAllSubscriptions(handler)
+	req.Empty(dispatcher.circuitEventHandlers.Value())
+
+	err = dispatcher.ProcessSubscriptions(handler, []*event.Subscription{
+		{
+			Type: event.CircuitEventsNs,
+			Options: map[string]interface{}{
+				"filter": `noSuchField = "x"`,
+			},
+		},
+	})
+	req.ErrorContains(err, "noSuchField")
+}
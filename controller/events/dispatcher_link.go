@@ -27,7 +27,15 @@ func (self *Dispatcher) AddLinkEventHandler(handler event.LinkEventHandler) {
 }
 
 func (self *Dispatcher) RemoveLinkEventHandler(handler event.LinkEventHandler) {
-	self.linkEventHandlers.Delete(handler)
+	self.linkEventHandlers.DeleteIf(func(val event.LinkEventHandler) bool {
+		if val == handler {
+			return true
+		}
+		if w, ok := val.(event.LinkEventHandlerWrapper); ok {
+			return w.IsWrapping(handler)
+		}
+		return false
+	})
 }
 
 func (self *Dispatcher) AcceptLinkEvent(event *event.LinkEvent) {
@@ -38,13 +46,25 @@ func (self *Dispatcher) AcceptLinkEvent(event *event.LinkEvent) {
 	}()
 }
 
-func (self *Dispatcher) registerLinkEventHandler(val interface{}, _ map[string]interface{}) error {
+func (self *Dispatcher) registerLinkEventHandler(val interface{}, config map[string]interface{}) error {
 	handler, ok := val.(event.LinkEventHandler)
 
 	if !ok {
 		return errors.Errorf("type %v doesn't implement ztna-core/ztna/controller/event/LinkEventHandler interface.", reflect.TypeOf(val))
 	}
 
+	filter, err := event.ParseFilterOption(config, &event.LinkEvent{})
+	if err != nil {
+		return errors.Wrap(err, "invalid fabric.links filter")
+	}
+
+	if filter != nil {
+		handler = &filteredLinkEventHandler{
+			filter:  filter,
+			wrapped: handler,
+		}
+	}
+
 	self.linkEventHandlers.Append(handler)
 
 	return nil
@@ -55,3 +75,24 @@ func (self *Dispatcher) unregisterLinkEventHandler(val interface{}) {
 		self.RemoveLinkEventHandler(handler)
 	}
 }
+
+type filteredLinkEventHandler struct {
+	filter  *event.Filter
+	wrapped event.LinkEventHandler
+}
+
+func (self *filteredLinkEventHandler) IsWrapping(value event.LinkEventHandler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.LinkEventHandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
+
+func (self *filteredLinkEventHandler) AcceptLinkEvent(evt *event.LinkEvent) {
+	if self.filter.Matches(evt) {
+		self.wrapped.AcceptLinkEvent(evt)
+	}
+}
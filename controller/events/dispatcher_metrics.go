@@ -121,6 +121,18 @@ func (self *Dispatcher) registerMetricsEventHandler(val interface{}, config map[
 		}
 	}
 
+	filter, err := event.ParseFilterOption(config, &event.MetricsEvent{})
+	if err != nil {
+		return errors.Wrap(err, "invalid metrics filter")
+	}
+
+	if filter != nil {
+		handler = &filteredMetricsEventHandler{
+			filter:  filter,
+			wrapped: handler,
+		}
+	}
+
 	adapter := self.NewFilteredMetricsAdapter(sourceFilter, metricFilter, handler)
 	self.AddMetricsMessageHandler(adapter)
 	return nil
@@ -285,3 +297,24 @@ func (self *filteringMetricsMessageAdapter) AcceptMetricsMsg(msg *metrics_pb.Met
 	}
 	self.dispatcher.convertMetricsMsgToEvents(msg, self.sourceFilter, self.metricFilter, self.handler)
 }
+
+type filteredMetricsEventHandler struct {
+	filter  *event.Filter
+	wrapped event.MetricsEventHandler
+}
+
+func (self *filteredMetricsEventHandler) IsWrapping(value event.MetricsEventHandler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.MetricsEventHandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
+
+func (self *filteredMetricsEventHandler) AcceptMetricsEvent(event *event.MetricsEvent) {
+	if self.filter.Matches(event) {
+		self.wrapped.AcceptMetricsEvent(event)
+	}
+}
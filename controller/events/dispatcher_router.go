@@ -30,7 +30,15 @@ func (self *Dispatcher) AddRouterEventHandler(handler event.RouterEventHandler)
 }
 
 func (self *Dispatcher) RemoveRouterEventHandler(handler event.RouterEventHandler) {
-	self.routerEventHandlers.Delete(handler)
+	self.routerEventHandlers.DeleteIf(func(val event.RouterEventHandler) bool {
+		if val == handler {
+			return true
+		}
+		if w, ok := val.(event.RouterEventHandlerWrapper); ok {
+			return w.IsWrapping(handler)
+		}
+		return false
+	})
 }
 
 func (self *Dispatcher) AcceptRouterEvent(event *event.RouterEvent) {
@@ -48,13 +56,25 @@ func (self *Dispatcher) initRouterEvents(n *network.Network) {
 	n.AddRouterPresenceHandler(routerEvtAdapter)
 }
 
-func (self *Dispatcher) registerRouterEventHandler(val interface{}, _ map[string]interface{}) error {
+func (self *Dispatcher) registerRouterEventHandler(val interface{}, config map[string]interface{}) error {
 	handler, ok := val.(event.RouterEventHandler)
 
 	if !ok {
 		return errors.Errorf("type %v doesn't implement ztna-core/ztna/controller/event/RouterEventHandler interface.", reflect.TypeOf(val))
 	}
 
+	filter, err := event.ParseFilterOption(config, &event.RouterEvent{})
+	if err != nil {
+		return errors.Wrap(err, "invalid fabric.routers filter")
+	}
+
+	if filter != nil {
+		handler = &filteredRouterEventHandler{
+			filter:  filter,
+			wrapped: handler,
+		}
+	}
+
 	self.AddRouterEventHandler(handler)
 
 	return nil
@@ -112,3 +132,24 @@ func (self *routerEventAdapter) routerChange(eventType event.RouterEventType, r
 		self.Dispatcher.AcceptConnectEvent(connectEvent)
 	}
 }
+
+type filteredRouterEventHandler struct {
+	filter  *event.Filter
+	wrapped event.RouterEventHandler
+}
+
+func (self *filteredRouterEventHandler) IsWrapping(value event.RouterEventHandler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.RouterEventHandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
+
+func (self *filteredRouterEventHandler) AcceptRouterEvent(evt *event.RouterEvent) {
+	if self.filter.Matches(evt) {
+		self.wrapped.AcceptRouterEvent(evt)
+	}
+}
@@ -45,13 +45,25 @@ func (self *Dispatcher) AcceptSdkEvent(evt *event.SdkEvent) {
 	}
 }
 
-func (self *Dispatcher) registerSdkEventHandler(val interface{}, _ map[string]interface{}) error {
+func (self *Dispatcher) registerSdkEventHandler(val interface{}, config map[string]interface{}) error {
 	handler, ok := val.(event.SdkEventHandler)
 
 	if !ok {
 		return errors.Errorf("type %v doesn't implement ztna-core/ztna/controller/event/SdkEventHandler interface.", reflect.TypeOf(val))
 	}
 
+	filter, err := event.ParseFilterOption(config, &event.SdkEvent{})
+	if err != nil {
+		return errors.Wrap(err, "invalid sdk filter")
+	}
+
+	if filter != nil {
+		handler = &filteredSdkEventHandler{
+			filter:  filter,
+			wrapped: handler,
+		}
+	}
+
 	self.AddSdkEventHandler(handler)
 	return nil
 }
@@ -61,3 +73,24 @@ func (self *Dispatcher) unregisterSdkEventHandler(val interface{}) {
 		self.RemoveSdkEventHandler(handler)
 	}
 }
+
+type filteredSdkEventHandler struct {
+	filter  *event.Filter
+	wrapped event.SdkEventHandler
+}
+
+func (self *filteredSdkEventHandler) IsWrapping(value event.SdkEventHandler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.SdkEventHandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
+
+func (self *filteredSdkEventHandler) AcceptSdkEvent(evt *event.SdkEvent) {
+	if self.filter.Matches(evt) {
+		self.wrapped.AcceptSdkEvent(evt)
+	}
+}
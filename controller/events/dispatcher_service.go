@@ -30,7 +30,15 @@ func (self *Dispatcher) AddServiceEventHandler(handler event.ServiceEventHandler
 }
 
 func (self *Dispatcher) RemoveServiceEventHandler(handler event.ServiceEventHandler) {
-	self.serviceEventHandlers.Delete(handler)
+	self.serviceEventHandlers.DeleteIf(func(val event.ServiceEventHandler) bool {
+		if val == handler {
+			return true
+		}
+		if w, ok := val.(event.ServiceEventHandlerWrapper); ok {
+			return w.IsWrapping(handler)
+		}
+		return false
+	})
 }
 
 func (self *Dispatcher) AcceptServiceEvent(event *event.ServiceEvent) {
@@ -41,12 +49,24 @@ func (self *Dispatcher) AcceptServiceEvent(event *event.ServiceEvent) {
 	}()
 }
 
-func (self *Dispatcher) registerServiceEventHandler(val interface{}, _ map[string]interface{}) error {
+func (self *Dispatcher) registerServiceEventHandler(val interface{}, config map[string]interface{}) error {
 	handler, ok := val.(event.ServiceEventHandler)
 	if !ok {
 		return errors.Errorf("type %v doesn't implement github.com/openziti/edge/event/ServiceEventHandler interface.", reflect.TypeOf(val))
 	}
 
+	filter, err := event.ParseFilterOption(config, &event.ServiceEvent{})
+	if err != nil {
+		return errors.Wrap(err, "invalid services filter")
+	}
+
+	if filter != nil {
+		handler = &filteredServiceEventHandler{
+			filter:  filter,
+			wrapped: handler,
+		}
+	}
+
 	self.AddServiceEventHandler(handler)
 	return nil
 }
@@ -94,3 +114,24 @@ func (self *serviceEventAdapter) AcceptMetrics(message *metrics_pb.MetricsMessag
 		}
 	}
 }
+
+type filteredServiceEventHandler struct {
+	filter  *event.Filter
+	wrapped event.ServiceEventHandler
+}
+
+func (self *filteredServiceEventHandler) IsWrapping(value event.ServiceEventHandler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.ServiceEventHandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
+
+func (self *filteredServiceEventHandler) AcceptServiceEvent(evt *event.ServiceEvent) {
+	if self.filter.Matches(evt) {
+		self.wrapped.AcceptServiceEvent(evt)
+	}
+}
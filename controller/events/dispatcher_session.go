@@ -105,7 +105,16 @@ func (self *Dispatcher) registerSessionEventHandler(val interface{}, config map[
 		}
 	}
 
-	if len(includeList) == 0 || (len(includeList) == 2 && stringz.ContainsAll(includeList, event.SessionEventTypeCreated, event.SessionEventTypeDeleted)) {
+	filter, err := event.ParseFilterOption(config, &event.SessionEvent{})
+	if err != nil {
+		return errors.Wrapf(err, "invalid %v filter", event.SessionEventNS)
+	}
+
+	if len(includeList) == 2 && stringz.ContainsAll(includeList, event.SessionEventTypeCreated, event.SessionEventTypeDeleted) {
+		includeList = nil
+	}
+
+	if len(includeList) == 0 && filter == nil {
 		self.AddSessionEventHandler(handler)
 	} else {
 		for _, include := range includeList {
@@ -117,6 +126,7 @@ func (self *Dispatcher) registerSessionEventHandler(val interface{}, config map[
 		self.AddSessionEventHandler(&sessionEventAdapter{
 			wrapped:     handler,
 			includeList: includeList,
+			filter:      filter,
 		})
 	}
 
@@ -132,10 +142,14 @@ func (self *Dispatcher) unregisterSessionEventHandler(val interface{}) {
 type sessionEventAdapter struct {
 	wrapped     event.SessionEventHandler
 	includeList []string
+	filter      *event.Filter
 }
 
 func (adapter *sessionEventAdapter) AcceptSessionEvent(event *event.SessionEvent) {
-	if stringz.Contains(adapter.includeList, event.EventType) {
+	if len(adapter.includeList) > 0 && !stringz.Contains(adapter.includeList, event.EventType) {
+		return
+	}
+	if adapter.filter == nil || adapter.filter.Matches(event) {
 		adapter.wrapped.AcceptSessionEvent(event)
 	}
 }
@@ -73,10 +73,19 @@ func (self *Dispatcher) registerTerminatorEventHandler(val interface{}, options
 		}
 	}
 
-	if propagateAlways {
+	filter, err := event.ParseFilterOption(options, &event.TerminatorEvent{})
+	if err != nil {
+		return errors.Wrap(err, "invalid fabric.terminators filter")
+	}
+
+	if propagateAlways && filter == nil {
 		self.AddTerminatorEventHandler(handler)
 	} else {
-		self.AddTerminatorEventHandler(&terminatorEventFilter{TerminatorEventHandler: handler})
+		self.AddTerminatorEventHandler(&terminatorEventFilter{
+			TerminatorEventHandler: handler,
+			propagateAlways:        propagateAlways,
+			filter:                 filter,
+		})
 	}
 
 	return nil
@@ -103,6 +112,8 @@ func (self *Dispatcher) initTerminatorEvents(n *network.Network) {
 
 type terminatorEventFilter struct {
 	event.TerminatorEventHandler
+	propagateAlways bool
+	filter          *event.Filter
 }
 
 func (self *terminatorEventFilter) IsWrapping(value event.TerminatorEventHandler) bool {
@@ -116,7 +127,10 @@ func (self *terminatorEventFilter) IsWrapping(value event.TerminatorEventHandler
 }
 
 func (self *terminatorEventFilter) AcceptTerminatorEvent(evt *event.TerminatorEvent) {
-	if !evt.IsModelEvent() || evt.PropagateIndicator {
+	if !self.propagateAlways && evt.IsModelEvent() && !evt.PropagateIndicator {
+		return
+	}
+	if self.filter == nil || self.filter.Matches(evt) {
 		self.TerminatorEventHandler.AcceptTerminatorEvent(evt)
 	}
 }
@@ -29,7 +29,15 @@ func (self *Dispatcher) AddUsageEventHandler(handler event.UsageEventHandler) {
 }
 
 func (self *Dispatcher) RemoveUsageEventHandler(handler event.UsageEventHandler) {
-	self.usageEventHandlers.Delete(handler)
+	self.usageEventHandlers.DeleteIf(func(val event.UsageEventHandler) bool {
+		if val == handler {
+			return true
+		}
+		if w, ok := val.(event.UsageEventHandlerWrapper); ok {
+			return w.IsWrapping(handler)
+		}
+		return false
+	})
 }
 
 func (self *Dispatcher) AddUsageEventV3Handler(handler event.UsageEventV3Handler) {
@@ -83,6 +91,19 @@ func (self *Dispatcher) registerUsageEventHandler(val interface{}, config map[st
 		if !ok {
 			return errors.Errorf("type %v doesn't implement ztna-core/ztna/controller/event/UsageEventHandler interface.", reflect.TypeOf(val))
 		}
+
+		filter, err := event.ParseFilterOption(config, &event.UsageEvent{})
+		if err != nil {
+			return errors.Wrap(err, "invalid fabric.usage filter")
+		}
+
+		if filter != nil {
+			handler = &filteredUsageEventHandler{
+				filter:  filter,
+				wrapped: handler,
+			}
+		}
+
 		self.AddUsageEventHandler(handler)
 	} else if version == 3 {
 		handler, ok := val.(event.UsageEventV3Handler)
@@ -90,8 +111,14 @@ func (self *Dispatcher) registerUsageEventHandler(val interface{}, config map[st
 			return errors.Errorf("type %v doesn't implement ztna-core/ztna/controller/event/UsageEventV3Handler interface.", reflect.TypeOf(val))
 		}
 
+		filter, err := event.ParseFilterOption(config, &event.UsageEventV3{})
+		if err != nil {
+			return errors.Wrap(err, "invalid fabric.usage filter")
+		}
+
+		var includes map[string]struct{}
 		if includeListVal, found := config["include"]; found {
-			includes := map[string]struct{}{}
+			includes = map[string]struct{}{}
 			if list, ok := includeListVal.([]interface{}); ok {
 				for _, includeVal := range list {
 					if include, ok := includeVal.(string); ok {
@@ -107,9 +134,12 @@ func (self *Dispatcher) registerUsageEventHandler(val interface{}, config map[st
 			if len(includes) == 0 {
 				return errors.Errorf("no values provided in include list for usage events, either drop includes stanza or provide at least one usage type to include")
 			}
+		}
 
+		if includes != nil || filter != nil {
 			handler = &filteredUsageV3EventHandler{
 				include: includes,
+				filter:  filter,
 				wrapped: handler,
 			}
 		}
@@ -226,8 +256,30 @@ func (self *usageEventAdapter) AcceptMetricsMsg(message *metrics_pb.MetricsMessa
 	}
 }
 
+type filteredUsageEventHandler struct {
+	filter  *event.Filter
+	wrapped event.UsageEventHandler
+}
+
+func (self *filteredUsageEventHandler) IsWrapping(value event.UsageEventHandler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.UsageEventHandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
+
+func (self *filteredUsageEventHandler) AcceptUsageEvent(event *event.UsageEvent) {
+	if self.filter.Matches(event) {
+		self.wrapped.AcceptUsageEvent(event)
+	}
+}
+
 type filteredUsageV3EventHandler struct {
 	include map[string]struct{}
+	filter  *event.Filter
 	wrapped event.UsageEventV3Handler
 }
 
@@ -242,6 +294,15 @@ func (self *filteredUsageV3EventHandler) IsWrapping(value event.UsageEventV3Hand
 }
 
 func (self *filteredUsageV3EventHandler) AcceptUsageEventV3(event *event.UsageEventV3) {
+	if self.filter != nil && !self.filter.Matches(event) {
+		return
+	}
+
+	if self.include == nil {
+		self.wrapped.AcceptUsageEventV3(event)
+		return
+	}
+
 	usage := map[string]uint64{}
 	for k, v := range event.Usage {
 		if _, found := self.include[k]; found {
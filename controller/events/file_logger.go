@@ -25,26 +25,40 @@ import (
 	"strings"
 )
 
-type fabricFormatterFactory struct{}
+// fabricFormatterFactory creates formatters for event handlers. The handler configuration is used to
+// look up any format specific options
+type fabricFormatterFactory struct {
+	config map[interface{}]interface{}
+}
 
 func (f fabricFormatterFactory) NewLoggingHandler(format string, buffer int, out io.WriteCloser) (interface{}, error) {
-	if strings.EqualFold(format, "json") {
+	if strings.EqualFold(format, FormatJson) {
 		return NewJsonFormatter(buffer, NewWriterEventSink(out)), nil
 	}
 
-	return nil, errors.Errorf("invalid 'format' for event log output file: %v", format)
+	encoder, err := newEventEncoder(format, f.config)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid 'format' for event log output file: %v", format)
+	}
+
+	// binary formats are self-delimiting, so appending newlines would corrupt the output
+	if nlWriter, ok := out.(*newlineWriter); ok && encoder.IsBinary() {
+		out = nlWriter.out
+	}
+
+	return NewEncodingFormatter(buffer, NewWriterEventSink(out), encoder), nil
 }
 
 type StdOutLoggerFactory struct{}
 
 func (StdOutLoggerFactory) NewEventHandler(config map[interface{}]interface{}) (interface{}, error) {
-	return NewFileEventLogger(fabricFormatterFactory{}, true, config)
+	return NewFileEventLogger(fabricFormatterFactory{config: config}, true, config)
 }
 
 type FileEventLoggerFactory struct{}
 
 func (FileEventLoggerFactory) NewEventHandler(config map[interface{}]interface{}) (interface{}, error) {
-	return NewFileEventLogger(fabricFormatterFactory{}, false, config)
+	return NewFileEventLogger(fabricFormatterFactory{config: config}, false, config)
 }
 
 func NewFileEventLogger(formatterFactory LoggingHandlerFactory, stdout bool, config map[interface{}]interface{}) (interface{}, error) {
@@ -46,26 +46,57 @@ func (self WriterEventSink) AcceptFormattedEvent(_ string, formattedEvent []byte
 	}
 }
 
+func (self WriterEventSink) AcceptRoutedFormattedEvent(eventType string, namespace string, entityId string, formattedEvent []byte) {
+	routedWriter, ok := self.out.(RoutedEventWriter)
+	if !ok {
+		self.AcceptFormattedEvent(eventType, formattedEvent)
+		return
+	}
+
+	if err := routedWriter.WriteRoutedEvent(namespace, entityId, formattedEvent); err != nil {
+		pfxlog.Logger().WithError(err).Error("failed to output event")
+	}
+}
+
+// A RoutedEventSink is a FormattedEventSink which can make use of the namespace and entity id of an event
+type RoutedEventSink interface {
+	AcceptRoutedFormattedEvent(eventType string, namespace string, entityId string, formattedEvent []byte)
+}
+
+// A RoutedEventWriter is an output which can make use of the namespace and entity id of an event, for example
+// to pick a message subject or a topic partition
+type RoutedEventWriter interface {
+	WriteRoutedEvent(namespace string, entityId string, formattedEvent []byte) error
+}
+
 type FormatterEvent interface {
 	GetEventType() string
 	Format() ([]byte, error)
 }
 
+// A RoutableEvent is a FormatterEvent which can report the namespace of the event and the id of the
+// entity the event pertains to
+type RoutableEvent interface {
+	GetNamespace() string
+	GetEntityId() string
+}
+
 type BaseFormatter struct {
 	closed      atomic.Bool
 	closeNotify chan struct{}
 	events      chan FormatterEvent
 	sink        event.FormattedEventSink
+	encoder     EventEncoder
 }
 
 func (f *BaseFormatter) Run() {
 	for {
 		select {
 		case evt := <-f.events:
-			if formattedEvent, err := evt.Format(); err != nil {
+			if formattedEvent, err := f.format(evt); err != nil {
 				pfxlog.Logger().WithError(err).Errorf("failed to output event of type %v", reflect.TypeOf(evt))
 			} else {
-				f.sink.AcceptFormattedEvent(evt.GetEventType(), formattedEvent)
+				f.acceptFormattedEvent(evt, formattedEvent)
 			}
 		case <-f.closeNotify:
 			return
@@ -73,6 +104,23 @@ func (f *BaseFormatter) Run() {
 	}
 }
 
+func (f *BaseFormatter) format(evt FormatterEvent) ([]byte, error) {
+	if f.encoder != nil {
+		return f.encoder.Encode(evt)
+	}
+	return evt.Format()
+}
+
+func (f *BaseFormatter) acceptFormattedEvent(evt FormatterEvent, formattedEvent []byte) {
+	if routedSink, ok := f.sink.(RoutedEventSink); ok {
+		if routableEvent, ok := evt.(RoutableEvent); ok {
+			routedSink.AcceptRoutedFormattedEvent(evt.GetEventType(), routableEvent.GetNamespace(), routableEvent.GetEntityId(), formattedEvent)
+			return
+		}
+	}
+	f.sink.AcceptFormattedEvent(evt.GetEventType(), formattedEvent)
+}
+
 func (f *BaseFormatter) Close() error {
 	if f.closed.CompareAndSwap(false, true) {
 		close(f.closeNotify)
@@ -105,6 +153,14 @@ func (event *JsonCircuitEvent) Format() ([]byte, error) {
 	return MarshalJson(event)
 }
 
+func (event *JsonCircuitEvent) GetNamespace() string {
+	return event.Namespace
+}
+
+func (event *JsonCircuitEvent) GetEntityId() string {
+	return event.CircuitId
+}
+
 type JsonLinkEvent event.LinkEvent
 
 func (event *JsonLinkEvent) GetEventType() string {
@@ -115,6 +171,14 @@ func (event *JsonLinkEvent) Format() ([]byte, error) {
 	return MarshalJson(event)
 }
 
+func (event *JsonLinkEvent) GetNamespace() string {
+	return event.Namespace
+}
+
+func (event *JsonLinkEvent) GetEntityId() string {
+	return event.LinkId
+}
+
 type JsonMetricsEvent event.MetricsEvent
 
 func (event *JsonMetricsEvent) GetEventType() string {
@@ -125,6 +189,14 @@ func (event *JsonMetricsEvent) Format() ([]byte, error) {
 	return MarshalJson(event)
 }
 
+func (event *JsonMetricsEvent) GetNamespace() string {
+	return event.Namespace
+}
+
+func (event *JsonMetricsEvent) GetEntityId() string {
+	return event.SourceEntityId
+}
+
 type JsonRouterEvent event.RouterEvent
 
 func (event *JsonRouterEvent) GetEventType() string {
@@ -135,6 +207,14 @@ func (event *JsonRouterEvent) Format() ([]byte, error) {
 	return MarshalJson(event)
 }
 
+func (event *JsonRouterEvent) GetNamespace() string {
+	return event.Namespace
+}
+
+func (event *JsonRouterEvent) GetEntityId() string {
+	return event.RouterId
+}
+
 type JsonServiceEvent event.ServiceEvent
 
 func (event *JsonServiceEvent) GetEventType() string {
@@ -145,6 +225,14 @@ func (event *JsonServiceEvent) Format() ([]byte, error) {
 	return MarshalJson(event)
 }
 
+func (event *JsonServiceEvent) GetNamespace() string {
+	return event.Namespace
+}
+
+func (event *JsonServiceEvent) GetEntityId() string {
+	return event.ServiceId
+}
+
 type JsonTerminatorEvent event.TerminatorEvent
 
 func (event *JsonTerminatorEvent) GetEventType() string {
@@ -155,6 +243,14 @@ func (event *JsonTerminatorEvent) Format() ([]byte, error) {
 	return MarshalJson(event)
 }
 
+func (event *JsonTerminatorEvent) GetNamespace() string {
+	return event.Namespace
+}
+
+func (event *JsonTerminatorEvent) GetEntityId() string {
+	return event.TerminatorId
+}
+
 type JsonUsageEvent event.UsageEvent
 
 func (event *JsonUsageEvent) GetEventType() string {
@@ -165,6 +261,14 @@ func (event *JsonUsageEvent) Format() ([]byte, error) {
 	return MarshalJson(event)
 }
 
+func (event *JsonUsageEvent) GetNamespace() string {
+	return event.Namespace
+}
+
+func (event *JsonUsageEvent) GetEntityId() string {
+	return event.CircuitId
+}
+
 func (event *JsonUsageEventV3) GetEventType() string {
 	return "usage.v3"
 }
@@ -175,6 +279,14 @@ func (event *JsonUsageEventV3) Format() ([]byte, error) {
 	return MarshalJson(event)
 }
 
+func (event *JsonUsageEventV3) GetNamespace() string {
+	return event.Namespace
+}
+
+func (event *JsonUsageEventV3) GetEntityId() string {
+	return event.CircuitId
+}
+
 type JsonClusterEvent event.ClusterEvent
 
 func (event *JsonClusterEvent) GetEventType() string {
@@ -185,6 +297,32 @@ func (event *JsonClusterEvent) Format() ([]byte, error) {
 	return MarshalJson(event)
 }
 
+func (event *JsonClusterEvent) GetNamespace() string {
+	return event.Namespace
+}
+
+func (event *JsonClusterEvent) GetEntityId() string {
+	return event.EventSrcId
+}
+
+type JsonCaRevocationEvent event.CaRevocationEvent
+
+func (event *JsonCaRevocationEvent) GetEventType() string {
+	return "caRevocation"
+}
+
+func (event *JsonCaRevocationEvent) Format() ([]byte, error) {
+	return MarshalJson(event)
+}
+
+func (event *JsonCaRevocationEvent) GetNamespace() string {
+	return event.Namespace
+}
+
+func (event *JsonCaRevocationEvent) GetEntityId() string {
+	return event.CaId
+}
+
 type JsonConnectEvent event.ConnectEvent
 
 func (event *JsonConnectEvent) GetEventType() string {
@@ -195,6 +333,14 @@ func (event *JsonConnectEvent) Format() ([]byte, error) {
 	return MarshalJson(event)
 }
 
+func (event *JsonConnectEvent) GetNamespace() string {
+	return event.Namespace
+}
+
+func (event *JsonConnectEvent) GetEntityId() string {
+	return event.SrcId
+}
+
 type JsonSdkEvent event.SdkEvent
 
 func (event *JsonSdkEvent) GetEventType() string {
@@ -205,6 +351,14 @@ func (event *JsonSdkEvent) Format() ([]byte, error) {
 	return MarshalJson(event)
 }
 
+func (event *JsonSdkEvent) GetNamespace() string {
+	return event.Namespace
+}
+
+func (event *JsonSdkEvent) GetEntityId() string {
+	return event.IdentityId
+}
+
 type JsonEntityChangeEvent event.EntityChangeEvent
 
 func (event *JsonEntityChangeEvent) GetEventType() string {
@@ -215,6 +369,19 @@ func (event *JsonEntityChangeEvent) Format() ([]byte, error) {
 	return MarshalJson(event)
 }
 
+func (event *JsonEntityChangeEvent) GetNamespace() string {
+	return event.Namespace
+}
+
+func (event *JsonEntityChangeEvent) GetEntityId() string {
+	for _, state := range []any{event.FinalState, event.InitialState} {
+		if entity, ok := state.(interface{ GetId() string }); ok {
+			return entity.GetId()
+		}
+	}
+	return ""
+}
+
 type JsonSessionEvent event.SessionEvent
 
 func (event *JsonSessionEvent) GetEventType() string {
@@ -225,6 +392,14 @@ func (event *JsonSessionEvent) Format() ([]byte, error) {
 	return MarshalJson(event)
 }
 
+func (event *JsonSessionEvent) GetNamespace() string {
+	return event.Namespace
+}
+
+func (event *JsonSessionEvent) GetEntityId() string {
+	return event.Id
+}
+
 type JsonApiSessionEvent event.ApiSessionEvent
 
 func (event *JsonApiSessionEvent) GetEventType() string {
@@ -235,6 +410,14 @@ func (event *JsonApiSessionEvent) Format() ([]byte, error) {
 	return MarshalJson(event)
 }
 
+func (event *JsonApiSessionEvent) GetNamespace() string {
+	return event.Namespace
+}
+
+func (event *JsonApiSessionEvent) GetEntityId() string {
+	return event.Id
+}
+
 type JsonEntityCountEvent event.EntityCountEvent
 
 func (event *JsonEntityCountEvent) GetEventType() string {
@@ -245,6 +428,14 @@ func (event *JsonEntityCountEvent) Format() ([]byte, error) {
 	return MarshalJson(event)
 }
 
+func (event *JsonEntityCountEvent) GetNamespace() string {
+	return event.Namespace
+}
+
+func (event *JsonEntityCountEvent) GetEntityId() string {
+	return event.EventSrcId
+}
+
 func NewJsonFormatter(queueDepth int, sink event.FormattedEventSink) *JsonFormatter {
 	result := &JsonFormatter{
 		BaseFormatter: BaseFormatter{
@@ -305,6 +496,10 @@ func (formatter *JsonFormatter) AcceptSdkEvent(evt *event.SdkEvent) {
 	formatter.AcceptLoggingEvent((*JsonSdkEvent)(evt))
 }
 
+func (formatter *JsonFormatter) AcceptCaRevocationEvent(evt *event.CaRevocationEvent) {
+	formatter.AcceptLoggingEvent((*JsonCaRevocationEvent)(evt))
+}
+
 func (formatter *JsonFormatter) AcceptEntityChangeEvent(evt *event.EntityChangeEvent) {
 	formatter.AcceptLoggingEvent((*JsonEntityChangeEvent)(evt))
 }
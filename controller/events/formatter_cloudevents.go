@@ -0,0 +1,75 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CloudEvent is a CloudEvents 1.0 event in the structured JSON content mode
+type CloudEvent struct {
+	SpecVersion     string          `json:"specversion"`
+	Id              string          `json:"id"`
+	Source          string          `json:"source"`
+	Type            string          `json:"type"`
+	Subject         string          `json:"subject,omitempty"`
+	Time            string          `json:"time"`
+	DataContentType string          `json:"datacontenttype"`
+	Data            json.RawMessage `json:"data"`
+}
+
+// cloudEventsEncoder wraps the JSON representation of events in a CloudEvents envelope. The source is
+// the configured source followed by the id of the controller which emitted the event. The type is
+// the configured prefix followed by the event namespace and event type, ex: org.openziti.fabric.circuits.created
+type cloudEventsEncoder struct {
+	source     string
+	typePrefix string
+}
+
+func (self *cloudEventsEncoder) Encode(evt FormatterEvent) ([]byte, error) {
+	envelope, err := newEventEnvelope(evt)
+	if err != nil {
+		return nil, err
+	}
+
+	source := self.source
+	if envelope.srcId != "" {
+		source += "/" + envelope.srcId
+	}
+
+	return json.Marshal(&CloudEvent{
+		SpecVersion:     "1.0",
+		Id:              uuid.NewString(),
+		Source:          source,
+		Type:            self.typePrefix + "." + envelope.qualifiedType(),
+		Subject:         envelope.entityId,
+		Time:            envelope.timestamp.UTC().Format(time.RFC3339Nano),
+		DataContentType: "application/json",
+		Data:            envelope.json,
+	})
+}
+
+func (self *cloudEventsEncoder) IsBinary() bool {
+	return false
+}
+
+func (self *cloudEventsEncoder) ContentType() string {
+	return "application/cloudevents+json"
+}
@@ -0,0 +1,186 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"ztna-core/ztna/controller/event"
+)
+
+const (
+	FormatJson        = "json"
+	FormatCloudEvents = "cloudevents"
+	FormatCef         = "cef"
+	FormatLeef        = "leef"
+	FormatProtobuf    = "protobuf"
+)
+
+// An EventEncoder turns events into a wire format other than the default JSON representation
+type EventEncoder interface {
+	Encode(evt FormatterEvent) ([]byte, error)
+
+	// IsBinary returns true if the encoded events must not be altered, for example by having
+	// newlines appended
+	IsBinary() bool
+
+	// ContentType returns the media type of a single encoded event
+	ContentType() string
+}
+
+// NewEncodingFormatter returns a formatter which accepts all event types and uses the given
+// EventEncoder to format them
+func NewEncodingFormatter(queueDepth int, sink event.FormattedEventSink, encoder EventEncoder) *JsonFormatter {
+	result := &JsonFormatter{
+		BaseFormatter: BaseFormatter{
+			events:      make(chan FormatterEvent, queueDepth),
+			closeNotify: make(chan struct{}),
+			sink:        sink,
+			encoder:     encoder,
+		},
+	}
+	go result.Run()
+	return result
+}
+
+// newEventEncoder returns the encoder for the given format, or nil if the format is plain JSON. Format specific
+// settings are read from the optional formatOptions map of the handler configuration
+func newEventEncoder(format string, config map[interface{}]interface{}) (EventEncoder, error) {
+	var options map[interface{}]interface{}
+	if val, found := config["formatOptions"]; found {
+		var ok bool
+		if options, ok = val.(map[interface{}]interface{}); !ok {
+			return nil, errors.New("event handler 'formatOptions' must be a map")
+		}
+	}
+
+	getOption := func(name string, defaultValue string) string {
+		if val, found := options[name]; found {
+			return fmt.Sprintf("%v", val)
+		}
+		return defaultValue
+	}
+
+	switch strings.ToLower(format) {
+	case FormatJson:
+		return nil, nil
+	case FormatCloudEvents:
+		return &cloudEventsEncoder{
+			source:     getOption("source", "/ziti/controller"),
+			typePrefix: getOption("typePrefix", "org.openziti"),
+		}, nil
+	case FormatCef:
+		return newCefEncoder(getOption("vendor", siemDefaultVendor), getOption("product", siemDefaultProduct)), nil
+	case FormatLeef:
+		return newLeefEncoder(getOption("vendor", siemDefaultVendor), getOption("product", siemDefaultProduct)), nil
+	case FormatProtobuf:
+		return protobufEncoder{}, nil
+	}
+
+	return nil, errors.Errorf("invalid event format: %v", format)
+}
+
+// IsBinaryEventFormat returns true if events in the given format must be framed by the format itself
+func IsBinaryEventFormat(format string) bool {
+	return strings.EqualFold(format, FormatProtobuf)
+}
+
+// EventFormatContentType returns the media type of a single event in the given format, so sinks can label
+// what they publish
+func EventFormatContentType(format string) string {
+	encoder, err := newEventEncoder(format, nil)
+	if err != nil || encoder == nil {
+		return "application/json"
+	}
+	return encoder.ContentType()
+}
+
+// eventEnvelope holds the common event fields, extracted from the JSON representation of an event, which
+// formats that wrap or flatten events need
+type eventEnvelope struct {
+	eventType string
+	namespace string
+	subType   string
+	srcId     string
+	entityId  string
+	timestamp time.Time
+	json      []byte
+	data      map[string]interface{}
+}
+
+func newEventEnvelope(evt FormatterEvent) (*eventEnvelope, error) {
+	buf, err := evt.Format()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &eventEnvelope{
+		eventType: evt.GetEventType(),
+		json:      buf,
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(buf))
+	decoder.UseNumber()
+	if err = decoder.Decode(&result.data); err != nil {
+		return nil, errors.Wrapf(err, "unable to decode event of type %v", result.eventType)
+	}
+
+	if routable, ok := evt.(RoutableEvent); ok {
+		result.namespace = routable.GetNamespace()
+		result.entityId = routable.GetEntityId()
+	}
+
+	result.srcId = fmt.Sprintf("%v", result.getValue("event_src_id", ""))
+	result.subType = fmt.Sprintf("%v", result.getValue("event_type", result.getValue("eventType", "")))
+
+	result.timestamp = time.Now()
+	if ts, ok := result.data["timestamp"].(string); ok {
+		if parsed, err := time.Parse(time.RFC3339Nano, ts); err == nil {
+			result.timestamp = parsed
+		}
+	} else if ts, ok := result.data["interval_start_utc"].(json.Number); ok {
+		if secs, err := ts.Int64(); err == nil {
+			result.timestamp = time.Unix(secs, 0)
+		}
+	}
+
+	return result, nil
+}
+
+func (self *eventEnvelope) getValue(key string, defaultValue interface{}) interface{} {
+	if val, found := self.data[key]; found && val != nil {
+		return val
+	}
+	return defaultValue
+}
+
+// qualifiedType returns the namespace and event sub-type, ex: fabric.circuits.created
+func (self *eventEnvelope) qualifiedType() string {
+	result := self.namespace
+	if result == "" {
+		result = self.eventType
+	}
+	if self.subType != "" {
+		result += "." + self.subType
+	}
+	return result
+}
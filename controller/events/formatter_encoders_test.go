@@ -0,0 +1,183 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"ztna-core/ztna/common/pb/event_pb"
+	"ztna-core/ztna/controller/event"
+)
+
+func newTestCircuitEvent() *JsonCircuitEvent {
+	failureCause := "NO_TERMINATORS"
+	return &JsonCircuitEvent{
+		Namespace:    event.CircuitEventsNs,
+		EventType:    event.CircuitFailed,
+		EventSrcId:   "ctrl1",
+		CircuitId:    "circuit1",
+		Timestamp:    time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC),
+		ServiceId:    "svc|1",
+		FailureCause: &failureCause,
+		Tags: map[string]string{
+			"clientId": "a=b",
+		},
+	}
+}
+
+func Test_CloudEventsEncoder(t *testing.T) {
+	req := require.New(t)
+
+	encoder, err := newEventEncoder(FormatCloudEvents, map[interface{}]interface{}{
+		"formatOptions": map[interface{}]interface{}{
+			"source": "/ziti/test",
+		},
+	})
+	req.NoError(err)
+
+	buf, err := encoder.Encode(newTestCircuitEvent())
+	req.NoError(err)
+
+	ce := &CloudEvent{}
+	req.NoError(json.Unmarshal(buf, ce))
+	req.Equal("1.0", ce.SpecVersion)
+	req.NotEmpty(ce.Id)
+	req.Equal("/ziti/test/ctrl1", ce.Source)
+	req.Equal("org.openziti.fabric.circuits.failed", ce.Type)
+	req.Equal("circuit1", ce.Subject)
+	req.Equal("2024-05-01T12:30:00Z", ce.Time)
+
+	data := &event.CircuitEvent{}
+	req.NoError(json.Unmarshal(ce.Data, data))
+	req.Equal("circuit1", data.CircuitId)
+}
+
+func Test_CefEncoder(t *testing.T) {
+	req := require.New(t)
+
+	encoder, err := newEventEncoder(FormatCef, nil)
+	req.NoError(err)
+
+	buf, err := encoder.Encode(newTestCircuitEvent())
+	req.NoError(err)
+
+	line := string(buf)
+	req.True(strings.HasPrefix(line, "CEF:0|OpenZiti|ziti-controller|"), line)
+	req.Contains(line, "|fabric.circuits.failed|fabric circuits failed|7|rt=1714566600000 ")
+	req.Contains(line, " externalId=circuit1 ")
+	req.Contains(line, ` service_id=svc|1 `)
+	req.Contains(line, ` tags.clientId=a\=b `)
+}
+
+func Test_LeefEncoder(t *testing.T) {
+	req := require.New(t)
+
+	encoder, err := newEventEncoder(FormatLeef, map[interface{}]interface{}{
+		"formatOptions": map[interface{}]interface{}{
+			"vendor": "Acme",
+		},
+	})
+	req.NoError(err)
+
+	buf, err := encoder.Encode(newTestCircuitEvent())
+	req.NoError(err)
+
+	parts := strings.SplitN(string(buf), "|", 7)
+	req.Len(parts, 7)
+	req.Equal("LEEF:2.0", parts[0])
+	req.Equal("Acme", parts[1])
+	req.Equal("fabric.circuits.failed", parts[4])
+	req.Equal("x09", parts[5])
+
+	attrs := map[string]string{}
+	for _, attr := range strings.Split(parts[6], "\t") {
+		k, v, found := strings.Cut(attr, "=")
+		req.True(found, attr)
+		attrs[k] = v
+	}
+	req.Equal("2024-05-01T12:30:00.000Z", attrs["devTime"])
+	req.Equal("7", attrs["sev"])
+	req.Equal("circuit1", attrs["resource"])
+	req.Equal("a=b", attrs["tags.clientId"])
+}
+
+func Test_ProtobufEncoder(t *testing.T) {
+	req := require.New(t)
+
+	encoder, err := newEventEncoder(FormatProtobuf, nil)
+	req.NoError(err)
+	req.True(encoder.IsBinary())
+
+	usage, err := encoder.Encode(&JsonUsageEventV3{
+		Namespace:        event.UsageEventsNs,
+		Version:          3,
+		EventSrcId:       "ctrl1",
+		SourceId:         "router1",
+		CircuitId:        "circuit1",
+		Usage:            map[string]uint64{"ingress.rx": 100},
+		IntervalStartUTC: 1714566600,
+		IntervalLength:   60,
+	})
+	req.NoError(err)
+
+	circuit, err := encoder.Encode(newTestCircuitEvent())
+	req.NoError(err)
+
+	stream := append(usage, circuit...)
+
+	var decoded []*event_pb.Event
+	for len(stream) > 0 {
+		size, n := protowire.ConsumeVarint(stream)
+		req.True(n > 0)
+		msg := &event_pb.Event{}
+		req.NoError(proto.Unmarshal(stream[n:n+int(size)], msg))
+		decoded = append(decoded, msg)
+		stream = stream[n+int(size):]
+	}
+
+	req.Len(decoded, 2)
+	req.Equal(event.UsageEventsNs, decoded[0].Namespace)
+	req.Equal("circuit1", decoded[0].EntityId)
+	req.Equal(uint64(100), decoded[0].GetUsage().Usage["ingress.rx"])
+	req.Equal(time.Unix(1714566600, 0).UnixNano(), decoded[0].Timestamp)
+
+	req.Equal("fabric.circuits.failed", decoded[1].EventType)
+	data := &event.CircuitEvent{}
+	req.NoError(json.Unmarshal(decoded[1].GetJson(), data))
+	req.Equal("svc|1", data.ServiceId)
+}
+
+func Test_EventFormatContentType(t *testing.T) {
+	req := require.New(t)
+
+	req.Equal("application/json", EventFormatContentType(FormatJson))
+	req.Equal("application/cloudevents+json", EventFormatContentType(FormatCloudEvents))
+	req.Equal("text/plain", EventFormatContentType(FormatCef))
+	req.Equal("text/plain", EventFormatContentType(FormatLeef))
+	req.Equal("application/x-protobuf", EventFormatContentType(FormatProtobuf))
+
+	req.Equal("application/x-ndjson", httpBatchContentType(FormatJson))
+	req.Equal("application/x-ndjson", httpBatchContentType(FormatCloudEvents))
+	req.Equal("text/plain", httpBatchContentType(FormatCef))
+	req.Equal("application/x-protobuf", httpBatchContentType(FormatProtobuf))
+}
@@ -0,0 +1,143 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"ztna-core/ztna/common/pb/event_pb"
+)
+
+// protobufEncoder encodes events as event_pb.Event messages, each prefixed with its varint encoded length.
+// Metrics and usage events are encoded natively, everything else is carried as JSON
+type protobufEncoder struct{}
+
+func (self protobufEncoder) Encode(evt FormatterEvent) ([]byte, error) {
+	msg := &event_pb.Event{
+		EventType: evt.GetEventType(),
+	}
+
+	if routable, ok := evt.(RoutableEvent); ok {
+		msg.Namespace = routable.GetNamespace()
+		msg.EntityId = routable.GetEntityId()
+	}
+
+	switch e := evt.(type) {
+	case *JsonMetricsEvent:
+		msg.EventSrcId = e.EventSrcId
+		msg.Timestamp = e.Timestamp.UnixNano()
+		msg.Payload = &event_pb.Event_Metrics{
+			Metrics: &event_pb.MetricsEvent{
+				MetricType:     e.MetricType,
+				SourceAppId:    e.SourceAppId,
+				SourceEntityId: e.SourceEntityId,
+				Version:        e.Version,
+				Metric:         e.Metric,
+				Values:         toProtobufMetricValues(e.Metrics),
+				Tags:           e.Tags,
+				SourceEventId:  e.SourceEventId,
+			},
+		}
+	case *JsonUsageEventV3:
+		msg.EventSrcId = e.EventSrcId
+		msg.Timestamp = time.Unix(e.IntervalStartUTC, 0).UnixNano()
+		msg.Payload = &event_pb.Event_Usage{
+			Usage: &event_pb.UsageEvent{
+				Version:          e.Version,
+				SourceId:         e.SourceId,
+				CircuitId:        e.CircuitId,
+				Usage:            e.Usage,
+				IntervalStartUtc: e.IntervalStartUTC,
+				IntervalLength:   e.IntervalLength,
+				Tags:             e.Tags,
+			},
+		}
+	case *JsonUsageEvent:
+		msg.EventType = e.EventType
+		msg.EventSrcId = e.EventSrcId
+		msg.Timestamp = time.Unix(e.IntervalStartUTC, 0).UnixNano()
+		msg.Payload = &event_pb.Event_Usage{
+			Usage: &event_pb.UsageEvent{
+				Version:          e.Version,
+				SourceId:         e.SourceId,
+				CircuitId:        e.CircuitId,
+				Usage:            map[string]uint64{e.EventType: e.Usage},
+				IntervalStartUtc: e.IntervalStartUTC,
+				IntervalLength:   e.IntervalLength,
+				Tags:             e.Tags,
+			},
+		}
+	default:
+		envelope, err := newEventEnvelope(evt)
+		if err != nil {
+			return nil, err
+		}
+		msg.EventType = envelope.qualifiedType()
+		msg.EventSrcId = envelope.srcId
+		msg.Timestamp = envelope.timestamp.UnixNano()
+		msg.Payload = &event_pb.Event_Json{
+			Json: envelope.json,
+		}
+	}
+
+	buf, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	result := protowire.AppendVarint(make([]byte, 0, len(buf)+binaryVarintMaxLen), uint64(len(buf)))
+	return append(result, buf...), nil
+}
+
+func (self protobufEncoder) IsBinary() bool {
+	return true
+}
+
+func (self protobufEncoder) ContentType() string {
+	return "application/x-protobuf"
+}
+
+const binaryVarintMaxLen = 10
+
+func toProtobufMetricValues(values map[string]interface{}) map[string]float64 {
+	result := make(map[string]float64, len(values))
+	for k, v := range values {
+		switch val := v.(type) {
+		case int64:
+			result[k] = float64(val)
+		case int32:
+			result[k] = float64(val)
+		case int:
+			result[k] = float64(val)
+		case uint64:
+			result[k] = float64(val)
+		case float64:
+			result[k] = val
+		case float32:
+			result[k] = float64(val)
+		default:
+			if f, err := strconv.ParseFloat(fmt.Sprintf("%v", val), 64); err == nil {
+				result[k] = f
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,234 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"ztna-core/ztna/common/version"
+)
+
+const (
+	siemDefaultVendor  = "OpenZiti"
+	siemDefaultProduct = "ziti-controller"
+
+	siemSeverityDefault = 3
+	siemSeverityFailure = 7
+)
+
+var (
+	cefHeaderEscaper    = strings.NewReplacer(`\`, `\\`, `|`, `\|`, "\r", " ", "\n", " ")
+	cefExtensionEscaper = strings.NewReplacer(`\`, `\\`, `=`, `\=`, "\r", `\r`, "\n", `\n`)
+	leefHeaderEscaper   = strings.NewReplacer(`|`, `\|`, "\r", " ", "\n", " ")
+	leefValueEscaper    = strings.NewReplacer("\t", " ", "\r", " ", "\n", " ")
+)
+
+type siemField struct {
+	key   string
+	value string
+}
+
+// siemEncoder contains the shared logic for the line based SIEM formats, CEF and LEEF. Events are flattened
+// so that nested fields become dotted keys, ex: path.ingress_id
+type siemEncoder struct {
+	vendor  string
+	product string
+	version string
+}
+
+func (self *siemEncoder) severity(envelope *eventEnvelope) int {
+	if strings.Contains(strings.ToLower(envelope.subType), "fail") {
+		return siemSeverityFailure
+	}
+	return siemSeverityDefault
+}
+
+func (self *siemEncoder) flatten(envelope *eventEnvelope) []siemField {
+	var result []siemField
+	flattenSiemFields("", envelope.data, &result)
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].key < result[j].key
+	})
+	return result
+}
+
+func flattenSiemFields(prefix string, v interface{}, result *[]siemField) {
+	switch val := v.(type) {
+	case nil:
+		return
+	case map[string]interface{}:
+		for k, child := range val {
+			key := sanitizeSiemKey(k)
+			if prefix != "" {
+				key = prefix + "." + key
+			}
+			flattenSiemFields(key, child, result)
+		}
+	case []interface{}:
+		buf, _ := json.Marshal(val)
+		*result = append(*result, siemField{key: prefix, value: string(buf)})
+	default:
+		*result = append(*result, siemField{key: prefix, value: fmt.Sprintf("%v", val)})
+	}
+}
+
+func sanitizeSiemKey(key string) string {
+	return strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_' || r == '.' {
+			return r
+		}
+		return '_'
+	}, key)
+}
+
+func newCefEncoder(vendor, product string) *cefEncoder {
+	return &cefEncoder{
+		siemEncoder: siemEncoder{
+			vendor:  vendor,
+			product: product,
+			version: version.GetVersion(),
+		},
+	}
+}
+
+// cefEncoder formats events using the ArcSight Common Event Format:
+//
+//	CEF:0|Vendor|Product|Version|SignatureID|Name|Severity|Extensions
+type cefEncoder struct {
+	siemEncoder
+}
+
+func (self *cefEncoder) Encode(evt FormatterEvent) ([]byte, error) {
+	envelope, err := newEventEnvelope(evt)
+	if err != nil {
+		return nil, err
+	}
+
+	signatureId := envelope.qualifiedType()
+
+	var sb strings.Builder
+	sb.WriteString("CEF:0|")
+	for _, field := range []string{self.vendor, self.product, self.version, signatureId, strings.ReplaceAll(signatureId, ".", " ")} {
+		sb.WriteString(cefHeaderEscaper.Replace(field))
+		sb.WriteString("|")
+	}
+	sb.WriteString(strconv.Itoa(self.severity(envelope)))
+	sb.WriteString("|")
+
+	fields := []siemField{
+		{key: "rt", value: strconv.FormatInt(envelope.timestamp.UnixMilli(), 10)},
+		{key: "cat", value: envelope.namespace},
+		{key: "deviceExternalId", value: envelope.srcId},
+		{key: "externalId", value: envelope.entityId},
+	}
+	fields = append(fields, self.flatten(envelope)...)
+
+	first := true
+	for _, field := range fields {
+		if field.value == "" {
+			continue
+		}
+		if !first {
+			sb.WriteString(" ")
+		}
+		first = false
+		sb.WriteString(field.key)
+		sb.WriteString("=")
+		sb.WriteString(cefExtensionEscaper.Replace(field.value))
+	}
+
+	return []byte(sb.String()), nil
+}
+
+func (self *cefEncoder) IsBinary() bool {
+	return false
+}
+
+func (self *cefEncoder) ContentType() string {
+	return "text/plain"
+}
+
+func newLeefEncoder(vendor, product string) *leefEncoder {
+	return &leefEncoder{
+		siemEncoder: siemEncoder{
+			vendor:  vendor,
+			product: product,
+			version: version.GetVersion(),
+		},
+	}
+}
+
+// leefEncoder formats events using the IBM QRadar Log Event Extended Format, version 2.0, with tab
+// delimited attributes:
+//
+//	LEEF:2.0|Vendor|Product|Version|EventID|x09|Attributes
+type leefEncoder struct {
+	siemEncoder
+}
+
+func (self *leefEncoder) Encode(evt FormatterEvent) ([]byte, error) {
+	envelope, err := newEventEnvelope(evt)
+	if err != nil {
+		return nil, err
+	}
+
+	var sb strings.Builder
+	sb.WriteString("LEEF:2.0|")
+	for _, field := range []string{self.vendor, self.product, self.version, envelope.qualifiedType()} {
+		sb.WriteString(leefHeaderEscaper.Replace(field))
+		sb.WriteString("|")
+	}
+	sb.WriteString("x09|")
+
+	fields := []siemField{
+		{key: "devTime", value: envelope.timestamp.UTC().Format("2006-01-02T15:04:05.000Z")},
+		{key: "devTimeFormat", value: "yyyy-MM-dd'T'HH:mm:ss.SSSX"},
+		{key: "cat", value: envelope.namespace},
+		{key: "sev", value: strconv.Itoa(self.severity(envelope))},
+		{key: "identSrc", value: envelope.srcId},
+		{key: "resource", value: envelope.entityId},
+	}
+	fields = append(fields, self.flatten(envelope)...)
+
+	first := true
+	for _, field := range fields {
+		if field.value == "" {
+			continue
+		}
+		if !first {
+			sb.WriteString("\t")
+		}
+		first = false
+		sb.WriteString(field.key)
+		sb.WriteString("=")
+		sb.WriteString(leefValueEscaper.Replace(field.value))
+	}
+
+	return []byte(sb.String()), nil
+}
+
+func (self *leefEncoder) IsBinary() bool {
+	return false
+}
+
+func (self *leefEncoder) ContentType() string {
+	return "text/plain"
+}
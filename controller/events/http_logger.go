@@ -0,0 +1,614 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"bytes"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/michaelquigley/pfxlog"
+	"github.com/pkg/errors"
+)
+
+const (
+	httpSpoolFilePrefix = "events-"
+	httpSpoolFileSuffix = ".batch"
+)
+
+type HTTPEventLoggerFactory struct{}
+
+func (HTTPEventLoggerFactory) NewEventHandler(config map[interface{}]interface{}) (interface{}, error) {
+	return NewHTTPEventLogger(fabricFormatterFactory{config: config}, config)
+}
+
+type httpConfig struct {
+	url              string
+	headers          map[string]string
+	bearerToken      string
+	contentType      string
+	separator        []byte
+	bufferSize       int
+	batchSize        int
+	batchInterval    time.Duration
+	requestTimeout   time.Duration
+	initialRetry     time.Duration
+	maxRetryInterval time.Duration
+	spoolDir         string
+	spoolMaxBytes    int64
+	tlsConfig        *tls.Config
+}
+
+// httpWriteCloser collects formatted events into batches and POSTs them to the configured endpoint. For text
+// formats, each event becomes a single line in the request body. Batches which can't be delivered are spooled,
+// either to the configured spool directory or in memory, and re-sent oldest first by a background retrier, so
+// an unavailable endpoint never stalls the event queue. The spool is size bounded, discarding the oldest batches.
+type httpWriteCloser struct {
+	config       *httpConfig
+	client       *http.Client
+	messages     chan []byte
+	closed       atomic.Bool
+	closeNotify  chan struct{}
+	done         chan struct{}
+	retryNotify  chan struct{}
+	retryDone    chan struct{}
+	closeOnce    sync.Once
+	spoolLock    sync.Mutex
+	spoolPending atomic.Bool
+	spoolSeq     atomic.Uint64
+	memSpool     []*httpSpooledBatch
+	memSpoolSize int64
+}
+
+type httpSpooledBatch struct {
+	seq  uint64
+	body []byte
+}
+
+func newHttpWriteCloser(config *httpConfig) *httpWriteCloser {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if config.tlsConfig != nil {
+		transport.TLSClientConfig = config.tlsConfig
+	}
+
+	result := &httpWriteCloser{
+		config: config,
+		client: &http.Client{
+			Transport: transport,
+			Timeout:   config.requestTimeout,
+		},
+		messages:    make(chan []byte, config.bufferSize),
+		closeNotify: make(chan struct{}),
+		done:        make(chan struct{}),
+		retryNotify: make(chan struct{}, 1),
+		retryDone:   make(chan struct{}),
+	}
+
+	// batches spooled by a previous run get delivered before anything new
+	if config.spoolDir != "" {
+		if files, err := result.listSpoolFiles(); err == nil && len(files) > 0 {
+			result.spoolPending.Store(true)
+			result.notifyRetry()
+		}
+	}
+
+	go result.retrySpooled()
+	go result.run()
+
+	return result
+}
+
+func (self *httpWriteCloser) Write(data []byte) (int, error) {
+	if self.closed.Load() {
+		return 0, errors.New("http event sink closed")
+	}
+
+	select {
+	case self.messages <- data:
+		return len(data), nil
+	default:
+		return 0, errors.Errorf("http event queue full, dropping event of %v bytes", len(data))
+	}
+}
+
+func (self *httpWriteCloser) Close() error {
+	self.closeOnce.Do(func() {
+		self.closed.Store(true)
+		close(self.closeNotify)
+		<-self.done
+	})
+	return nil
+}
+
+func (self *httpWriteCloser) run() {
+	defer close(self.done)
+
+	ticker := time.NewTicker(self.config.batchInterval)
+	defer ticker.Stop()
+
+	var batch [][]byte
+
+	flush := func() {
+		if len(batch) > 0 {
+			self.flushBatch(bytes.Join(batch, self.config.separator))
+			batch = nil
+		}
+	}
+
+	for {
+		select {
+		case msg := <-self.messages:
+			batch = append(batch, msg)
+			if len(batch) >= self.config.batchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		case <-self.closeNotify:
+			for {
+				select {
+				case msg := <-self.messages:
+					batch = append(batch, msg)
+				default:
+					// once the retrier has stopped, make one last attempt at delivering everything in order
+					<-self.retryDone
+					self.drainSpool()
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
+
+// flushBatch makes a single delivery attempt. If it fails, or if earlier batches are still waiting to be
+// delivered, the batch is spooled and left to the background retrier, so the run loop never waits on retries
+func (self *httpWriteCloser) flushBatch(body []byte) {
+	if self.spoolPending.Load() {
+		self.spool(body)
+		self.notifyRetry()
+		return
+	}
+
+	if err := self.send(body); err != nil {
+		pfxlog.Logger().WithField("url", self.config.url).WithError(err).Error("unable to deliver events to http endpoint")
+		if !isHttpEventsRejected(err) {
+			self.spool(body)
+			self.notifyRetry()
+		}
+	}
+}
+
+func (self *httpWriteCloser) notifyRetry() {
+	select {
+	case self.retryNotify <- struct{}{}:
+	default:
+	}
+}
+
+// retrySpooled drains the spool in the background whenever batches are spooled, backing off between attempts
+// until the spool is empty or the sink is closed
+func (self *httpWriteCloser) retrySpooled() {
+	defer close(self.retryDone)
+
+	expBackoff := backoff.NewExponentialBackOff()
+	expBackoff.InitialInterval = self.config.initialRetry
+	expBackoff.MaxInterval = self.config.maxRetryInterval
+	expBackoff.MaxElapsedTime = 0
+
+	for {
+		select {
+		case <-self.retryNotify:
+		case <-self.closeNotify:
+			return
+		}
+
+		expBackoff.Reset()
+		for !self.drainSpool() {
+			select {
+			case <-time.After(expBackoff.NextBackOff()):
+			case <-self.closeNotify:
+				return
+			}
+		}
+	}
+}
+
+func (self *httpWriteCloser) send(body []byte) error {
+	req, err := http.NewRequest(http.MethodPost, self.config.url, bytes.NewReader(body))
+	if err != nil {
+		return backoff.Permanent(&httpEventsRejectedError{err: err})
+	}
+
+	req.Header.Set("Content-Type", self.config.contentType)
+	for k, v := range self.config.headers {
+		req.Header.Set(k, v)
+	}
+	if self.config.bearerToken != "" {
+		req.Header.Set("Authorization", "Bearer "+self.config.bearerToken)
+	}
+
+	resp, err := self.client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	err = errors.Errorf("http event endpoint returned status %v", resp.Status)
+
+	// client errors, other than timeouts and rate limiting, won't be fixed by retrying
+	if resp.StatusCode >= 400 && resp.StatusCode < 500 &&
+		resp.StatusCode != http.StatusRequestTimeout && resp.StatusCode != http.StatusTooManyRequests {
+		return backoff.Permanent(&httpEventsRejectedError{err: err})
+	}
+
+	return err
+}
+
+// httpEventsRejectedError indicates that retrying or spooling the events won't help, either because the request
+// can't be built or because the endpoint refused it
+type httpEventsRejectedError struct {
+	err error
+}
+
+func (self *httpEventsRejectedError) Error() string {
+	return self.err.Error()
+}
+
+func (self *httpEventsRejectedError) Unwrap() error {
+	return self.err
+}
+
+func isHttpEventsRejected(err error) bool {
+	var rejectedErr *httpEventsRejectedError
+	return errors.As(err, &rejectedErr)
+}
+
+// drainSpool attempts to deliver any spooled batches, oldest first. It returns true if the spool is empty
+// once it's done
+func (self *httpWriteCloser) drainSpool() bool {
+	for {
+		body, remove, found := self.nextSpooled()
+		if !found {
+			return true
+		}
+
+		if err := self.send(body); err != nil {
+			if !isHttpEventsRejected(err) {
+				pfxlog.Logger().WithField("url", self.config.url).WithError(err).Debug("spooled event delivery attempt failed")
+				return false
+			}
+			pfxlog.Logger().WithField("url", self.config.url).WithError(err).Error("spooled events rejected by http endpoint, discarding")
+		}
+
+		if !remove() {
+			return false
+		}
+	}
+}
+
+// nextSpooled returns the oldest spooled batch along with a function to remove it once it's been handled. The
+// spool lock is only held while looking the batch up, so the run loop can keep spooling while a send is in flight
+func (self *httpWriteCloser) nextSpooled() ([]byte, func() bool, bool) {
+	self.spoolLock.Lock()
+	defer self.spoolLock.Unlock()
+
+	if self.config.spoolDir == "" {
+		if len(self.memSpool) == 0 {
+			self.spoolPending.Store(false)
+			return nil, nil, false
+		}
+		batch := self.memSpool[0]
+		return batch.body, func() bool {
+			self.spoolLock.Lock()
+			defer self.spoolLock.Unlock()
+			// the batch may already have been discarded to keep the spool under its limit
+			if len(self.memSpool) > 0 && self.memSpool[0].seq == batch.seq {
+				self.memSpool = self.memSpool[1:]
+				self.memSpoolSize -= int64(len(batch.body))
+			}
+			return true
+		}, true
+	}
+
+	log := pfxlog.Logger().WithField("spoolDir", self.config.spoolDir)
+
+	files, err := self.listSpoolFiles()
+	if err != nil {
+		log.WithError(err).Error("unable to list http event spool files")
+		return nil, nil, false
+	}
+
+	for _, file := range files {
+		body, err := os.ReadFile(file.path)
+		if err != nil {
+			log.WithError(err).WithField("file", file.path).Error("unable to read spooled events, discarding")
+			_ = os.Remove(file.path)
+			continue
+		}
+
+		path := file.path
+		return body, func() bool {
+			// the file may already have been discarded to keep the spool under its limit
+			if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+				log.WithError(err).WithField("file", path).Error("unable to remove spooled events file")
+				return false
+			}
+			return true
+		}, true
+	}
+
+	self.spoolPending.Store(false)
+	return nil, nil, false
+}
+
+func (self *httpWriteCloser) spool(body []byte) {
+	self.spoolLock.Lock()
+	defer self.spoolLock.Unlock()
+
+	seq := self.spoolSeq.Add(1)
+
+	if self.config.spoolDir == "" {
+		self.memSpool = append(self.memSpool, &httpSpooledBatch{seq: seq, body: body})
+		self.memSpoolSize += int64(len(body))
+		for len(self.memSpool) > 0 && self.memSpoolSize > self.config.spoolMaxBytes {
+			self.memSpoolSize -= int64(len(self.memSpool[0].body))
+			self.memSpool = self.memSpool[1:]
+			pfxlog.Logger().Warn("http event spool full, discarded oldest spooled events")
+		}
+		self.spoolPending.Store(true)
+		return
+	}
+
+	log := pfxlog.Logger().WithField("spoolDir", self.config.spoolDir)
+
+	name := fmt.Sprintf("%s%020d-%06d%s", httpSpoolFilePrefix, time.Now().UnixNano(), seq%1000000, httpSpoolFileSuffix)
+	path := filepath.Join(self.config.spoolDir, name)
+	if err := os.WriteFile(path, body, 0600); err != nil {
+		log.WithError(err).Errorf("unable to spool events, dropping %v bytes of events", len(body))
+		return
+	}
+
+	self.spoolPending.Store(true)
+	self.enforceSpoolLimit()
+}
+
+func (self *httpWriteCloser) enforceSpoolLimit() {
+	log := pfxlog.Logger().WithField("spoolDir", self.config.spoolDir)
+
+	files, err := self.listSpoolFiles()
+	if err != nil {
+		log.WithError(err).Error("unable to list http event spool files")
+		return
+	}
+
+	var total int64
+	for _, file := range files {
+		total += file.size
+	}
+
+	for len(files) > 0 && total > self.config.spoolMaxBytes {
+		file := files[0]
+		files = files[1:]
+		if err = os.Remove(file.path); err != nil && !os.IsNotExist(err) {
+			log.WithError(err).WithField("file", file.path).Error("unable to remove spooled events file")
+			return
+		}
+		total -= file.size
+		log.WithField("file", file.path).Warn("http event spool full, discarded oldest spooled events")
+	}
+}
+
+type spoolFile struct {
+	path string
+	size int64
+}
+
+func (self *httpWriteCloser) listSpoolFiles() ([]spoolFile, error) {
+	entries, err := os.ReadDir(self.config.spoolDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []spoolFile
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, httpSpoolFilePrefix) || !strings.HasSuffix(name, httpSpoolFileSuffix) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		result = append(result, spoolFile{
+			path: filepath.Join(self.config.spoolDir, name),
+			size: info.Size(),
+		})
+	}
+
+	// file names are zero-padded timestamps, so lexical order is chronological order
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].path < result[j].path
+	})
+
+	return result, nil
+}
+
+func parseHttpConfig(config map[interface{}]interface{}) (*httpConfig, error) {
+	ret := &httpConfig{
+		headers:          map[string]string{},
+		contentType:      "application/x-ndjson",
+		separator:        []byte("\n"),
+		bufferSize:       100,
+		batchSize:        50,
+		batchInterval:    time.Second,
+		requestTimeout:   10 * time.Second,
+		initialRetry:     time.Second,
+		maxRetryInterval: 30 * time.Second,
+		spoolMaxBytes:    100 * 1024 * 1024,
+	}
+
+	if value, found := config["url"]; !found {
+		return nil, errors.New("missing http url")
+	} else if u, ok := value.(string); ok && u != "" {
+		ret.url = u
+	} else {
+		return nil, errors.Errorf("invalid http url: %v", value)
+	}
+
+	if value, found := config["headers"]; found {
+		headers, ok := value.(map[interface{}]interface{})
+		if !ok {
+			return nil, errors.New("http 'headers' must be a map")
+		}
+		for k, v := range headers {
+			ret.headers[fmt.Sprintf("%v", k)] = fmt.Sprintf("%v", v)
+		}
+	}
+
+	if value, found := config["bearerToken"]; found {
+		if token, ok := value.(string); ok {
+			ret.bearerToken = token
+		} else {
+			return nil, errors.New("invalid http 'bearerToken', must be a string")
+		}
+	}
+
+	if value, found := config["contentType"]; found {
+		if contentType, ok := value.(string); ok {
+			ret.contentType = contentType
+		}
+	}
+
+	intValues := map[string]*int{
+		"bufferSize": &ret.bufferSize,
+		"batchSize":  &ret.batchSize,
+	}
+
+	for k, target := range intValues {
+		if value, found := config[k]; found {
+			if v, ok := value.(int); ok && v > 0 {
+				*target = v
+			} else {
+				return nil, errors.Errorf("invalid http '%s' value: %v, must be a positive integer", k, value)
+			}
+		}
+	}
+
+	durationValues := map[string]*time.Duration{
+		"batchInterval":    &ret.batchInterval,
+		"timeout":          &ret.requestTimeout,
+		"retryInterval":    &ret.initialRetry,
+		"maxRetryInterval": &ret.maxRetryInterval,
+	}
+
+	for k, target := range durationValues {
+		if value, found := config[k]; found {
+			d, err := time.ParseDuration(fmt.Sprintf("%v", value))
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid http '%s' value: %v", k, value)
+			}
+			if d <= 0 {
+				return nil, errors.Errorf("invalid http '%s' value: %v, must be greater than 0", k, value)
+			}
+			*target = d
+		}
+	}
+
+	if value, found := config["spoolDir"]; found {
+		dir, ok := value.(string)
+		if !ok {
+			return nil, errors.New("invalid http 'spoolDir', must be a string")
+		}
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			return nil, errors.Wrapf(err, "unable to create http event spool dir %s", dir)
+		}
+		ret.spoolDir = dir
+	}
+
+	if value, found := config["spoolMaxSizeMb"]; found {
+		if v, ok := value.(int); ok && v > 0 {
+			ret.spoolMaxBytes = int64(v) * 1024 * 1024
+		} else {
+			return nil, errors.Errorf("invalid http 'spoolMaxSizeMb' value: %v, must be a positive integer", value)
+		}
+	}
+
+	tlsConfig, err := parseEventSinkTlsConfig("http", config)
+	if err != nil {
+		return nil, err
+	}
+	ret.tlsConfig = tlsConfig
+
+	return ret, nil
+}
+
+// httpBatchContentType returns the media type of a batch of events in the given format. Batches of JSON
+// events are newline delimited, other formats are labelled with the type of the events themselves
+func httpBatchContentType(format string) string {
+	contentType := EventFormatContentType(format)
+	if !IsBinaryEventFormat(format) && strings.HasSuffix(contentType, "json") {
+		return "application/x-ndjson"
+	}
+	return contentType
+}
+
+func NewHTTPEventLogger(formatterFactory LoggingHandlerFactory, config map[interface{}]interface{}) (interface{}, error) {
+	conf, err := parseHttpConfig(config)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to parse http event handler config")
+	}
+
+	if value, found := config["format"]; found {
+		if format, ok := value.(string); ok {
+			// binary formats are self-delimiting, so batches are sent as a plain concatenation of events
+			if IsBinaryEventFormat(format) {
+				conf.separator = nil
+			}
+			if _, found := config["contentType"]; !found {
+				conf.contentType = httpBatchContentType(format)
+			}
+			out := newHttpWriteCloser(conf)
+			handler, err := formatterFactory.NewLoggingHandler(format, conf.bufferSize, out)
+			if err != nil {
+				_ = out.Close()
+				return nil, err
+			}
+			return handler, nil
+		}
+		return nil, errors.New("invalid 'format' for event http handler")
+	}
+	return nil, errors.New("'format' must be specified for event handler")
+}
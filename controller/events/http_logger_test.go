@@ -0,0 +1,208 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type httpTestEndpoint struct {
+	sync.Mutex
+	available atomic.Bool
+	bodies    []string
+	headers   []http.Header
+}
+
+func (self *httpTestEndpoint) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !self.available.Load() {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	body, _ := io.ReadAll(r.Body)
+	self.Lock()
+	self.bodies = append(self.bodies, string(body))
+	self.headers = append(self.headers, r.Header.Clone())
+	self.Unlock()
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (self *httpTestEndpoint) events() []string {
+	self.Lock()
+	defer self.Unlock()
+	var result []string
+	for _, body := range self.bodies {
+		result = append(result, strings.Split(body, "\n")...)
+	}
+	return result
+}
+
+func newTestHttpConfig(t *testing.T, url string, extra map[interface{}]interface{}) *httpConfig {
+	config := map[interface{}]interface{}{
+		"url":           url,
+		"batchSize":     3,
+		"batchInterval": "20ms",
+		"retryInterval": "5ms",
+	}
+	for k, v := range extra {
+		config[k] = v
+	}
+	result, err := parseHttpConfig(config)
+	require.NoError(t, err)
+	return result
+}
+
+func Test_HttpEventSinkBatchesAndAuth(t *testing.T) {
+	req := require.New(t)
+
+	endpoint := &httpTestEndpoint{}
+	endpoint.available.Store(true)
+	server := httptest.NewServer(endpoint)
+	defer server.Close()
+
+	config := newTestHttpConfig(t, server.URL, map[interface{}]interface{}{
+		"bearerToken": "token",
+		"headers":     map[interface{}]interface{}{"X-Source": "ctrl"},
+	})
+
+	sink := newHttpWriteCloser(config)
+	for _, evt := range []string{"a", "b", "c", "d"} {
+		_, err := sink.Write([]byte(evt))
+		req.NoError(err)
+	}
+
+	req.Eventually(func() bool {
+		return len(endpoint.events()) == 4
+	}, time.Second, 10*time.Millisecond)
+	req.NoError(sink.Close())
+
+	req.Equal([]string{"a", "b", "c", "d"}, endpoint.events())
+	endpoint.Lock()
+	defer endpoint.Unlock()
+	req.Equal("a\nb\nc", endpoint.bodies[0])
+	req.Equal("Bearer token", endpoint.headers[0].Get("Authorization"))
+	req.Equal("ctrl", endpoint.headers[0].Get("X-Source"))
+	req.Equal("application/x-ndjson", endpoint.headers[0].Get("Content-Type"))
+}
+
+func Test_HttpEventSinkSpoolsWhileUnavailable(t *testing.T) {
+	req := require.New(t)
+
+	endpoint := &httpTestEndpoint{}
+	server := httptest.NewServer(endpoint)
+	defer server.Close()
+
+	spoolDir := t.TempDir()
+	config := newTestHttpConfig(t, server.URL, map[interface{}]interface{}{
+		"spoolDir":      spoolDir,
+		"batchInterval": "1h",
+	})
+
+	sink := newHttpWriteCloser(config)
+
+	for _, evt := range []string{"a", "b", "c", "d", "e", "f"} {
+		_, err := sink.Write([]byte(evt))
+		req.NoError(err)
+	}
+
+	req.Eventually(func() bool {
+		files, err := sink.listSpoolFiles()
+		return err == nil && len(files) == 2
+	}, time.Second, 10*time.Millisecond)
+
+	// the background retrier drains the spool once the endpoint is back
+	endpoint.available.Store(true)
+	req.Eventually(func() bool {
+		return len(endpoint.events()) == 6
+	}, time.Second, 10*time.Millisecond)
+	req.NoError(sink.Close())
+
+	req.Equal([]string{"a", "b", "c", "d", "e", "f"}, endpoint.events())
+
+	entries, err := os.ReadDir(spoolDir)
+	req.NoError(err)
+	req.Empty(entries)
+}
+
+func Test_HttpEventSinkDoesNotBlockWhileUnavailable(t *testing.T) {
+	req := require.New(t)
+
+	endpoint := &httpTestEndpoint{}
+	server := httptest.NewServer(endpoint)
+	defer server.Close()
+
+	config := newTestHttpConfig(t, server.URL, map[interface{}]interface{}{
+		"bufferSize":    3,
+		"batchInterval": "1h",
+	})
+
+	sink := newHttpWriteCloser(config)
+
+	var expected []string
+	for i := 0; i < 10; i++ {
+		for j := 0; j < 3; j++ {
+			evt := fmt.Sprintf("%v-%v", i, j)
+			expected = append(expected, evt)
+			_, err := sink.Write([]byte(evt))
+			req.NoError(err)
+		}
+		// failed batches are spooled, so the queue keeps draining while the endpoint is down
+		req.Eventually(func() bool {
+			return len(sink.messages) == 0
+		}, time.Second, time.Millisecond)
+	}
+
+	endpoint.available.Store(true)
+	req.Eventually(func() bool {
+		return len(endpoint.events()) == len(expected)
+	}, time.Second, 10*time.Millisecond)
+	req.NoError(sink.Close())
+
+	req.Equal(expected, endpoint.events())
+}
+
+func Test_HttpEventSinkSpoolIsBounded(t *testing.T) {
+	req := require.New(t)
+
+	config := newTestHttpConfig(t, "http://localhost:1", map[interface{}]interface{}{
+		"spoolDir": t.TempDir(),
+	})
+	config.spoolMaxBytes = 10
+
+	sink := &httpWriteCloser{config: config}
+	sink.spool([]byte("0123456"))
+	sink.spool([]byte("789"))
+	sink.spool([]byte("abcdef"))
+
+	files, err := sink.listSpoolFiles()
+	req.NoError(err)
+	req.Len(files, 2)
+
+	body, err := os.ReadFile(files[0].path)
+	req.NoError(err)
+	req.Equal("789", string(body))
+}
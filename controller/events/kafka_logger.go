@@ -0,0 +1,381 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/metrics"
+	"github.com/pkg/errors"
+	"github.com/segmentio/kafka-go"
+	"github.com/segmentio/kafka-go/sasl/plain"
+)
+
+const kafkaTopicNamespacePlaceholder = "{namespace}"
+
+type KafkaEventLoggerFactory struct {
+	dispatcher *Dispatcher
+}
+
+func (self KafkaEventLoggerFactory) NewEventHandler(config map[interface{}]interface{}) (interface{}, error) {
+	return NewKafkaEventLogger(fabricFormatterFactory{config: config}, self.dispatcher.getSinkMetricsRegistry(), config)
+}
+
+// kafkaProducer is the subset of kafka.Writer used by the event handler
+type kafkaProducer interface {
+	WriteMessages(ctx context.Context, msgs ...kafka.Message) error
+	Close() error
+}
+
+type kafkaMessage struct {
+	namespace string
+	entityId  string
+	value     []byte
+}
+
+// kafkaWriteCloser publishes events to a kafka topic. Events are keyed by namespace and entity id, so all
+// events for a given entity land in the same partition and stay ordered
+type kafkaWriteCloser struct {
+	config      *kafkaConfig
+	producer    kafkaProducer
+	messages    chan *kafkaMessage
+	ctx         context.Context
+	cancel      context.CancelFunc
+	health      *eventSinkHealth
+	closeNotify chan struct{}
+	closeOnce   sync.Once
+	done        chan struct{}
+}
+
+func newKafkaWriteCloser(config *kafkaConfig, producer kafkaProducer, registry metrics.Registry) *kafkaWriteCloser {
+	ctx, cancel := context.WithCancel(context.Background())
+	messages := make(chan *kafkaMessage, config.bufferSize)
+
+	result := &kafkaWriteCloser{
+		config:      config,
+		producer:    producer,
+		messages:    messages,
+		ctx:         ctx,
+		cancel:      cancel,
+		closeNotify: make(chan struct{}),
+		done:        make(chan struct{}),
+		health: newEventSinkHealth(registry, "events.kafka."+config.metricsName, func() int64 {
+			return int64(len(messages))
+		}),
+	}
+
+	go result.run()
+
+	return result
+}
+
+func (self *kafkaWriteCloser) run() {
+	defer close(self.done)
+	defer func() {
+		// closing the producer flushes any messages it has batched
+		if err := self.producer.Close(); err != nil {
+			pfxlog.Logger().WithError(err).Error("error closing kafka producer")
+		}
+	}()
+
+	for {
+		select {
+		case msg := <-self.messages:
+			self.sendBatch(msg)
+		case <-self.closeNotify:
+			// flush events queued before close, until they're all sent or the close timeout cancels the context
+			for len(self.messages) > 0 && self.ctx.Err() == nil {
+				self.sendBatch(<-self.messages)
+			}
+			return
+		case <-self.ctx.Done():
+			return
+		}
+	}
+}
+
+func (self *kafkaWriteCloser) sendBatch(first *kafkaMessage) {
+	batch := []kafka.Message{self.toKafkaMessage(first)}
+	for len(batch) < self.config.batchSize && len(self.messages) > 0 {
+		batch = append(batch, self.toKafkaMessage(<-self.messages))
+	}
+	self.sendMessages(batch)
+}
+
+func (self *kafkaWriteCloser) toKafkaMessage(msg *kafkaMessage) kafka.Message {
+	result := kafka.Message{
+		Key:   []byte(msg.namespace + "/" + msg.entityId),
+		Value: msg.value,
+		Headers: []kafka.Header{
+			{Key: "namespace", Value: []byte(msg.namespace)},
+			{Key: "content-type", Value: []byte(self.config.contentType)},
+		},
+	}
+	if msg.entityId != "" {
+		result.Headers = append(result.Headers, kafka.Header{Key: "entityId", Value: []byte(msg.entityId)})
+	}
+	if self.config.topicPerNamespace() {
+		result.Topic = self.config.topicFor(msg.namespace)
+	}
+	return result
+}
+
+func (self *kafkaWriteCloser) sendMessages(batch []kafka.Message) {
+	log := pfxlog.Logger().WithField("brokers", self.config.brokers)
+
+	expBackoff := backoff.NewExponentialBackOff()
+	expBackoff.InitialInterval = time.Second
+	expBackoff.MaxInterval = time.Minute
+	expBackoff.MaxElapsedTime = self.config.maxRetryElapsed
+
+	operation := func() error {
+		err := self.producer.WriteMessages(self.ctx, batch...)
+		if err != nil {
+			self.health.failed()
+			log.WithError(err).Error("error sending events to kafka")
+			return err
+		}
+		self.health.sent(len(batch))
+		return nil
+	}
+
+	if err := backoff.Retry(operation, backoff.WithContext(expBackoff, self.ctx)); err != nil {
+		self.health.dropped(len(batch))
+		log.WithError(err).Errorf("unable to send events to kafka, dropped %v events", len(batch))
+	}
+}
+
+func (self *kafkaWriteCloser) WriteRoutedEvent(namespace string, entityId string, data []byte) error {
+	select {
+	case self.messages <- &kafkaMessage{namespace: namespace, entityId: entityId, value: data}:
+		return nil
+	default:
+		self.health.dropped(1)
+		return errors.Errorf("kafka queue full, dropping event of %v bytes", len(data))
+	}
+}
+
+func (self *kafkaWriteCloser) Write(data []byte) (int, error) {
+	if err := self.WriteRoutedEvent("", "", data); err != nil {
+		return 0, err
+	}
+	return len(data), nil
+}
+
+// Close sends any queued events and closes the producer, giving up on unsent events after the close timeout
+func (self *kafkaWriteCloser) Close() error {
+	self.closeOnce.Do(func() {
+		close(self.closeNotify)
+
+		timer := time.NewTimer(self.config.closeTimeout)
+		defer timer.Stop()
+
+		select {
+		case <-self.done:
+		case <-timer.C:
+			pfxlog.Logger().WithField("brokers", self.config.brokers).
+				Warnf("timed out after %v flushing events to kafka, dropping %v queued events", self.config.closeTimeout, len(self.messages))
+			self.cancel()
+			<-self.done
+		}
+
+		self.cancel()
+		self.health.dispose()
+	})
+	return nil
+}
+
+type kafkaConfig struct {
+	brokers         []string
+	topic           string
+	metricsName     string
+	bufferSize      int
+	batchSize       int
+	batchTimeout    time.Duration
+	maxRetryElapsed time.Duration
+	closeTimeout    time.Duration
+	requiredAcks    kafka.RequiredAcks
+	tlsConfig       *tls.Config
+	username        string
+	password        string
+	contentType     string
+}
+
+func (self *kafkaConfig) topicPerNamespace() bool {
+	return strings.Contains(self.topic, kafkaTopicNamespacePlaceholder)
+}
+
+func (self *kafkaConfig) topicFor(namespace string) string {
+	if namespace == "" {
+		namespace = "unknown"
+	}
+	return strings.ReplaceAll(self.topic, kafkaTopicNamespacePlaceholder, namespace)
+}
+
+func (self *kafkaConfig) newWriter() *kafka.Writer {
+	transport := &kafka.Transport{
+		TLS: self.tlsConfig,
+	}
+
+	if self.username != "" {
+		transport.SASL = plain.Mechanism{
+			Username: self.username,
+			Password: self.password,
+		}
+	}
+
+	result := &kafka.Writer{
+		Addr:         kafka.TCP(self.brokers...),
+		Balancer:     &kafka.Hash{},
+		BatchSize:    self.batchSize,
+		BatchTimeout: self.batchTimeout,
+		RequiredAcks: self.requiredAcks,
+		Transport:    transport,
+		MaxAttempts:  1,
+	}
+
+	if !self.topicPerNamespace() {
+		result.Topic = self.topic
+	}
+
+	return result
+}
+
+func parseKafkaConfig(config map[interface{}]interface{}) (*kafkaConfig, error) {
+	ret := &kafkaConfig{
+		bufferSize:      100,
+		batchSize:       100,
+		batchTimeout:    time.Second,
+		maxRetryElapsed: 5 * time.Minute,
+		closeTimeout:    10 * time.Second,
+		requiredAcks:    kafka.RequireOne,
+	}
+
+	if value, found := config["brokers"]; !found {
+		return nil, errors.New("missing kafka brokers")
+	} else if brokerList, ok := value.([]interface{}); ok && len(brokerList) > 0 {
+		for _, broker := range brokerList {
+			ret.brokers = append(ret.brokers, fmt.Sprintf("%v", broker))
+		}
+	} else if broker, ok := value.(string); ok && broker != "" {
+		ret.brokers = strings.Split(broker, ",")
+	} else {
+		return nil, errors.Errorf("invalid kafka brokers: %v", value)
+	}
+
+	if value, found := config["topic"]; !found {
+		return nil, errors.New("missing kafka topic")
+	} else if topic, ok := value.(string); ok && topic != "" {
+		ret.topic = topic
+	} else {
+		return nil, errors.Errorf("invalid kafka topic: %v", value)
+	}
+
+	ret.metricsName = strings.ReplaceAll(ret.topic, kafkaTopicNamespacePlaceholder, "namespace")
+	if value, found := config["metricsName"]; found {
+		ret.metricsName = fmt.Sprintf("%v", value)
+	}
+
+	intValues := map[string]*int{
+		"bufferSize": &ret.bufferSize,
+		"batchSize":  &ret.batchSize,
+	}
+
+	for k, target := range intValues {
+		if value, found := config[k]; found {
+			if v, ok := value.(int); ok && v > 0 {
+				*target = v
+			} else {
+				return nil, errors.Errorf("invalid kafka '%s' value: %v, must be a positive integer", k, value)
+			}
+		}
+	}
+
+	durationValues := map[string]*time.Duration{
+		"batchTimeout":    &ret.batchTimeout,
+		"maxRetryElapsed": &ret.maxRetryElapsed,
+		"closeTimeout":    &ret.closeTimeout,
+	}
+
+	for k, target := range durationValues {
+		if value, found := config[k]; found {
+			d, err := time.ParseDuration(fmt.Sprintf("%v", value))
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid kafka '%s' value: %v", k, value)
+			}
+			*target = d
+		}
+	}
+
+	if value, found := config["requiredAcks"]; found {
+		switch fmt.Sprintf("%v", value) {
+		case "none", "0":
+			ret.requiredAcks = kafka.RequireNone
+		case "one", "1":
+			ret.requiredAcks = kafka.RequireOne
+		case "all", "-1":
+			ret.requiredAcks = kafka.RequireAll
+		default:
+			return nil, errors.Errorf("invalid kafka 'requiredAcks' value: %v, must be one of none, one or all", value)
+		}
+	}
+
+	if value, found := config["username"]; found {
+		ret.username = fmt.Sprintf("%v", value)
+	}
+
+	if value, found := config["password"]; found {
+		ret.password = fmt.Sprintf("%v", value)
+	}
+
+	tlsConfig, err := parseEventSinkTlsConfig("kafka", config)
+	if err != nil {
+		return nil, err
+	}
+	ret.tlsConfig = tlsConfig
+
+	return ret, nil
+}
+
+func NewKafkaEventLogger(formatterFactory LoggingHandlerFactory, registry metrics.Registry, config map[interface{}]interface{}) (interface{}, error) {
+	conf, err := parseKafkaConfig(config)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to parse kafka config")
+	}
+
+	if value, found := config["format"]; found {
+		if format, ok := value.(string); ok {
+			conf.contentType = EventFormatContentType(format)
+			out := newKafkaWriteCloser(conf, conf.newWriter(), registry)
+			handler, err := formatterFactory.NewLoggingHandler(format, conf.bufferSize, out)
+			if err != nil {
+				_ = out.Close()
+				return nil, err
+			}
+			return handler, nil
+		}
+		return nil, errors.New("invalid 'format' for event kafka handler")
+	}
+	return nil, errors.New("'format' must be specified for event handler")
+}
@@ -0,0 +1,182 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openziti/metrics"
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/require"
+	"ztna-core/ztna/controller/event"
+)
+
+type testKafkaProducer struct {
+	sync.Mutex
+	failures int
+	messages []kafka.Message
+	closed   bool
+	gate     chan struct{}
+}
+
+func (self *testKafkaProducer) WriteMessages(ctx context.Context, msgs ...kafka.Message) error {
+	if self.gate != nil {
+		select {
+		case <-self.gate:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	self.Lock()
+	defer self.Unlock()
+	if self.failures > 0 {
+		self.failures--
+		return errors.New("broker unavailable")
+	}
+	self.messages = append(self.messages, msgs...)
+	return nil
+}
+
+func (self *testKafkaProducer) Close() error {
+	self.Lock()
+	defer self.Unlock()
+	self.closed = true
+	return nil
+}
+
+func (self *testKafkaProducer) isClosed() bool {
+	self.Lock()
+	defer self.Unlock()
+	return self.closed
+}
+
+func (self *testKafkaProducer) getMessages() []kafka.Message {
+	self.Lock()
+	defer self.Unlock()
+	return append([]kafka.Message(nil), self.messages...)
+}
+
+func Test_KafkaEventSinkKeysByNamespaceAndEntity(t *testing.T) {
+	req := require.New(t)
+
+	config, err := parseKafkaConfig(map[interface{}]interface{}{
+		"brokers": []interface{}{"localhost:9092"},
+		"topic":   "ziti.{namespace}",
+	})
+	req.NoError(err)
+	req.Equal([]string{"localhost:9092"}, config.brokers)
+
+	registry := metrics.NewRegistry("test", nil)
+	producer := &testKafkaProducer{failures: 1}
+	sink := newKafkaWriteCloser(config, producer, registry)
+	defer func() { _ = sink.Close() }()
+
+	formatter := NewJsonFormatter(4, NewWriterEventSink(sink))
+	defer func() { _ = formatter.Close() }()
+
+	formatter.AcceptCircuitEvent(&event.CircuitEvent{
+		Namespace: event.CircuitEventsNs,
+		CircuitId: "circuit1",
+	})
+	formatter.AcceptRouterEvent(&event.RouterEvent{
+		Namespace: event.RouterEventsNs,
+		RouterId:  "router1",
+	})
+
+	req.Eventually(func() bool {
+		return len(producer.getMessages()) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	messages := producer.getMessages()
+	req.Equal("fabric.circuits/circuit1", string(messages[0].Key))
+	req.Equal("ziti.fabric.circuits", messages[0].Topic)
+	req.Equal("fabric.routers/router1", string(messages[1].Key))
+	req.Equal("ziti.fabric.routers", messages[1].Topic)
+
+	req.Equal(int64(2), testMeterCount(registry, "events.kafka.ziti.namespace.sent"))
+	req.Equal(int64(1), testMeterCount(registry, "events.kafka.ziti.namespace.errors"))
+	req.Equal(int64(1), registry.GetGauge("events.kafka.ziti.namespace.healthy").Value())
+}
+
+func Test_KafkaEventSinkFlushesQueuedEventsOnClose(t *testing.T) {
+	req := require.New(t)
+
+	config, err := parseKafkaConfig(map[interface{}]interface{}{
+		"brokers":   []interface{}{"localhost:9092"},
+		"topic":     "ziti.events",
+		"batchSize": 1,
+	})
+	req.NoError(err)
+
+	gate := make(chan struct{})
+	producer := &testKafkaProducer{gate: gate}
+	sink := newKafkaWriteCloser(config, producer, metrics.NewRegistry("test", nil))
+
+	for _, entityId := range []string{"circuit1", "circuit2", "circuit3"} {
+		req.NoError(sink.WriteRoutedEvent(event.CircuitEventsNs, entityId, []byte(entityId)))
+	}
+
+	closed := make(chan struct{})
+	go func() {
+		_ = sink.Close()
+		close(closed)
+	}()
+
+	close(gate)
+
+	select {
+	case <-closed:
+	case <-time.After(5 * time.Second):
+		req.Fail("timed out waiting for close")
+	}
+
+	req.Len(producer.getMessages(), 3)
+	req.True(producer.isClosed())
+}
+
+func Test_KafkaEventSinkCloseIsBounded(t *testing.T) {
+	req := require.New(t)
+
+	config, err := parseKafkaConfig(map[interface{}]interface{}{
+		"brokers":      []interface{}{"localhost:9092"},
+		"topic":        "ziti.events",
+		"closeTimeout": "100ms",
+	})
+	req.NoError(err)
+
+	// the gate is never opened, so sends block until the close timeout cancels them
+	producer := &testKafkaProducer{gate: make(chan struct{})}
+	sink := newKafkaWriteCloser(config, producer, metrics.NewRegistry("test", nil))
+	req.NoError(sink.WriteRoutedEvent(event.CircuitEventsNs, "circuit1", []byte("circuit1")))
+
+	start := time.Now()
+	req.NoError(sink.Close())
+	req.Less(time.Since(start), 5*time.Second)
+	req.Empty(producer.getMessages())
+	req.True(producer.isClosed())
+}
+
+func testMeterCount(registry metrics.Registry, name string) int64 {
+	if meter, ok := registry.GetMeter(name).(interface{ Count() int64 }); ok {
+		return meter.Count()
+	}
+	return -1
+}
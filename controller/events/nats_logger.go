@@ -0,0 +1,283 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"crypto/tls"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/nats-io/nats.go"
+	"github.com/openziti/metrics"
+	"github.com/pkg/errors"
+)
+
+type NATSEventLoggerFactory struct {
+	dispatcher *Dispatcher
+}
+
+func (self NATSEventLoggerFactory) NewEventHandler(config map[interface{}]interface{}) (interface{}, error) {
+	return NewNATSEventLogger(fabricFormatterFactory{config: config}, self.dispatcher.getSinkMetricsRegistry(), config)
+}
+
+// natsWriteCloser publishes events to subjects of the form <subjectPrefix>.<namespace>.<entityId>, so
+// consumers can subscribe to all events, all events for a namespace or all events for a single entity.
+// The nats client buffers publishes while reconnecting, so no additional queueing is done here
+type natsWriteCloser struct {
+	config *natsConfig
+	conn   atomic.Pointer[nats.Conn]
+	health *eventSinkHealth
+}
+
+func newNATSWriteCloser(config *natsConfig, registry metrics.Registry) (*natsWriteCloser, error) {
+	result := &natsWriteCloser{
+		config: config,
+	}
+
+	log := pfxlog.Logger().WithField("url", config.url)
+
+	options := []nats.Option{
+		nats.Name(config.clientName),
+		nats.MaxReconnects(-1),
+		nats.RetryOnFailedConnect(true),
+		nats.ReconnectBufSize(config.reconnectBufSize),
+		nats.ConnectHandler(func(*nats.Conn) {
+			log.Info("connected to nats server")
+			result.health.setHealthy(true)
+		}),
+		nats.ReconnectHandler(func(*nats.Conn) {
+			log.Info("reconnected to nats server")
+			result.health.setHealthy(true)
+		}),
+		nats.DisconnectErrHandler(func(_ *nats.Conn, err error) {
+			log.WithError(err).Warn("disconnected from nats server")
+			result.health.setHealthy(false)
+		}),
+		nats.ErrorHandler(func(_ *nats.Conn, _ *nats.Subscription, err error) {
+			log.WithError(err).Error("nats error")
+			result.health.failed()
+		}),
+	}
+
+	if config.username != "" {
+		options = append(options, nats.UserInfo(config.username, config.password))
+	}
+
+	if config.token != "" {
+		options = append(options, nats.Token(config.token))
+	}
+
+	if config.tlsConfig != nil {
+		options = append(options, nats.Secure(config.tlsConfig))
+	}
+
+	// the gauge may be read before the connection is established, so the connection is accessed atomically
+	result.health = newEventSinkHealth(registry, "events.nats."+config.metricsName, func() int64 {
+		conn := result.conn.Load()
+		if conn == nil {
+			return 0
+		}
+		buffered, _ := conn.Buffered()
+		return int64(buffered)
+	})
+	result.health.setHealthy(false)
+
+	conn, err := nats.Connect(config.url, options...)
+	if err != nil {
+		result.health.dispose()
+		return nil, errors.Wrapf(err, "unable to connect to nats server at %s", config.url)
+	}
+	result.conn.Store(conn)
+
+	return result, nil
+}
+
+func (self *natsWriteCloser) subjectFor(namespace string, entityId string) string {
+	subject := self.config.subjectPrefix
+	if namespace != "" {
+		subject += "." + namespace
+	}
+	if entityId != "" {
+		subject += "." + sanitizeNATSToken(entityId)
+	}
+	return subject
+}
+
+// sanitizeNATSToken replaces characters which have special meaning in nats subjects
+func sanitizeNATSToken(token string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '.', '*', '>', ' ', '\t', '\r', '\n':
+			return '_'
+		}
+		return r
+	}, token)
+}
+
+func (self *natsWriteCloser) WriteRoutedEvent(namespace string, entityId string, data []byte) error {
+	msg := &nats.Msg{
+		Subject: self.subjectFor(namespace, entityId),
+		Header:  nats.Header{"Content-Type": []string{self.config.contentType}},
+		Data:    data,
+	}
+	if err := self.conn.Load().PublishMsg(msg); err != nil {
+		self.health.failed()
+		self.health.dropped(1)
+		return errors.Wrapf(err, "unable to publish event of %v bytes to nats", len(data))
+	}
+	self.health.sent(1)
+	return nil
+}
+
+func (self *natsWriteCloser) Write(data []byte) (int, error) {
+	if err := self.WriteRoutedEvent("", "", data); err != nil {
+		return 0, err
+	}
+	return len(data), nil
+}
+
+func (self *natsWriteCloser) Close() error {
+	conn := self.conn.Load()
+	if err := conn.FlushTimeout(self.config.flushTimeout); err != nil {
+		pfxlog.Logger().WithError(err).Warn("unable to flush pending events to nats server")
+	}
+	conn.Close()
+	self.health.dispose()
+	return nil
+}
+
+type natsConfig struct {
+	url              string
+	subjectPrefix    string
+	clientName       string
+	metricsName      string
+	bufferSize       int
+	reconnectBufSize int
+	flushTimeout     time.Duration
+	username         string
+	password         string
+	token            string
+	tlsConfig        *tls.Config
+	contentType      string
+}
+
+func parseNATSConfig(config map[interface{}]interface{}) (*natsConfig, error) {
+	ret := &natsConfig{
+		subjectPrefix:    "ziti.events",
+		clientName:       "ziti-controller",
+		bufferSize:       10,
+		reconnectBufSize: nats.DefaultReconnectBufSize,
+		flushTimeout:     5 * time.Second,
+	}
+
+	if value, found := config["url"]; !found {
+		return nil, errors.New("missing nats url")
+	} else if u, ok := value.(string); ok && u != "" {
+		ret.url = u
+	} else {
+		return nil, errors.Errorf("invalid nats url: %v", value)
+	}
+
+	if value, found := config["subjectPrefix"]; found {
+		prefix, ok := value.(string)
+		if !ok || prefix == "" || strings.ContainsAny(prefix, "*> \t") {
+			return nil, errors.Errorf("invalid nats 'subjectPrefix': %v", value)
+		}
+		ret.subjectPrefix = strings.TrimSuffix(prefix, ".")
+	}
+
+	ret.metricsName = ret.subjectPrefix
+	if value, found := config["metricsName"]; found {
+		ret.metricsName = fmt.Sprintf("%v", value)
+	}
+
+	if value, found := config["clientName"]; found {
+		ret.clientName = fmt.Sprintf("%v", value)
+	}
+
+	if value, found := config["bufferSize"]; found {
+		if v, ok := value.(int); ok && v > 0 {
+			ret.bufferSize = v
+		} else {
+			return nil, errors.Errorf("invalid nats 'bufferSize' value: %v, must be a positive integer", value)
+		}
+	}
+
+	if value, found := config["reconnectBufferSizeMb"]; found {
+		if v, ok := value.(int); ok && v > 0 {
+			ret.reconnectBufSize = v * 1024 * 1024
+		} else {
+			return nil, errors.Errorf("invalid nats 'reconnectBufferSizeMb' value: %v, must be a positive integer", value)
+		}
+	}
+
+	if value, found := config["flushTimeout"]; found {
+		d, err := time.ParseDuration(fmt.Sprintf("%v", value))
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid nats 'flushTimeout' value: %v", value)
+		}
+		ret.flushTimeout = d
+	}
+
+	if value, found := config["username"]; found {
+		ret.username = fmt.Sprintf("%v", value)
+	}
+
+	if value, found := config["password"]; found {
+		ret.password = fmt.Sprintf("%v", value)
+	}
+
+	if value, found := config["token"]; found {
+		ret.token = fmt.Sprintf("%v", value)
+	}
+
+	tlsConfig, err := parseEventSinkTlsConfig("nats", config)
+	if err != nil {
+		return nil, err
+	}
+	ret.tlsConfig = tlsConfig
+
+	return ret, nil
+}
+
+func NewNATSEventLogger(formatterFactory LoggingHandlerFactory, registry metrics.Registry, config map[interface{}]interface{}) (interface{}, error) {
+	conf, err := parseNATSConfig(config)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to parse nats config")
+	}
+
+	if value, found := config["format"]; found {
+		if format, ok := value.(string); ok {
+			conf.contentType = EventFormatContentType(format)
+			out, err := newNATSWriteCloser(conf, registry)
+			if err != nil {
+				return nil, err
+			}
+			handler, err := formatterFactory.NewLoggingHandler(format, conf.bufferSize, out)
+			if err != nil {
+				_ = out.Close()
+				return nil, err
+			}
+			return handler, nil
+		}
+		return nil, errors.New("invalid 'format' for event nats handler")
+	}
+	return nil, errors.New("'format' must be specified for event handler")
+}
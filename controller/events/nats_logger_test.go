@@ -0,0 +1,143 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openziti/metrics"
+	"github.com/stretchr/testify/require"
+	"ztna-core/ztna/controller/event"
+)
+
+// testNATSServer speaks just enough of the nats client protocol to accept publishes
+type testNATSServer struct {
+	sync.Mutex
+	listener net.Listener
+	subjects []string
+}
+
+func newTestNATSServer(t *testing.T) *testNATSServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	result := &testNATSServer{listener: listener}
+	go result.accept()
+	return result
+}
+
+func (self *testNATSServer) url() string {
+	return "nats://" + self.listener.Addr().String()
+}
+
+func (self *testNATSServer) accept() {
+	for {
+		conn, err := self.listener.Accept()
+		if err != nil {
+			return
+		}
+		go self.handle(conn)
+	}
+}
+
+func (self *testNATSServer) handle(conn net.Conn) {
+	defer func() { _ = conn.Close() }()
+
+	_, _ = fmt.Fprintf(conn, "INFO {\"server_id\":\"test\",\"version\":\"2.10.0\",\"proto\":1,\"max_payload\":1048576}\r\n")
+
+	reader := bufio.NewReader(conn)
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		switch strings.ToUpper(fields[0]) {
+		case "PING":
+			_, _ = fmt.Fprint(conn, "PONG\r\n")
+		case "PUB":
+			size, _ := strconv.Atoi(fields[len(fields)-1])
+			payload := make([]byte, size+2)
+			if _, err = io.ReadFull(reader, payload); err != nil {
+				return
+			}
+			self.Lock()
+			self.subjects = append(self.subjects, fields[1])
+			self.Unlock()
+		}
+	}
+}
+
+func (self *testNATSServer) getSubjects() []string {
+	self.Lock()
+	defer self.Unlock()
+	return append([]string(nil), self.subjects...)
+}
+
+func Test_NATSEventSinkPublishesBySubject(t *testing.T) {
+	req := require.New(t)
+
+	server := newTestNATSServer(t)
+	defer func() { _ = server.listener.Close() }()
+
+	config, err := parseNATSConfig(map[interface{}]interface{}{
+		"url":           server.url(),
+		"subjectPrefix": "ziti.events.",
+	})
+	req.NoError(err)
+
+	registry := metrics.NewRegistry("test", nil)
+	sink, err := newNATSWriteCloser(config, registry)
+	req.NoError(err)
+
+	req.Eventually(func() bool {
+		return registry.GetGauge("events.nats.ziti.events.healthy").Value() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	formatter := NewJsonFormatter(4, NewWriterEventSink(sink))
+	formatter.AcceptCircuitEvent(&event.CircuitEvent{
+		Namespace: event.CircuitEventsNs,
+		CircuitId: "circuit.1",
+	})
+	formatter.AcceptUsageEventV3(&event.UsageEventV3{
+		Namespace: event.UsageEventsNs,
+		CircuitId: "circuit2",
+	})
+
+	req.Eventually(func() bool {
+		return testMeterCount(registry, "events.nats.ziti.events.sent") == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	_ = formatter.Close()
+	req.NoError(sink.Close())
+
+	req.Eventually(func() bool {
+		return len(server.getSubjects()) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	req.Equal([]string{"ziti.events.fabric.circuits.circuit_1", "ziti.events.fabric.usage.circuit2"}, server.getSubjects())
+}
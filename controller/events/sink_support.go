@@ -0,0 +1,128 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"crypto/tls"
+	"sync/atomic"
+
+	"github.com/openziti/identity"
+	"github.com/openziti/metrics"
+	"github.com/pkg/errors"
+)
+
+// getSinkMetricsRegistry returns the registry event sinks should report their health to. Handlers are wired
+// after the network is initialized, so the network registry is normally available
+func (self *Dispatcher) getSinkMetricsRegistry() metrics.Registry {
+	if self != nil && self.network != nil {
+		return self.network.GetMetricsRegistry()
+	}
+	return metrics.NewRegistry(self.getCtrlId(), nil)
+}
+
+func (self *Dispatcher) getCtrlId() string {
+	if self == nil {
+		return ""
+	}
+	return self.ctrlId
+}
+
+// eventSinkHealth tracks delivery metrics for event sinks which publish to an external system
+type eventSinkHealth struct {
+	healthy      atomic.Bool
+	sentMeter    metrics.Meter
+	errorMeter   metrics.Meter
+	droppedMeter metrics.Meter
+	gauges       []metrics.Gauge
+}
+
+func newEventSinkHealth(registry metrics.Registry, prefix string, queueSize func() int64) *eventSinkHealth {
+	result := &eventSinkHealth{
+		sentMeter:    registry.Meter(prefix + ".sent"),
+		errorMeter:   registry.Meter(prefix + ".errors"),
+		droppedMeter: registry.Meter(prefix + ".dropped"),
+	}
+	result.healthy.Store(true)
+
+	result.gauges = append(result.gauges, registry.FuncGauge(prefix+".queue_size", queueSize))
+	result.gauges = append(result.gauges, registry.FuncGauge(prefix+".healthy", func() int64 {
+		if result.healthy.Load() {
+			return 1
+		}
+		return 0
+	}))
+
+	return result
+}
+
+func (self *eventSinkHealth) sent(count int) {
+	self.healthy.Store(true)
+	self.sentMeter.Mark(int64(count))
+}
+
+func (self *eventSinkHealth) failed() {
+	self.healthy.Store(false)
+	self.errorMeter.Mark(1)
+}
+
+func (self *eventSinkHealth) setHealthy(healthy bool) {
+	self.healthy.Store(healthy)
+}
+
+func (self *eventSinkHealth) dropped(count int) {
+	self.droppedMeter.Mark(int64(count))
+}
+
+func (self *eventSinkHealth) dispose() {
+	self.sentMeter.Dispose()
+	self.errorMeter.Dispose()
+	self.droppedMeter.Dispose()
+	for _, gauge := range self.gauges {
+		gauge.Dispose()
+	}
+}
+
+// parseEventSinkTlsConfig loads the optional client identity for event sinks which connect to an external
+// service. If only a CA is required, tls can be enabled with `tls: true`
+func parseEventSinkTlsConfig(sinkType string, config map[interface{}]interface{}) (*tls.Config, error) {
+	if value, found := config["identity"]; found {
+		idMap, ok := value.(map[interface{}]interface{})
+		if !ok {
+			return nil, errors.Errorf("%s 'identity' must be a map", sinkType)
+		}
+		idConfig, err := identity.NewConfigFromMap(idMap)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s 'identity' configuration", sinkType)
+		}
+		if err = idConfig.ValidateForClient(); err != nil {
+			return nil, errors.Wrapf(err, "invalid %s 'identity' configuration", sinkType)
+		}
+		id, err := identity.LoadIdentity(*idConfig)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to load %s 'identity'", sinkType)
+		}
+		return id.ClientTLSConfig(), nil
+	}
+
+	if value, found := config["tls"]; found {
+		if enabled, ok := value.(bool); ok && enabled {
+			return &tls.Config{}, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,93 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package permissions
+
+const (
+	VerbRead   = "read"
+	VerbCreate = "create"
+	VerbUpdate = "update"
+	VerbDelete = "delete"
+
+	// Wildcard matches any entity type or verb in a grant
+	Wildcard = "*"
+)
+
+// EntityPermission returns the permission granting the given verb on the given entity type
+func EntityPermission(entityType, verb string) string {
+	return entityType + ":" + verb
+}
+
+// EntityResolver is implemented by resolvers which check access to a specific entity type. Access granted by
+// a scoped grant must additionally be checked against the entity being accessed
+type EntityResolver interface {
+	Resolver
+	GetEntityType() string
+	GetVerb() string
+}
+
+type RequireEntityPermission struct {
+	entityType  string
+	verb        string
+	permissions map[string]struct{}
+}
+
+func CanRead(entityType string) *RequireEntityPermission {
+	return NewRequireEntityPermission(entityType, VerbRead)
+}
+
+func CanCreate(entityType string) *RequireEntityPermission {
+	return NewRequireEntityPermission(entityType, VerbCreate)
+}
+
+func CanUpdate(entityType string) *RequireEntityPermission {
+	return NewRequireEntityPermission(entityType, VerbUpdate)
+}
+
+func CanDelete(entityType string) *RequireEntityPermission {
+	return NewRequireEntityPermission(entityType, VerbDelete)
+}
+
+func NewRequireEntityPermission(entityType, verb string) *RequireEntityPermission {
+	return &RequireEntityPermission{
+		entityType: entityType,
+		verb:       verb,
+		permissions: map[string]struct{}{
+			AdminPermission:                        {},
+			EntityPermission(entityType, verb):     {},
+			EntityPermission(entityType, Wildcard): {},
+			EntityPermission(Wildcard, verb):       {},
+			EntityPermission(Wildcard, Wildcard):   {},
+		},
+	}
+}
+
+func (rp *RequireEntityPermission) GetEntityType() string {
+	return rp.entityType
+}
+
+func (rp *RequireEntityPermission) GetVerb() string {
+	return rp.verb
+}
+
+func (rp *RequireEntityPermission) IsAllowed(identityPerms ...string) bool {
+	for _, p := range identityPerms {
+		if _, ok := rp.permissions[p]; ok {
+			return true
+		}
+	}
+	return false
+}
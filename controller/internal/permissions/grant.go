@@ -0,0 +1,412 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package permissions
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/storage/ast"
+	"github.com/openziti/storage/boltz"
+	"github.com/pkg/errors"
+)
+
+// Grant allows the listed verbs on the listed entity types. If Tags or RoleAttributes are set, the grant is scoped
+// and only covers entities which have all the tags and at least one of the role attributes
+type Grant struct {
+	EntityTypes    []string
+	Verbs          []string
+	Tags           map[string]string
+	RoleAttributes []string
+}
+
+func (self *Grant) IsScoped() bool {
+	return len(self.Tags) > 0 || len(self.RoleAttributes) > 0
+}
+
+func (self *Grant) Covers(entityType, verb string) bool {
+	return matches(self.EntityTypes, entityType) && matches(self.Verbs, verb)
+}
+
+// Permissions returns the permissions the grant adds to a request. Scoped grants add the same permissions as
+// unscoped grants, the scope is checked separately against the entities being accessed
+func (self *Grant) Permissions() []string {
+	var result []string
+	for _, entityType := range self.EntityTypes {
+		for _, verb := range self.Verbs {
+			result = append(result, EntityPermission(entityType, verb))
+		}
+	}
+	return result
+}
+
+// GetFilter returns a query predicate matching the entities covered by the grant
+func (self *Grant) GetFilter() string {
+	var clauses []string
+
+	var keys []string
+	for k := range self.Tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		clauses = append(clauses, fmt.Sprintf(`tags.%s = "%s"`, k, self.Tags[k]))
+	}
+
+	if len(self.RoleAttributes) > 0 {
+		var attrClauses []string
+		for _, attr := range self.RoleAttributes {
+			attrClauses = append(attrClauses, fmt.Sprintf(`anyOf(roleAttributes) = "%s"`, attr))
+		}
+		clauses = append(clauses, "("+strings.Join(attrClauses, " or ")+")")
+	}
+
+	if len(clauses) == 0 {
+		return "true"
+	}
+
+	return strings.Join(clauses, " and ")
+}
+
+// AllowsValues checks the tags and role attributes of an entity being created or updated, as submitted in a
+// request body. If partial is true, fields missing from the body are left unchanged by the request and not checked
+func (self *Grant) AllowsValues(body map[string]interface{}, partial bool) bool {
+	if len(self.Tags) > 0 {
+		val, found := body["tags"]
+		if found || !partial {
+			tags, _ := val.(map[string]interface{})
+			for k, v := range self.Tags {
+				if tagVal, ok := tags[k]; !ok || fmt.Sprintf("%v", tagVal) != v {
+					return false
+				}
+			}
+		}
+	}
+
+	if len(self.RoleAttributes) > 0 {
+		val, found := body["roleAttributes"]
+		if found || !partial {
+			attrs, _ := val.([]interface{})
+			matched := false
+			for _, attr := range attrs {
+				if attrStr, ok := attr.(string); ok && slices.Contains(self.RoleAttributes, attrStr) {
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// GetScope returns the scoped grants which limit the given verb on the given entity type. If access isn't limited,
+// either because of an admin or unscoped grant, or because no grants apply, nil is returned
+func GetScope(identityPerms []string, grants []*Grant, entityType, verb string) []*Grant {
+	if slices.Contains(identityPerms, AdminPermission) {
+		return nil
+	}
+
+	var result []*Grant
+	for _, grant := range grants {
+		if grant.Covers(entityType, verb) {
+			if !grant.IsScoped() {
+				return nil
+			}
+			result = append(result, grant)
+		}
+	}
+	return result
+}
+
+// GetScopeFilter returns a query predicate matching the entities covered by any of the given grants
+func GetScopeFilter(scope []*Grant) string {
+	var clauses []string
+	for _, grant := range scope {
+		clauses = append(clauses, "("+grant.GetFilter()+")")
+	}
+	return strings.Join(clauses, " or ")
+}
+
+// ScopeAllowsValues returns true if any of the given grants allows the values in the request body
+func ScopeAllowsValues(scope []*Grant, body map[string]interface{}, partial bool) bool {
+	for _, grant := range scope {
+		if grant.AllowsValues(body, partial) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsIdentityValues checks the admin flag and role attributes of an identity being created or updated by a
+// request which doesn't have admin rights, as submitted in the request body. Admin rights can't be handed out, either
+// by changing the admin flag or by adding role attributes which confer one of the given admin roles, and added role
+// attributes must be allowed by the scope, if there is one. currentIsAdmin and currentAttributes describe the
+// identity being updated, and are empty for creates
+func AllowsIdentityValues(scope []*Grant, adminRoles []*Role, body map[string]interface{}, currentIsAdmin bool, currentAttributes []string) bool {
+	if val, found := body["isAdmin"]; found {
+		isAdmin, _ := val.(bool)
+		if isAdmin != currentIsAdmin {
+			return false
+		}
+	}
+
+	attrs, _ := body["roleAttributes"].([]interface{})
+	for _, attr := range attrs {
+		attrStr, ok := attr.(string)
+		if !ok {
+			return false
+		}
+
+		if slices.Contains(currentAttributes, attrStr) {
+			continue
+		}
+
+		for _, role := range adminRoles {
+			if slices.Contains(role.IdentityRoles, "#"+attrStr) {
+				return false
+			}
+		}
+
+		if len(scope) > 0 && !slices.ContainsFunc(scope, func(grant *Grant) bool {
+			return len(grant.RoleAttributes) == 0 || slices.Contains(grant.RoleAttributes, attrStr)
+		}) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// A ScopeFilterSource provides the query predicates which restrict requests holding scoped grants to the entities
+// those grants cover
+type ScopeFilterSource interface {
+	GetScopeFilter(entityType, verb string) (string, bool)
+}
+
+// ApplyReadScope restricts the query to the entities which the source's scoped grants allow reading, if any
+func ApplyReadScope(source ScopeFilterSource, store boltz.Store, query ast.Query) error {
+	filter, scoped := source.GetScopeFilter(store.GetEntityType(), VerbRead)
+	if !scoped {
+		return nil
+	}
+
+	scopeQuery, err := ast.Parse(store, filter)
+	if err != nil {
+		// the scope can't be evaluated against this store, so none of its entities are covered
+		pfxlog.Logger().WithError(err).WithField("entityType", store.GetEntityType()).Debug("unable to apply grant scope")
+		return errorz.NewUnauthorized()
+	}
+
+	query.SetPredicate(ast.NewAndExprNode(query.GetPredicate(), scopeQuery.GetPredicate()))
+	return nil
+}
+
+// Role is a named set of grants, held by identities matching the identity roles. Identity roles are either
+// #attribute, matching identities with the role attribute, @id, matching an identity by id or name, or #all
+type Role struct {
+	Name          string
+	IdentityRoles []string
+	Grants        []*Grant
+}
+
+func (self *Role) AppliesTo(identityId, identityName string, roleAttributes []string) bool {
+	for _, identityRole := range self.IdentityRoles {
+		if identityRole == "#all" {
+			return true
+		}
+		if attr, ok := strings.CutPrefix(identityRole, "#"); ok && slices.Contains(roleAttributes, attr) {
+			return true
+		}
+		if id, ok := strings.CutPrefix(identityRole, "@"); ok && (id == identityId || id == identityName) {
+			return true
+		}
+	}
+	return false
+}
+
+func LoadRoles(value interface{}) ([]*Role, error) {
+	list, ok := value.([]interface{})
+	if !ok {
+		return nil, errors.Errorf("invalid type for adminRoles, should be list instead of %T", value)
+	}
+
+	var result []*Role
+	names := map[string]struct{}{}
+
+	for idx, v := range list {
+		roleMap, ok := v.(map[interface{}]interface{})
+		if !ok {
+			return nil, errors.Errorf("invalid type for adminRoles[%d], should be map instead of %T", idx, v)
+		}
+
+		role, err := loadRole(roleMap)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid adminRoles[%d]", idx)
+		}
+
+		if _, found := names[role.Name]; found {
+			return nil, errors.Errorf("duplicate admin role name %s", role.Name)
+		}
+		names[role.Name] = struct{}{}
+
+		result = append(result, role)
+	}
+
+	return result, nil
+}
+
+func loadRole(cfgmap map[interface{}]interface{}) (*Role, error) {
+	role := &Role{}
+
+	if value, found := cfgmap["name"]; found {
+		role.Name = fmt.Sprintf("%v", value)
+	}
+
+	if role.Name == "" {
+		return nil, errors.New("name is required")
+	}
+
+	var err error
+	if role.IdentityRoles, err = loadStringList(cfgmap, "identityRoles"); err != nil {
+		return nil, err
+	}
+
+	if len(role.IdentityRoles) == 0 {
+		return nil, errors.New("at least one identity role is required")
+	}
+
+	for _, identityRole := range role.IdentityRoles {
+		if !strings.HasPrefix(identityRole, "#") && !strings.HasPrefix(identityRole, "@") {
+			return nil, errors.Errorf("invalid identity role %s, must start with # or @", identityRole)
+		}
+	}
+
+	grants, ok := cfgmap["grants"].([]interface{})
+	if !ok || len(grants) == 0 {
+		return nil, errors.New("grants must be a non-empty list")
+	}
+
+	for idx, v := range grants {
+		grantMap, ok := v.(map[interface{}]interface{})
+		if !ok {
+			return nil, errors.Errorf("invalid type for grants[%d], should be map instead of %T", idx, v)
+		}
+
+		grant, err := loadGrant(grantMap)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid grants[%d]", idx)
+		}
+		role.Grants = append(role.Grants, grant)
+	}
+
+	return role, nil
+}
+
+func loadGrant(cfgmap map[interface{}]interface{}) (*Grant, error) {
+	grant := &Grant{
+		Tags: map[string]string{},
+	}
+
+	var err error
+	if grant.EntityTypes, err = loadStringList(cfgmap, "entityTypes"); err != nil {
+		return nil, err
+	}
+
+	if len(grant.EntityTypes) == 0 {
+		return nil, errors.New("at least one entity type is required")
+	}
+
+	if grant.Verbs, err = loadStringList(cfgmap, "verbs"); err != nil {
+		return nil, err
+	}
+
+	if len(grant.Verbs) == 0 {
+		return nil, errors.New("at least one verb is required")
+	}
+
+	for _, verb := range grant.Verbs {
+		if verb != VerbRead && verb != VerbCreate && verb != VerbUpdate && verb != VerbDelete && verb != Wildcard {
+			return nil, errors.Errorf("invalid verb %s, must be one of read, create, update, delete or *", verb)
+		}
+	}
+
+	if value, found := cfgmap["tags"]; found {
+		tags, ok := value.(map[interface{}]interface{})
+		if !ok {
+			return nil, errors.Errorf("invalid type for tags, should be map instead of %T", value)
+		}
+		for k, v := range tags {
+			key, val := fmt.Sprintf("%v", k), fmt.Sprintf("%v", v)
+			if !isValidScopeValue(key) || !isValidScopeValue(val) {
+				return nil, errors.Errorf("invalid tag %s=%s", key, val)
+			}
+			grant.Tags[key] = val
+		}
+	}
+
+	if grant.RoleAttributes, err = loadStringList(cfgmap, "roleAttributes"); err != nil {
+		return nil, err
+	}
+
+	for _, attr := range grant.RoleAttributes {
+		if !isValidScopeValue(attr) {
+			return nil, errors.Errorf("invalid role attribute %s", attr)
+		}
+	}
+
+	return grant, nil
+}
+
+func loadStringList(cfgmap map[interface{}]interface{}, field string) ([]string, error) {
+	value, found := cfgmap[field]
+	if !found {
+		return nil, nil
+	}
+
+	list, ok := value.([]interface{})
+	if !ok {
+		return nil, errors.Errorf("invalid type for %s, should be list instead of %T", field, value)
+	}
+
+	var result []string
+	for _, v := range list {
+		result = append(result, fmt.Sprintf("%v", v))
+	}
+	return result, nil
+}
+
+func isValidScopeValue(val string) bool {
+	return val != "" && !strings.ContainsAny(val, "\"\\ ")
+}
+
+func matches(list []string, val string) bool {
+	for _, v := range list {
+		if v == val || v == Wildcard {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,162 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package permissions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+)
+
+const testRoles = `
+adminRoles:
+  - name: payments-admin
+    identityRoles: ['#payments-admins', '@alice']
+    grants:
+      - entityTypes: [services, configs]
+        verbs: [read, create, update, delete]
+        tags:
+          team: payments
+      - entityTypes: [identities]
+        verbs: [read]
+  - name: auditor
+    identityRoles: ['#all']
+    grants:
+      - entityTypes: ['*']
+        verbs: [read]
+        roleAttributes: [audited, public]
+`
+
+func loadTestRoles(t *testing.T) []*Role {
+	cfg := map[interface{}]interface{}{}
+	require.NoError(t, yaml.Unmarshal([]byte(testRoles), &cfg))
+	roles, err := LoadRoles(cfg["adminRoles"])
+	require.NoError(t, err)
+	return roles
+}
+
+func TestLoadRoles(t *testing.T) {
+	req := require.New(t)
+	roles := loadTestRoles(t)
+	req.Len(roles, 2)
+
+	payments := roles[0]
+	req.Equal("payments-admin", payments.Name)
+	req.True(payments.AppliesTo("id1", "bob", []string{"payments-admins"}))
+	req.True(payments.AppliesTo("id2", "alice", nil))
+	req.False(payments.AppliesTo("id3", "carol", []string{"other"}))
+	req.True(roles[1].AppliesTo("id3", "carol", nil))
+
+	req.Len(payments.Grants, 2)
+	req.Equal(map[string]string{"team": "payments"}, payments.Grants[0].Tags)
+	req.True(payments.Grants[0].IsScoped())
+	req.False(payments.Grants[1].IsScoped())
+
+	invalid := []string{
+		`[{name: x, identityRoles: ['#a'], grants: [{entityTypes: [services], verbs: [write]}]}]`,
+		`[{name: x, identityRoles: ['a'], grants: [{entityTypes: [services], verbs: [read]}]}]`,
+		`[{name: x, identityRoles: ['#a']}]`,
+		`[{name: x, identityRoles: ['#a'], grants: [{entityTypes: [services], verbs: [read], tags: {team: 'a"b'}}]}]`,
+	}
+	for _, cfg := range invalid {
+		var val []interface{}
+		req.NoError(yaml.Unmarshal([]byte(cfg), &val))
+		_, err := LoadRoles(val)
+		req.Error(err, cfg)
+	}
+}
+
+func TestEntityPermissions(t *testing.T) {
+	req := require.New(t)
+	roles := loadTestRoles(t)
+
+	var perms []string
+	for _, grant := range roles[0].Grants {
+		perms = append(perms, grant.Permissions()...)
+	}
+
+	req.True(CanUpdate("services").IsAllowed(perms...))
+	req.True(CanRead("identities").IsAllowed(perms...))
+	req.False(CanUpdate("identities").IsAllowed(perms...))
+	req.False(CanRead("routers").IsAllowed(perms...))
+	req.True(CanRead("routers").IsAllowed(roles[1].Grants[0].Permissions()...))
+	req.True(CanDelete("routers").IsAllowed(AdminPermission))
+}
+
+func TestScope(t *testing.T) {
+	req := require.New(t)
+	roles := loadTestRoles(t)
+	grants := roles[0].Grants
+
+	req.Nil(GetScope([]string{AdminPermission}, grants, "services", VerbRead))
+	req.Nil(GetScope(nil, grants, "identities", VerbRead))
+	req.Nil(GetScope(nil, grants, "routers", VerbRead))
+
+	scope := GetScope(nil, grants, "services", VerbUpdate)
+	req.Len(scope, 1)
+	req.Equal(`(tags.team = "payments")`, GetScopeFilter(scope))
+
+	scope = GetScope(nil, append(grants, roles[1].Grants...), "services", VerbRead)
+	req.Len(scope, 2)
+	req.Equal(`(tags.team = "payments") or ((anyOf(roleAttributes) = "audited" or anyOf(roleAttributes) = "public"))`, GetScopeFilter(scope))
+
+	inScope := map[string]interface{}{"tags": map[string]interface{}{"team": "payments"}}
+	outOfScope := map[string]interface{}{"tags": map[string]interface{}{"team": "billing"}}
+	noTags := map[string]interface{}{"name": "svc"}
+
+	scope = GetScope(nil, grants, "services", VerbCreate)
+	req.True(ScopeAllowsValues(scope, inScope, false))
+	req.False(ScopeAllowsValues(scope, outOfScope, false))
+	req.False(ScopeAllowsValues(scope, noTags, false))
+	req.True(ScopeAllowsValues(scope, noTags, true))
+	req.False(ScopeAllowsValues(scope, outOfScope, true))
+
+	attrs := map[string]interface{}{"roleAttributes": []interface{}{"other", "public"}}
+	req.True(roles[1].Grants[0].AllowsValues(attrs, false))
+	req.False(roles[1].Grants[0].AllowsValues(noTags, false))
+}
+
+func TestAllowsIdentityValues(t *testing.T) {
+	req := require.New(t)
+	roles := loadTestRoles(t)
+
+	scoped := []*Grant{{EntityTypes: []string{"identities"}, Verbs: []string{VerbCreate}, RoleAttributes: []string{"devices", "laptops"}}}
+
+	body := func(isAdmin bool, attrs ...interface{}) map[string]interface{} {
+		return map[string]interface{}{"isAdmin": isAdmin, "roleAttributes": attrs}
+	}
+
+	// admin rights can't be handed out or taken away, scoped or not
+	req.True(AllowsIdentityValues(nil, roles, body(false, "devices"), false, nil))
+	req.False(AllowsIdentityValues(nil, roles, body(true), false, nil))
+	req.False(AllowsIdentityValues(scoped, roles, body(true, "devices"), false, nil))
+	req.False(AllowsIdentityValues(nil, roles, body(false), true, nil))
+	req.True(AllowsIdentityValues(nil, roles, body(true), true, nil))
+	req.True(AllowsIdentityValues(nil, roles, map[string]interface{}{"name": "id1"}, true, nil))
+
+	// nor can the role attributes of admin roles be added, though identities already holding them can be updated
+	req.False(AllowsIdentityValues(nil, roles, body(false, "payments-admins"), false, nil))
+	req.False(AllowsIdentityValues(nil, roles, body(false, "devices", "payments-admins"), false, []string{"devices"}))
+	req.True(AllowsIdentityValues(nil, roles, body(false, "devices", "payments-admins"), false, []string{"payments-admins"}))
+
+	// added role attributes must be allowed by the scope
+	req.True(AllowsIdentityValues(scoped, roles, body(false, "devices", "laptops"), false, nil))
+	req.False(AllowsIdentityValues(scoped, roles, body(false, "devices", "servers"), false, nil))
+	req.True(AllowsIdentityValues(scoped, roles, body(false, "devices", "servers"), false, []string{"servers"}))
+	req.False(AllowsIdentityValues(scoped, roles, body(false, 42), false, nil))
+}
@@ -39,6 +39,9 @@ type ServicePolicyEnforcer struct {
 	appEnv *env.AppEnv
 	*runner.BaseOperation
 	notify chan struct{}
+
+	// scheduleStates holds the last seen active state of scheduled service policies. Only accessed from Run
+	scheduleStates map[string]bool
 }
 
 func NewServicePolicyEnforcer(appEnv *env.AppEnv, f time.Duration) *ServicePolicyEnforcer {
@@ -111,8 +114,52 @@ func (enforcer *ServicePolicyEnforcer) handleServiceEvent(event *db.ServiceEvent
 	enforcer.appEnv.GetMetricsRegistry().Meter(SessionPolicyEnforcerEventDeletes).Mark(int64(len(sessionsToDelete)))
 }
 
+// checkSchedules records the active state of scheduled service policies and requests a full scan if any of them
+// have left their schedule since the last run.
+func (enforcer *ServicePolicyEnforcer) checkSchedules() {
+	now := time.Now()
+	current := map[string]bool{}
+
+	err := enforcer.appEnv.GetDb().View(func(tx *bbolt.Tx) error {
+		store := enforcer.appEnv.GetStores().ServicePolicy
+		ids, _, err := store.QueryIds(tx, fmt.Sprintf("%s = true limit none", db.FieldServicePolicyScheduled))
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			policy, err := store.LoadById(tx, id)
+			if err != nil {
+				return err
+			}
+			policySchedule, err := policy.Schedule.Parse()
+			current[id] = err == nil && policySchedule.IsActive(now)
+		}
+		return nil
+	})
+
+	if err != nil {
+		pfxlog.Logger().WithError(err).Error("error while checking service policy schedules")
+		return
+	}
+
+	for id, active := range current {
+		if prev, found := enforcer.scheduleStates[id]; !active && (!found || prev) {
+			pfxlog.Logger().WithField("policyId", id).Info("service policy left its schedule, checking sessions")
+			select {
+			case enforcer.notify <- struct{}{}:
+			default:
+			}
+			break
+		}
+	}
+
+	enforcer.scheduleStates = current
+}
+
 func (enforcer *ServicePolicyEnforcer) Run() error {
-	// if we haven't been notified to run b/c of startup or handler error, skip run
+	enforcer.checkSchedules()
+
+	// if we haven't been notified to run b/c of startup, handler error or a schedule closing, skip run
 	select {
 	case <-enforcer.notify:
 	default:
@@ -131,7 +178,16 @@ func (enforcer *ServicePolicyEnforcer) Run() error {
 		return err
 	}
 
+	type scheduledAccess struct {
+		sessionId  string
+		identityId string
+		serviceId  string
+		policyType db.PolicyType
+	}
+
 	var sessionsToRemove []string
+	var scheduledAccessToCheck []scheduledAccess
+
 	err = enforcer.appEnv.GetDb().View(func(tx *bbolt.Tx) error {
 		for _, session := range result.Sessions {
 			apiSession, err := enforcer.appEnv.GetStores().ApiSession.LoadById(tx, session.ApiSessionId)
@@ -159,6 +215,13 @@ func (enforcer *ServicePolicyEnforcer) Run() error {
 			}
 			if count == 0 {
 				sessionsToRemove = append(sessionsToRemove, session.Id)
+			} else if len(enforcer.scheduleStates) > 0 {
+				scheduledAccessToCheck = append(scheduledAccessToCheck, scheduledAccess{
+					sessionId:  session.Id,
+					identityId: identity.Id,
+					serviceId:  session.ServiceId,
+					policyType: policyType,
+				})
 			}
 		}
 		return nil
@@ -168,6 +231,13 @@ func (enforcer *ServicePolicyEnforcer) Run() error {
 		return err
 	}
 
+	for _, access := range scheduledAccessToCheck {
+		active, _ := enforcer.appEnv.GetManagers().EdgeService.GetPoliciesBySchedule(access.identityId, access.serviceId, access.policyType)
+		if len(active) == 0 {
+			sessionsToRemove = append(sessionsToRemove, access.sessionId)
+		}
+	}
+
 	ctx := change.New().SetSourceType("service-policy.enforcer").SetChangeAuthorType(change.AuthorTypeController)
 	for _, sessionId := range sessionsToRemove {
 		_ = enforcer.appEnv.GetManagers().Session.Delete(sessionId, ctx)
@@ -0,0 +1,101 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package routes
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/foundation/v2/errorz"
+	"go.etcd.io/bbolt"
+	"ztna-core/ztna/controller/apierror"
+	"ztna-core/ztna/controller/db"
+	"ztna-core/ztna/controller/env"
+	"ztna-core/ztna/controller/response"
+)
+
+const AccessMatrixPath = "/access-matrix"
+
+func init() {
+	r := NewAccessMatrixRouter()
+	env.AddRouter(r)
+}
+
+// AccessMatrixRouter serves the effective access of every identity to every service. It is returned as JSON, or as
+// CSV if the format query parameter is csv. Policy schedules are evaluated at the RFC3339 time given by the at query
+// parameter, or now if it is not set.
+type AccessMatrixRouter struct {
+	BasePath string
+}
+
+func NewAccessMatrixRouter() *AccessMatrixRouter {
+	return &AccessMatrixRouter{
+		BasePath: AccessMatrixPath,
+	}
+}
+
+func (r *AccessMatrixRouter) Register(ae *env.AppEnv) {
+	ae.AddManagementRoute(r.BasePath, func(ae *env.AppEnv, rc *response.RequestContext) {
+		if rc.Request.Method != http.MethodGet {
+			rc.RespondWithApiError(apierror.NewMethodNotAllowed())
+			return
+		}
+
+		if !canReadAllPolicyEntities(ae, rc) {
+			rc.RespondWithApiError(errorz.NewUnauthorized())
+			return
+		}
+
+		r.Get(ae, rc)
+	})
+}
+
+func (r *AccessMatrixRouter) Get(ae *env.AppEnv, rc *response.RequestContext) {
+	at := time.Now()
+	if val := rc.Request.URL.Query().Get("at"); val != "" {
+		var err error
+		if at, err = time.Parse(time.RFC3339, val); err != nil {
+			rc.RespondWithFieldError(errorz.NewFieldError("at must be an RFC3339 timestamp", "at", val))
+			return
+		}
+	}
+
+	var matrix *db.AccessMatrix
+	err := ae.GetDb().View(func(tx *bbolt.Tx) error {
+		var err error
+		matrix, err = db.BuildAccessMatrix(tx, ae.GetStores(), at, ae.IsEdgeRouterOnline)
+		return err
+	})
+
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	if rc.Request.URL.Query().Get("format") != "csv" {
+		rc.RespondWithOk(matrix, nil)
+		return
+	}
+
+	rc.ResponseWriter.Header().Set("Content-Type", "text/csv")
+	rc.ResponseWriter.Header().Set("Content-Disposition", `attachment; filename="access-matrix.csv"`)
+	rc.ResponseWriter.WriteHeader(http.StatusOK)
+	if err = matrix.WriteCsv(rc.ResponseWriter); err != nil {
+		pfxlog.Logger().WithError(err).Error("unable to write access matrix")
+	}
+}
@@ -18,6 +18,7 @@ package routes
 
 import (
 	"ztna-core/edge-api/rest_management_api_server/operations/api_session"
+	"ztna-core/ztna/controller/db"
 	"ztna-core/ztna/controller/env"
 	"ztna-core/ztna/controller/internal/permissions"
 	"ztna-core/ztna/controller/model"
@@ -43,15 +44,15 @@ func NewApiSessionRouter() *ApiSessionHandler {
 
 func (ir *ApiSessionHandler) Register(ae *env.AppEnv) {
 	ae.ManagementApi.APISessionDeleteAPISessionsHandler = api_session.DeleteAPISessionsHandlerFunc(func(params api_session.DeleteAPISessionsParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(ir.Delete, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+		return ae.IsAllowed(ir.Delete, params.HTTPRequest, params.ID, "", permissions.CanDelete(db.EntityTypeApiSessions))
 	})
 
 	ae.ManagementApi.APISessionDetailAPISessionsHandler = api_session.DetailAPISessionsHandlerFunc(func(params api_session.DetailAPISessionsParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(ir.Detail, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+		return ae.IsAllowed(ir.Detail, params.HTTPRequest, params.ID, "", permissions.CanRead(db.EntityTypeApiSessions))
 	})
 
 	ae.ManagementApi.APISessionListAPISessionsHandler = api_session.ListAPISessionsHandlerFunc(func(params api_session.ListAPISessionsParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(ir.List, params.HTTPRequest, "", "", permissions.IsAdmin())
+		return ae.IsAllowed(ir.List, params.HTTPRequest, "", "", permissions.CanRead(db.EntityTypeApiSessions))
 	})
 }
 
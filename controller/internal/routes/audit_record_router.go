@@ -0,0 +1,213 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package routes
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+	"ztna-core/ztna/controller/apierror"
+	"ztna-core/ztna/controller/audit"
+	"ztna-core/ztna/controller/db"
+	"ztna-core/ztna/controller/env"
+	"ztna-core/ztna/controller/internal/permissions"
+	"ztna-core/ztna/controller/models"
+	"ztna-core/ztna/controller/response"
+
+	"github.com/openziti/foundation/v2/errorz"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	AuditRecordsPath       = "/audit-records"
+	AuditRecordsVerifyPath = "/audit-records/verify"
+
+	auditRecordsDefaultSort = db.FieldAuditRecordSequence + " desc"
+)
+
+// AuditRecordDetail is the api representation of an audit record
+type AuditRecordDetail struct {
+	Id         string          `json:"id"`
+	Sequence   int64           `json:"sequence"`
+	Timestamp  time.Time       `json:"timestamp"`
+	ChangeType string          `json:"changeType"`
+	EntityType string          `json:"entityType"`
+	EntityId   string          `json:"entityId"`
+	ActorType  string          `json:"actorType"`
+	ActorId    string          `json:"actorId"`
+	ActorName  string          `json:"actorName"`
+	AuthMethod string          `json:"authMethod"`
+	Api        string          `json:"api"`
+	SourceType string          `json:"sourceType"`
+	Method     string          `json:"method"`
+	RemoteAddr string          `json:"remoteAddr"`
+	LocalAddr  string          `json:"localAddr"`
+	TraceId    string          `json:"traceId"`
+	Changes    json.RawMessage `json:"changes"`
+	PrevHash   string          `json:"prevHash"`
+	Hash       string          `json:"hash"`
+}
+
+func MapAuditRecordToRestEntity(record *db.AuditRecord) *AuditRecordDetail {
+	changes := json.RawMessage(record.Changes)
+	if len(changes) == 0 {
+		changes = json.RawMessage("{}")
+	}
+
+	return &AuditRecordDetail{
+		Id:         record.Id,
+		Sequence:   record.Sequence,
+		Timestamp:  record.Timestamp,
+		ChangeType: record.ChangeType,
+		EntityType: record.EntityType,
+		EntityId:   record.EntityId,
+		ActorType:  record.ActorType,
+		ActorId:    record.ActorId,
+		ActorName:  record.ActorName,
+		AuthMethod: record.AuthMethod,
+		Api:        record.Api,
+		SourceType: record.SourceType,
+		Method:     record.Method,
+		RemoteAddr: record.RemoteAddr,
+		LocalAddr:  record.LocalAddr,
+		TraceId:    record.TraceId,
+		Changes:    changes,
+		PrevHash:   record.PrevHash,
+		Hash:       record.Hash,
+	}
+}
+
+func init() {
+	r := NewAuditRecordRouter()
+	env.AddRouter(r)
+}
+
+// AuditRecordRouter serves the audit log. The audit log isn't part of the generated management api, so its paths are
+// registered as management routes
+type AuditRecordRouter struct {
+	BasePath string
+}
+
+func NewAuditRecordRouter() *AuditRecordRouter {
+	return &AuditRecordRouter{
+		BasePath: AuditRecordsPath,
+	}
+}
+
+func (r *AuditRecordRouter) Register(ae *env.AppEnv) {
+	ae.AddManagementRoute(AuditRecordsPath, r.wrap(r.List))
+	ae.AddManagementRoute(AuditRecordsVerifyPath, r.wrap(r.Verify))
+}
+
+func (r *AuditRecordRouter) wrap(handler env.ManagementRouteHandler) env.ManagementRouteHandler {
+	return func(ae *env.AppEnv, rc *response.RequestContext) {
+		if rc.Request.Method != http.MethodGet {
+			rc.RespondWithApiError(apierror.NewMethodNotAllowed())
+			return
+		}
+
+		if !ae.CheckPermissions(rc, permissions.CanRead(db.EntityTypeAuditRecords)) {
+			rc.RespondWithApiError(errorz.NewUnauthorized())
+			return
+		}
+
+		handler(ae, rc)
+	}
+}
+
+func (r *AuditRecordRouter) List(ae *env.AppEnv, rc *response.RequestContext) {
+	List(rc, func(rc *response.RequestContext, queryOptions *PublicQueryOptions) (*QueryResult, error) {
+		return listAuditRecords(ae, rc, queryOptions)
+	})
+}
+
+func (r *AuditRecordRouter) Verify(ae *env.AppEnv, rc *response.RequestContext) {
+	var result *audit.VerifyResult
+	err := ae.GetDb().View(func(tx *bbolt.Tx) error {
+		var err error
+		result, err = audit.Verify(tx, ae.GetStores().AuditRecord)
+		return err
+	})
+
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	rc.RespondWithOk(result, nil)
+}
+
+func listAuditRecords(ae *env.AppEnv, rc *response.RequestContext, queryOptions *PublicQueryOptions) (*QueryResult, error) {
+	store := ae.GetStores().AuditRecord
+
+	if queryOptions.Sort == "" {
+		queryOptions.Sort = auditRecordsDefaultSort
+	}
+
+	query, err := queryOptions.getFullQuery(store)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = permissions.ApplyReadScope(rc, store, query); err != nil {
+		return nil, err
+	}
+
+	if query.GetLimit() == nil || *query.GetLimit() <= 0 {
+		query.SetLimit(models.ListLimitDefault)
+	} else if *query.GetLimit() > models.ListLimitMax {
+		query.SetLimit(models.ListLimitMax)
+	}
+
+	if query.GetSkip() == nil || *query.GetSkip() < 0 {
+		query.SetSkip(models.ListOffsetDefault)
+	} else if *query.GetSkip() > models.ListOffsetMax {
+		query.SetSkip(models.ListOffsetMax)
+	}
+
+	var result []*AuditRecordDetail
+	var count int64
+
+	err = ae.GetDb().View(func(tx *bbolt.Tx) error {
+		var ids []string
+		ids, count, err = store.QueryIdsC(tx, query)
+		if err != nil {
+			return err
+		}
+
+		for _, id := range ids {
+			record, err := store.LoadById(tx, id)
+			if err != nil {
+				return err
+			}
+			result = append(result, MapAuditRecordToRestEntity(record))
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &QueryResult{
+		Result:           result,
+		Count:            count,
+		Limit:            *query.GetLimit(),
+		Offset:           *query.GetSkip(),
+		FilterableFields: store.GetPublicSymbols(),
+	}, nil
+}
@@ -227,6 +227,7 @@ func (ro *AuthRouter) authHandler(ae *env.AppEnv, rc *response.RequestContext, h
 	}
 
 	ae.GetManagers().PostureResponse.SetSdkInfo(identity.Id, sessionId, identity.SdkInfo)
+	ae.GetManagers().PostureResponse.SetSourceIp(identity.Id, sessionId, rc.Request.RemoteAddr)
 
 	rc.ApiSession = filledApiSession
 
@@ -28,6 +28,7 @@ import (
 	"ztna-core/ztna/controller/change"
 	"ztna-core/ztna/controller/env"
 	"ztna-core/ztna/controller/fields"
+	"ztna-core/ztna/controller/internal/permissions"
 	"ztna-core/ztna/controller/model"
 	"ztna-core/ztna/controller/models"
 	"ztna-core/ztna/controller/response"
@@ -103,6 +104,10 @@ func ListWithQueryFAndCollector[E models.Entity](ae *env.AppEnv,
 			return nil, err
 		}
 
+		if err = permissions.ApplyReadScope(rc, lister.GetListStore(), query); err != nil {
+			return nil, err
+		}
+
 		result, err := qf(query)
 		if err != nil {
 			return nil, err
@@ -413,6 +418,16 @@ func listWithId(rc *response.RequestContext, f func(id string) ([]interface{}, e
 	rc.RespondWithOk(results, meta)
 }
 
+// applyAssociatedReadScope restricts an association list to the associated entities the request may read. Access to
+// association lists is checked against the parent entity, so requests limited by grants must also be allowed to
+// read the associated entity type, otherwise there's no scope to restrict the list with
+func applyAssociatedReadScope(rc *response.RequestContext, store boltz.Store, query ast.Query) error {
+	if rc.EnforceGrantScopes && !permissions.CanRead(store.GetEntityType()).IsAllowed(rc.ActivePermissions...) {
+		return errorz.NewUnauthorized()
+	}
+	return permissions.ApplyReadScope(rc, store, query)
+}
+
 // type ListAssocF func(string, func(models.Entity)) error
 type listAssocF func(rc *response.RequestContext, id string, queryOptions *PublicQueryOptions) (*QueryResult, error)
 
@@ -433,6 +448,10 @@ func ListAssociationsWithFilter[E models.Entity](ae *env.AppEnv,
 			return nil, err
 		}
 
+		if err = applyAssociatedReadScope(rc, entityController.GetListStore(), query); err != nil {
+			return nil, err
+		}
+
 		filter := filterTemplate
 		if strings.Contains(filterTemplate, "%v") || strings.Contains(filterTemplate, "%s") {
 			filter = fmt.Sprintf(filterTemplate, id)
@@ -472,6 +491,10 @@ func ListAssociationWithHandler[E models.Entity, A models.Entity](ae *env.AppEnv
 			return nil, err
 		}
 
+		if err = applyAssociatedReadScope(rc, associationLoader.GetListStore(), query); err != nil {
+			return nil, err
+		}
+
 		result := models.EntityListResult[A]{
 			Loader: associationLoader,
 		}
@@ -501,6 +524,10 @@ func ListTerminatorAssociations(ae *env.AppEnv, rc *response.RequestContext,
 			return nil, err
 		}
 
+		if err = applyAssociatedReadScope(rc, associationLoader.GetStore(), query); err != nil {
+			return nil, err
+		}
+
 		result := models.EntityListResult[*model.Terminator]{
 			Loader: associationLoader,
 		}
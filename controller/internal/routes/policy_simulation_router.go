@@ -0,0 +1,108 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package routes
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/openziti/foundation/v2/errorz"
+	"ztna-core/ztna/controller/apierror"
+	"ztna-core/ztna/controller/db"
+	"ztna-core/ztna/controller/env"
+	"ztna-core/ztna/controller/internal/permissions"
+	"ztna-core/ztna/controller/model"
+	"ztna-core/ztna/controller/response"
+)
+
+const PolicySimulationPath = "/policy-advice/simulate"
+
+// policyEntityTypes are read in full by policy simulations and the access matrix, so scoped grants on them aren't
+// sufficient
+var policyEntityTypes = []string{
+	db.EntityTypeIdentities,
+	db.EntityTypeServices,
+	db.EntityTypeRouters,
+	db.EntityTypePostureChecks,
+	db.EntityTypeServicePolicies,
+	db.EntityTypeEdgeRouterPolicies,
+	db.EntityTypeServiceEdgeRouterPolicies,
+}
+
+func init() {
+	r := NewPolicySimulationRouter()
+	env.AddRouter(r)
+}
+
+// PolicySimulationRouter serves policy simulations, which report how proposed changes to policies, identities,
+// services and edge routers would change effective access, without making them
+type PolicySimulationRouter struct {
+	BasePath string
+}
+
+func NewPolicySimulationRouter() *PolicySimulationRouter {
+	return &PolicySimulationRouter{
+		BasePath: PolicySimulationPath,
+	}
+}
+
+func (r *PolicySimulationRouter) Register(ae *env.AppEnv) {
+	ae.AddManagementRoute(r.BasePath, func(ae *env.AppEnv, rc *response.RequestContext) {
+		if rc.Request.Method != http.MethodPost {
+			rc.RespondWithApiError(apierror.NewMethodNotAllowed())
+			return
+		}
+
+		if !canReadAllPolicyEntities(ae, rc) {
+			rc.RespondWithApiError(errorz.NewUnauthorized())
+			return
+		}
+
+		r.Simulate(ae, rc)
+	})
+}
+
+func canReadAllPolicyEntities(ae *env.AppEnv, rc *response.RequestContext) bool {
+	for _, entityType := range policyEntityTypes {
+		if !ae.CheckPermissions(rc, permissions.CanRead(entityType)) || len(rc.GetScope(entityType, permissions.VerbRead)) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *PolicySimulationRouter) Simulate(ae *env.AppEnv, rc *response.RequestContext) {
+	changes := &model.PolicySimulationChanges{}
+	if len(rc.Body) > 0 {
+		if err := json.Unmarshal(rc.Body, changes); err != nil {
+			rc.RespondWithCouldNotParseBody(err)
+			return
+		}
+	}
+
+	result, err := ae.Managers.PolicyAdvisor.Simulate(changes)
+	if err != nil {
+		if fe, ok := err.(*errorz.FieldError); ok {
+			rc.RespondWithFieldError(fe)
+			return
+		}
+		rc.RespondWithError(err)
+		return
+	}
+
+	rc.RespondWithOk(result, nil)
+}
@@ -373,6 +373,10 @@ func MapPostureCheckToRestModel(ae *env.AppEnv, rc *response.RequestContext, i *
 
 		ret = detail
 		setBaseEntityDetailsOnPostureCheck(ret, i)
+	case *model.PostureCheckSourceIp:
+		ret = MapPostureCheckSourceIpToRestModel(i, subType)
+	case *model.PostureCheckAttestation:
+		ret = MapPostureCheckAttestationToRestModel(i, subType)
 	}
 
 	return ret, nil
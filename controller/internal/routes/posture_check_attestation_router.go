@@ -0,0 +1,159 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package routes
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"ztna-core/ztna/common/attestation"
+	"ztna-core/ztna/controller/apierror"
+	"ztna-core/ztna/controller/env"
+	"ztna-core/ztna/controller/model"
+	"ztna-core/ztna/controller/response"
+
+	"github.com/openziti/foundation/v2/errorz"
+)
+
+const (
+	// PostureCheckAttestationPath serves create and update of ATTESTATION posture checks
+	PostureCheckAttestationPath = "/" + EntityNamePostureCheck + "/attestation"
+
+	// PostureResponseAttestationPath accepts signed attestation statements from clients
+	PostureResponseAttestationPath = "/" + EntityNamePostureResponse + "/attestation"
+
+	maxAttestationStatementLength = 16 * 1024
+)
+
+func init() {
+	env.AddRouter(&CustomPostureCheckRouter{
+		BasePath: PostureCheckAttestationPath,
+		TypeId:   model.PostureCheckTypeAttestation,
+		newRequest: func(check *model.PostureCheck) CustomPostureCheckRequest {
+			request := &PostureCheckAttestationRequest{}
+			if check != nil {
+				request.fill(check)
+				if subType, ok := check.SubType.(*model.PostureCheckAttestation); ok {
+					request.PublicKeys = subType.PublicKeys
+					request.MaxAgeSeconds = subType.MaxAgeSeconds
+					request.RequireDiskEncryption = subType.RequireDiskEncryption
+					request.RequireFirewall = subType.RequireFirewall
+					request.EdrAgent = subType.EdrAgent
+					request.MinEdrVersion = subType.MinEdrVersion
+				}
+			}
+			return request
+		},
+	})
+
+	env.AddRouter(&PostureResponseAttestationRouter{
+		BasePath: PostureResponseAttestationPath,
+	})
+}
+
+// PostureCheckAttestationRequest is the body of an ATTESTATION posture check create, update or patch
+type PostureCheckAttestationRequest struct {
+	PostureCheckRequestBase
+	PublicKeys            []string `json:"publicKeys"`
+	MaxAgeSeconds         int64    `json:"maxAgeSeconds"`
+	RequireDiskEncryption bool     `json:"requireDiskEncryption"`
+	RequireFirewall       bool     `json:"requireFirewall"`
+	EdrAgent              string   `json:"edrAgent"`
+	MinEdrVersion         string   `json:"minEdrVersion"`
+}
+
+func (self *PostureCheckAttestationRequest) toModel(id string) (*model.PostureCheck, error) {
+	subType := &model.PostureCheckAttestation{
+		Check: attestation.Check{
+			PublicKeys:            stringsOrEmpty(self.PublicKeys),
+			MaxAgeSeconds:         self.MaxAgeSeconds,
+			RequireDiskEncryption: self.RequireDiskEncryption,
+			RequireFirewall:       self.RequireFirewall,
+			EdrAgent:              self.EdrAgent,
+			MinEdrVersion:         self.MinEdrVersion,
+		},
+	}
+
+	if err := subType.Validate(); err != nil {
+		return nil, err
+	}
+
+	return self.PostureCheckRequestBase.toModel(id, subType)
+}
+
+func MapPostureCheckAttestationToRestModel(i *model.PostureCheck, subType *model.PostureCheckAttestation) *CustomPostureCheckDetail {
+	return NewCustomPostureCheckDetail(i, map[string]interface{}{
+		"publicKeys":            stringsOrEmpty(subType.PublicKeys),
+		"maxAgeSeconds":         subType.MaxAgeSeconds,
+		"requireDiskEncryption": subType.RequireDiskEncryption,
+		"requireFirewall":       subType.RequireFirewall,
+		"edrAgent":              subType.EdrAgent,
+		"minEdrVersion":         subType.MinEdrVersion,
+	})
+}
+
+// PostureResponseAttestationRequest is the body of a client attestation submission. Statement is a compact JWS
+// signed by a key pinned in an ATTESTATION posture check.
+type PostureResponseAttestationRequest struct {
+	Statement string `json:"statement"`
+}
+
+// PostureResponseAttestationRouter accepts attestation statements for the current API Session, registered as a
+// client route
+type PostureResponseAttestationRouter struct {
+	BasePath string
+}
+
+func (r *PostureResponseAttestationRouter) Register(ae *env.AppEnv) {
+	ae.AddClientRoute(r.BasePath, func(ae *env.AppEnv, rc *response.RequestContext) {
+		if rc.Request.Method != http.MethodPost {
+			rc.RespondWithApiError(apierror.NewMethodNotAllowed())
+			return
+		}
+
+		if rc.ApiSession == nil || rc.Identity == nil {
+			rc.RespondWithApiError(errorz.NewUnauthorized())
+			return
+		}
+
+		r.Create(ae, rc)
+	})
+}
+
+func (r *PostureResponseAttestationRouter) Create(ae *env.AppEnv, rc *response.RequestContext) {
+	request := &PostureResponseAttestationRequest{}
+	if err := json.Unmarshal(rc.Body, request); err != nil {
+		rc.RespondWithCouldNotParseBody(err)
+		return
+	}
+
+	statement := strings.TrimSpace(request.Statement)
+	if statement == "" {
+		rc.RespondWithFieldError(errorz.NewFieldError("statement is required", "statement", request.Statement))
+		return
+	}
+
+	if len(statement) > maxAttestationStatementLength {
+		rc.RespondWithFieldError(errorz.NewFieldError("statement is too long", "statement", len(statement)))
+		return
+	}
+
+	ae.Managers.PostureResponse.SetAttestation(rc.Identity.Id, rc.ApiSession.Id, statement)
+
+	rc.RespondWithEmptyOk()
+}
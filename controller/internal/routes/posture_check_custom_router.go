@@ -0,0 +1,271 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package routes
+
+import (
+	"encoding/json"
+	"net/http"
+	"path"
+	"strings"
+
+	"ztna-core/edge-api/rest_model"
+	"ztna-core/ztna/controller/apierror"
+	"ztna-core/ztna/controller/db"
+	"ztna-core/ztna/controller/env"
+	"ztna-core/ztna/controller/internal/permissions"
+	"ztna-core/ztna/controller/model"
+	"ztna-core/ztna/controller/models"
+	"ztna-core/ztna/controller/response"
+
+	"github.com/openziti/foundation/v2/errorz"
+)
+
+// CustomPostureCheckRequest is the body of a create, update or patch of a posture check type which the generated
+// api doesn't know about
+type CustomPostureCheckRequest interface {
+	toModel(id string) (*model.PostureCheck, error)
+}
+
+// CustomPostureCheckRouter serves create, update and patch for a posture check type which isn't part of the
+// generated api, at <BasePath> and <BasePath>/<id>. Reads, lists and deletes go through the regular posture check
+// endpoints. The generated api comes from the edge-api spec, so a type served here moves to the generated handlers
+// once its create, update and patch models are added to the spec.
+type CustomPostureCheckRouter struct {
+	BasePath string
+	TypeId   string
+
+	// newRequest returns a request filled from the given check, or an empty request if the check is nil
+	newRequest func(check *model.PostureCheck) CustomPostureCheckRequest
+}
+
+func (r *CustomPostureCheckRouter) Register(ae *env.AppEnv) {
+	ae.AddManagementRoute(r.BasePath, func(ae *env.AppEnv, rc *response.RequestContext) {
+		if rc.Request.Method != http.MethodPost {
+			rc.RespondWithApiError(apierror.NewMethodNotAllowed())
+			return
+		}
+
+		if !ae.CheckPermissions(rc, permissions.CanCreate(db.EntityTypePostureChecks)) {
+			rc.RespondWithApiError(errorz.NewUnauthorized())
+			return
+		}
+
+		r.Create(ae, rc)
+	})
+
+	ae.AddManagementRoute(r.BasePath+"/", func(ae *env.AppEnv, rc *response.RequestContext) {
+		if rc.Request.Method != http.MethodPut && rc.Request.Method != http.MethodPatch {
+			rc.RespondWithApiError(apierror.NewMethodNotAllowed())
+			return
+		}
+
+		rc.SetEntityId(path.Base(rc.Request.URL.Path))
+
+		if !ae.CheckPermissions(rc, permissions.CanUpdate(db.EntityTypePostureChecks)) {
+			rc.RespondWithApiError(errorz.NewUnauthorized())
+			return
+		}
+
+		if rc.Request.Method == http.MethodPatch {
+			r.Patch(ae, rc)
+		} else {
+			r.Update(ae, rc)
+		}
+	})
+}
+
+func (r *CustomPostureCheckRouter) Create(ae *env.AppEnv, rc *response.RequestContext) {
+	request := r.newRequest(nil)
+	if err := json.Unmarshal(rc.Body, request); err != nil {
+		rc.RespondWithCouldNotParseBody(err)
+		return
+	}
+
+	Create(rc, rc, PostureCheckLinkFactory, func() (string, error) {
+		check, err := request.toModel("")
+		if err != nil {
+			return "", err
+		}
+		return MapCreate(ae.Managers.PostureCheck.Create, check, rc)
+	})
+}
+
+func (r *CustomPostureCheckRouter) Update(ae *env.AppEnv, rc *response.RequestContext) {
+	request := r.newRequest(nil)
+	if err := json.Unmarshal(rc.Body, request); err != nil {
+		rc.RespondWithCouldNotParseBody(err)
+		return
+	}
+
+	Update(rc, func(id string) error {
+		if _, err := r.read(ae, id); err != nil {
+			return err
+		}
+
+		check, err := request.toModel(id)
+		if err != nil {
+			return err
+		}
+		return ae.Managers.PostureCheck.Update(check, nil, rc.NewChangeContext())
+	})
+}
+
+// Patch applies the given fields over the current check and stores the result
+func (r *CustomPostureCheckRouter) Patch(ae *env.AppEnv, rc *response.RequestContext) {
+	Update(rc, func(id string) error {
+		current, err := r.read(ae, id)
+		if err != nil {
+			return err
+		}
+
+		request := r.newRequest(current)
+		if err = json.Unmarshal(rc.Body, request); err != nil {
+			return apierror.NewCouldNotParseBody(err)
+		}
+
+		check, err := request.toModel(id)
+		if err != nil {
+			return err
+		}
+		return ae.Managers.PostureCheck.Update(check, nil, rc.NewChangeContext())
+	})
+}
+
+func (r *CustomPostureCheckRouter) read(ae *env.AppEnv, id string) (*model.PostureCheck, error) {
+	check, err := ae.Managers.PostureCheck.Read(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if check.TypeId != r.TypeId {
+		return nil, errorz.NewFieldError("posture check is not of type "+r.TypeId, "typeId", check.TypeId)
+	}
+
+	return check, nil
+}
+
+// PostureCheckRequestBase holds the fields common to all custom posture check requests
+type PostureCheckRequestBase struct {
+	Name           string                 `json:"name"`
+	RoleAttributes []string               `json:"roleAttributes"`
+	Tags           map[string]interface{} `json:"tags"`
+}
+
+func (self *PostureCheckRequestBase) fill(check *model.PostureCheck) {
+	self.Name = check.Name
+	self.RoleAttributes = check.RoleAttributes
+	self.Tags = check.Tags
+}
+
+func (self *PostureCheckRequestBase) toModel(id string, subType model.PostureCheckSubType) (*model.PostureCheck, error) {
+	if strings.TrimSpace(self.Name) == "" {
+		return nil, errorz.NewFieldError("name is required", "name", self.Name)
+	}
+
+	return &model.PostureCheck{
+		BaseEntity: models.BaseEntity{
+			Id:   id,
+			Tags: self.Tags,
+		},
+		Name:           self.Name,
+		TypeId:         subType.TypeId(),
+		Version:        1,
+		RoleAttributes: stringsOrEmpty(self.RoleAttributes),
+		SubType:        subType,
+	}, nil
+}
+
+// CustomPostureCheckDetail renders a posture check type which isn't part of the generated api. The generated detail
+// types are the only implementations of rest_model.PostureCheckDetail, so one is embedded to hold the base posture
+// check fields and satisfy the interface. Only those base fields are rendered from it, along with the type specific
+// values.
+type CustomPostureCheckDetail struct {
+	rest_model.PostureCheckDomainDetail
+	typeId string
+	values map[string]interface{}
+}
+
+func NewCustomPostureCheckDetail(i *model.PostureCheck, values map[string]interface{}) *CustomPostureCheckDetail {
+	ret := &CustomPostureCheckDetail{
+		typeId: i.TypeId,
+		values: values,
+	}
+	setBaseEntityDetailsOnPostureCheck(ret, i)
+	return ret
+}
+
+func (self *CustomPostureCheckDetail) MarshalJSON() ([]byte, error) {
+	result := map[string]interface{}{
+		"_links":         self.Links(),
+		"createdAt":      self.CreatedAt(),
+		"id":             self.ID(),
+		"tags":           self.Tags(),
+		"updatedAt":      self.UpdatedAt(),
+		"name":           self.Name(),
+		"roleAttributes": self.RoleAttributes(),
+		"typeId":         self.typeId,
+		"version":        self.Version(),
+	}
+
+	for k, v := range self.values {
+		result[k] = v
+	}
+
+	return json.Marshal(result)
+}
+
+// CustomPostureCheckFailure renders a failed posture check of a type which isn't part of the generated api, in the
+// same manner as CustomPostureCheckDetail
+type CustomPostureCheckFailure struct {
+	rest_model.PostureCheckFailureDomain
+	typeId   string
+	actual   interface{}
+	expected interface{}
+}
+
+func NewCustomPostureCheckFailure(failure *model.PostureCheckFailure) *CustomPostureCheckFailure {
+	ret := &CustomPostureCheckFailure{
+		typeId: failure.PostureCheckType,
+	}
+	ret.SetPostureCheckID(&failure.PostureCheckId)
+	ret.SetPostureCheckType(failure.PostureCheckType)
+	ret.SetPostureCheckName(&failure.PostureCheckName)
+
+	if failure.PostureCheckFailureValues != nil {
+		ret.actual = failure.Actual()
+		ret.expected = failure.Expected()
+	}
+
+	return ret
+}
+
+func (self *CustomPostureCheckFailure) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		"postureCheckId":   self.PostureCheckID(),
+		"postureCheckName": self.PostureCheckName(),
+		"postureCheckType": self.typeId,
+		"actualValue":      self.actual,
+		"expectedValue":    self.expected,
+	})
+}
+
+func stringsOrEmpty(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
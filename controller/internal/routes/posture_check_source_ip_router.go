@@ -0,0 +1,81 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package routes
+
+import (
+	"ztna-core/ztna/common/geoip"
+	"ztna-core/ztna/controller/env"
+	"ztna-core/ztna/controller/model"
+)
+
+// PostureCheckSourceIpPath serves create and update of SOURCE_IP posture checks
+const PostureCheckSourceIpPath = "/" + EntityNamePostureCheck + "/source-ip"
+
+func init() {
+	env.AddRouter(&CustomPostureCheckRouter{
+		BasePath: PostureCheckSourceIpPath,
+		TypeId:   model.PostureCheckTypeSourceIp,
+		newRequest: func(check *model.PostureCheck) CustomPostureCheckRequest {
+			request := &PostureCheckSourceIpRequest{}
+			if check != nil {
+				request.fill(check)
+				if subType, ok := check.SubType.(*model.PostureCheckSourceIp); ok {
+					request.AllowedCidrs = subType.AllowedCidrs
+					request.DeniedCidrs = subType.DeniedCidrs
+					request.AllowedCountries = subType.AllowedCountries
+					request.DeniedCountries = subType.DeniedCountries
+				}
+			}
+			return request
+		},
+	})
+}
+
+// PostureCheckSourceIpRequest is the body of a SOURCE_IP posture check create, update or patch
+type PostureCheckSourceIpRequest struct {
+	PostureCheckRequestBase
+	AllowedCidrs     []string `json:"allowedCidrs"`
+	DeniedCidrs      []string `json:"deniedCidrs"`
+	AllowedCountries []string `json:"allowedCountries"`
+	DeniedCountries  []string `json:"deniedCountries"`
+}
+
+func (self *PostureCheckSourceIpRequest) toModel(id string) (*model.PostureCheck, error) {
+	subType := &model.PostureCheckSourceIp{
+		Rules: geoip.Rules{
+			AllowedCidrs:     stringsOrEmpty(self.AllowedCidrs),
+			DeniedCidrs:      stringsOrEmpty(self.DeniedCidrs),
+			AllowedCountries: stringsOrEmpty(self.AllowedCountries),
+			DeniedCountries:  stringsOrEmpty(self.DeniedCountries),
+		},
+	}
+
+	if err := subType.Validate(); err != nil {
+		return nil, err
+	}
+
+	return self.PostureCheckRequestBase.toModel(id, subType)
+}
+
+func MapPostureCheckSourceIpToRestModel(i *model.PostureCheck, subType *model.PostureCheckSourceIp) *CustomPostureCheckDetail {
+	return NewCustomPostureCheckDetail(i, map[string]interface{}{
+		"allowedCidrs":     stringsOrEmpty(subType.AllowedCidrs),
+		"deniedCidrs":      stringsOrEmpty(subType.DeniedCidrs),
+		"allowedCountries": stringsOrEmpty(subType.AllowedCountries),
+		"deniedCountries":  stringsOrEmpty(subType.DeniedCountries),
+	})
+}
@@ -375,6 +375,8 @@ func MapPostureDataFailedSessionRequestToRestModel(modelFailedSessionRequests []
 					checks = append(checks, MapPostureCheckFailureOsToRestModel(pdCheck))
 				case string(rest_model.PostureCheckTypeMFA):
 					checks = append(checks, MapPostureCheckFailureMfaToRestModel(pdCheck))
+				case model.PostureCheckTypeSourceIp, model.PostureCheckTypeAttestation:
+					checks = append(checks, NewCustomPostureCheckFailure(pdCheck))
 				}
 				policyFailure.SetChecks(checks)
 			}
@@ -17,12 +17,19 @@
 package routes
 
 import (
+	"encoding/json"
+	"time"
+
 	"ztna-core/edge-api/rest_model"
+	"ztna-core/ztna/common/schedule"
+	"ztna-core/ztna/controller/db"
 	"ztna-core/ztna/controller/env"
 	"ztna-core/ztna/controller/model"
 	"ztna-core/ztna/controller/models"
 	"ztna-core/ztna/controller/response"
 
+	"github.com/go-openapi/swag"
+	"github.com/openziti/foundation/v2/errorz"
 	"github.com/openziti/foundation/v2/stringz"
 )
 
@@ -49,6 +56,87 @@ func (factory *ServicePolicyLinkFactoryImpl) Links(entity models.Entity) rest_mo
 	return links
 }
 
+// ServicePolicyScheduleWindow is a time-of-day window, with start and end given as HH:MM
+type ServicePolicyScheduleWindow struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// ServicePolicySchedule is the REST representation of a service policy schedule. It is not part of the published
+// edge-api models, so it is read from and spliced into the raw JSON.
+type ServicePolicySchedule struct {
+	Timezone  string                        `json:"timezone,omitempty"`
+	Days      []string                      `json:"days,omitempty"`
+	Windows   []ServicePolicyScheduleWindow `json:"windows,omitempty"`
+	NotBefore *time.Time                    `json:"notBefore,omitempty"`
+	NotAfter  *time.Time                    `json:"notAfter,omitempty"`
+}
+
+func (self *ServicePolicySchedule) toModel() (*schedule.Schedule, error) {
+	var windows []string
+	for _, window := range self.Windows {
+		windows = append(windows, window.Start+"-"+window.End)
+	}
+	return schedule.Parse(self.Timezone, self.Days, windows, self.NotBefore, self.NotAfter)
+}
+
+func MapScheduleToRestModel(s *schedule.Schedule) *ServicePolicySchedule {
+	if s == nil {
+		return nil
+	}
+
+	result := &ServicePolicySchedule{
+		Timezone:  s.Timezone,
+		Days:      s.DayNames(),
+		NotBefore: s.NotBefore,
+		NotAfter:  s.NotAfter,
+	}
+
+	for _, window := range s.Windows {
+		windowStr := window.String()
+		result.Windows = append(result.Windows, ServicePolicyScheduleWindow{
+			Start: windowStr[:5],
+			End:   windowStr[6:],
+		})
+	}
+
+	return result
+}
+
+// GetServicePolicySchedule reads the optional schedule from a raw service policy request body. The returned bool is
+// true if the body contained a schedule property, including an explicit null used to clear it.
+func GetServicePolicySchedule(body []byte) (*schedule.Schedule, bool, error) {
+	if len(body) == 0 {
+		return nil, false, nil
+	}
+
+	fieldMap := map[string]json.RawMessage{}
+	if err := json.Unmarshal(body, &fieldMap); err != nil {
+		return nil, false, err
+	}
+
+	raw, found := fieldMap[db.FieldServicePolicySchedule]
+	if !found {
+		return nil, false, nil
+	}
+
+	if string(raw) == "null" {
+		return nil, true, nil
+	}
+
+	restSchedule := &ServicePolicySchedule{}
+	if err := json.Unmarshal(raw, restSchedule); err != nil {
+		return nil, true, errorz.NewFieldError(err.Error(), db.FieldServicePolicySchedule, string(raw))
+	}
+
+	result, err := restSchedule.toModel()
+	if err != nil {
+		return nil, true, errorz.NewFieldError(err.Error(), db.FieldServicePolicySchedule, string(raw))
+	}
+
+	return result, true, nil
+}
+
 func MapCreateServicePolicyToModel(policy *rest_model.ServicePolicyCreate) *model.ServicePolicy {
 	semantic := ""
 	if policy.Semantic != nil {
@@ -109,8 +197,39 @@ func MapPatchServicePolicyToModel(id string, policy *rest_model.ServicePolicyPat
 	return ret
 }
 
+// ServicePolicyDetail adds the schedule to the generated service policy detail
+type ServicePolicyDetail struct {
+	*rest_model.ServicePolicyDetail
+	Schedule *ServicePolicySchedule
+}
+
+func (self *ServicePolicyDetail) MarshalJSON() ([]byte, error) {
+	base, err := json.Marshal(self.ServicePolicyDetail)
+	if err != nil {
+		return nil, err
+	}
+
+	if self.Schedule == nil {
+		return base, nil
+	}
+
+	scheduleJson, err := json.Marshal(struct {
+		Schedule *ServicePolicySchedule `json:"schedule"`
+	}{
+		Schedule: self.Schedule,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return swag.ConcatJSON(base, scheduleJson), nil
+}
+
 func MapServicePolicyToRestEntity(ae *env.AppEnv, _ *response.RequestContext, policy *model.ServicePolicy) (interface{}, error) {
-	return MapServicePolicyToRestModel(ae, policy), nil
+	return &ServicePolicyDetail{
+		ServicePolicyDetail: MapServicePolicyToRestModel(ae, policy),
+		Schedule:            MapScheduleToRestModel(policy.Schedule),
+	}, nil
 }
 
 func MapServicePolicyToRestModel(ae *env.AppEnv, policy *model.ServicePolicy) *rest_model.ServicePolicyDetail {
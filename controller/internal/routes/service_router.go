@@ -82,53 +82,53 @@ func (r *ServiceRouter) Register(ae *env.AppEnv) {
 
 	//Management
 	ae.ManagementApi.ServiceDeleteServiceHandler = managementService.DeleteServiceHandlerFunc(func(params managementService.DeleteServiceParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(r.Delete, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+		return ae.IsAllowed(r.Delete, params.HTTPRequest, params.ID, "", permissions.CanDelete(db.EntityTypeServices))
 	})
 
 	ae.ManagementApi.ServiceDetailServiceHandler = managementService.DetailServiceHandlerFunc(func(params managementService.DetailServiceParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(r.Detail, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+		return ae.IsAllowed(r.DetailManagement, params.HTTPRequest, params.ID, "", permissions.CanRead(db.EntityTypeServices))
 	})
 
 	ae.ManagementApi.ServiceListServicesHandler = managementService.ListServicesHandlerFunc(func(params managementService.ListServicesParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(r.ListManagementServices, params.HTTPRequest, "", "", permissions.IsAdmin())
+		return ae.IsAllowed(r.ListManagementServices, params.HTTPRequest, "", "", permissions.CanRead(db.EntityTypeServices))
 	})
 
 	ae.ManagementApi.ServiceUpdateServiceHandler = managementService.UpdateServiceHandlerFunc(func(params managementService.UpdateServiceParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.Update(ae, rc, params) }, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.Update(ae, rc, params) }, params.HTTPRequest, params.ID, "", permissions.CanUpdate(db.EntityTypeServices))
 	})
 
 	ae.ManagementApi.ServiceCreateServiceHandler = managementService.CreateServiceHandlerFunc(func(params managementService.CreateServiceParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.Create(ae, rc, params) }, params.HTTPRequest, "", "", permissions.IsAdmin())
+		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.Create(ae, rc, params) }, params.HTTPRequest, "", "", permissions.CanCreate(db.EntityTypeServices))
 	})
 
 	ae.ManagementApi.ServicePatchServiceHandler = managementService.PatchServiceHandlerFunc(func(params managementService.PatchServiceParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.Patch(ae, rc, params) }, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.Patch(ae, rc, params) }, params.HTTPRequest, params.ID, "", permissions.CanUpdate(db.EntityTypeServices))
 	})
 
 	ae.ManagementApi.ServiceListServiceServiceEdgeRouterPoliciesHandler = managementService.ListServiceServiceEdgeRouterPoliciesHandlerFunc(func(params managementService.ListServiceServiceEdgeRouterPoliciesParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(r.listServiceEdgeRouterPolicies, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+		return ae.IsAllowed(r.listServiceEdgeRouterPolicies, params.HTTPRequest, params.ID, "", permissions.CanRead(db.EntityTypeServices))
 	})
 
 	ae.ManagementApi.ServiceListServiceEdgeRoutersHandler = managementService.ListServiceEdgeRoutersHandlerFunc(func(params managementService.ListServiceEdgeRoutersParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(r.listEdgeRouters, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+		return ae.IsAllowed(r.listEdgeRouters, params.HTTPRequest, params.ID, "", permissions.CanRead(db.EntityTypeServices))
 	})
 
 	ae.ManagementApi.ServiceListServiceServicePoliciesHandler = managementService.ListServiceServicePoliciesHandlerFunc(func(params managementService.ListServiceServicePoliciesParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(r.listServicePolicies, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+		return ae.IsAllowed(r.listServicePolicies, params.HTTPRequest, params.ID, "", permissions.CanRead(db.EntityTypeServices))
 	})
 
 	ae.ManagementApi.ServiceListServiceIdentitiesHandler = managementService.ListServiceIdentitiesHandlerFunc(func(params managementService.ListServiceIdentitiesParams, _ interface{}) middleware.Responder {
 		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) {
 			r.listIdentities(ae, rc, params)
-		}, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+		}, params.HTTPRequest, params.ID, "", permissions.CanRead(db.EntityTypeServices))
 	})
 
 	ae.ManagementApi.ServiceListServiceConfigHandler = managementService.ListServiceConfigHandlerFunc(func(params managementService.ListServiceConfigParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(r.listConfigs, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+		return ae.IsAllowed(r.listConfigs, params.HTTPRequest, params.ID, "", permissions.CanRead(db.EntityTypeServices))
 	})
 
 	ae.ManagementApi.ServiceListServiceTerminatorsHandler = managementService.ListServiceTerminatorsHandlerFunc(func(params managementService.ListServiceTerminatorsParams, i interface{}) middleware.Responder {
-		return ae.IsAllowed(r.listManagementTerminators, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+		return ae.IsAllowed(r.listManagementTerminators, params.HTTPRequest, params.ID, "", permissions.CanRead(db.EntityTypeServices))
 	})
 }
 
@@ -136,7 +136,8 @@ func (r *ServiceRouter) ListManagementServices(ae *env.AppEnv, rc *response.Requ
 	//always admin
 	List(rc, func(rc *response.RequestContext, queryOptions *PublicQueryOptions) (*QueryResult, error) {
 		identity := rc.Identity
-		if asId := rc.Request.URL.Query().Get("asIdentity"); asId != "" {
+		asId := rc.Request.URL.Query().Get("asIdentity")
+		if asId != "" {
 			identity, _ = ae.Managers.Identity.Read(asId)
 			if identity == nil {
 				identity, _ = ae.Managers.Identity.ReadByName(asId)
@@ -158,6 +159,10 @@ func (r *ServiceRouter) ListManagementServices(ae *env.AppEnv, rc *response.Requ
 			return nil, err
 		}
 
+		if err = permissions.ApplyReadScope(rc, ae.Managers.EdgeService.GetStore(), query); err != nil {
+			return nil, err
+		}
+
 		roleFilters := rc.Request.URL.Query()["roleFilter"]
 		roleSemantic := rc.Request.URL.Query().Get("roleSemantic")
 
@@ -182,7 +187,13 @@ func (r *ServiceRouter) ListManagementServices(ae *env.AppEnv, rc *response.Requ
 			}
 			qmd = &result.QueryMetaData
 		} else {
-			result, err := ae.Managers.EdgeService.PublicQueryForIdentity(identity, configTypes, query)
+			var result *model.ServiceListResult
+			if asId == "" && !identity.IsAdmin {
+				// identities managing services through admin role grants see all services, not just those they can use
+				result, err = ae.Managers.EdgeService.QueryAsAdmin(identity.Id, configTypes, query)
+			} else {
+				result, err = ae.Managers.EdgeService.PublicQueryForIdentity(identity, configTypes, query)
+			}
 			if err != nil {
 				pfxlog.Logger().Errorf("error executing list query: %+v", err)
 				return nil, err
@@ -242,6 +253,16 @@ func (r *ServiceRouter) Detail(ae *env.AppEnv, rc *response.RequestContext) {
 	})
 }
 
+func (r *ServiceRouter) DetailManagement(ae *env.AppEnv, rc *response.RequestContext) {
+	Detail(rc, func(rc *response.RequestContext, id string) (interface{}, error) {
+		svc, err := ae.Managers.EdgeService.ReadForAdmin(id, rc.ApiSession.IdentityId, rc.ApiSession.ConfigTypes)
+		if err != nil {
+			return nil, err
+		}
+		return MapServiceToRestEntity(ae, rc, svc)
+	})
+}
+
 func (r *ServiceRouter) Create(ae *env.AppEnv, rc *response.RequestContext, params managementService.CreateServiceParams) {
 	Create(rc, rc, ServiceLinkFactory, func() (string, error) {
 		return MapCreate(ae.Managers.EdgeService.Create, MapCreateServiceToModel(params.Service), rc)
@@ -126,6 +126,10 @@ func (r *SessionRouter) Delete(ae *env.AppEnv, rc *response.RequestContext) {
 
 func (r *SessionRouter) Create(ae *env.AppEnv, rc *response.RequestContext, params session.CreateSessionParams) {
 	start := time.Now()
+
+	// source ip posture checks evaluate the address the session is requested from
+	ae.GetManagers().PostureResponse.SetSourceIp(rc.ApiSession.IdentityId, rc.ApiSession.Id, rc.Request.RemoteAddr)
+
 	if rc.Claims != nil {
 		request := MapCreateSessionToModel(rc.Claims.Subject, rc.Claims.ApiSessionId, params.Session)
 		token, err := ae.Managers.Session.CreateJwt(request, rc.NewChangeContext())
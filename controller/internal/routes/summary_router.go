@@ -26,6 +26,10 @@ import (
 	"github.com/go-openapi/runtime/middleware"
 )
 
+// EntityNameSummary is the entity type used by grants for the entity count summary. There is no summary entity, so
+// grants for it can't be scoped
+const EntityNameSummary = "summary"
+
 func init() {
 	r := NewSummaryRouter()
 	env.AddRouter(r)
@@ -43,7 +47,7 @@ func NewSummaryRouter() *SummaryRouter {
 
 func (r *SummaryRouter) Register(ae *env.AppEnv) {
 	ae.ManagementApi.InformationalListSummaryHandler = informational.ListSummaryHandlerFunc(func(params informational.ListSummaryParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(r.List, params.HTTPRequest, "", "", permissions.IsAdmin())
+		return ae.IsAllowed(r.List, params.HTTPRequest, "", "", permissions.CanRead(EntityNameSummary))
 	})
 
 }
@@ -0,0 +1,148 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/storage/boltz"
+	"go.etcd.io/bbolt"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"ztna-core/ztna/common/pb/cmd_pb"
+	"ztna-core/ztna/common/pb/edge_cmd_pb"
+	"ztna-core/ztna/controller/change"
+	"ztna-core/ztna/controller/db"
+)
+
+const auditPruneBatchSize = 1000
+
+// AuditRecordPruner removes audit records older than the configured max age. Only the leader looks for expired
+// records. Removal is dispatched as a command carrying the cutoff, so every node removes the same records
+type AuditRecordPruner struct {
+	env      Env
+	maxAge   time.Duration
+	interval time.Duration
+}
+
+func NewAuditRecordPruner(env Env, maxAge, interval time.Duration) *AuditRecordPruner {
+	return &AuditRecordPruner{
+		env:      env,
+		maxAge:   maxAge,
+		interval: interval,
+	}
+}
+
+// Run periodically prunes expired records, until the controller shuts down
+func (self *AuditRecordPruner) Run() {
+	if self.maxAge == 0 {
+		return
+	}
+
+	ticker := time.NewTicker(self.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if !self.env.GetManagers().Dispatcher.IsLeader() {
+				continue
+			}
+			if err := self.Prune(time.Now().Add(-self.maxAge).UTC()); err != nil {
+				pfxlog.Logger().WithError(err).Error("unable to prune audit records")
+			}
+		case <-self.env.GetCloseNotifyChannel():
+			return
+		}
+	}
+}
+
+// Prune dispatches batches of deletes until no records older than the cutoff remain
+func (self *AuditRecordPruner) Prune(cutoff time.Time) error {
+	for {
+		var count int64
+		err := self.env.GetDb().View(func(tx *bbolt.Tx) error {
+			var err error
+			_, count, err = self.env.GetStores().AuditRecord.QueryIds(tx, pruneAuditRecordsQuery(cutoff, 1))
+			return err
+		})
+
+		if err != nil || count == 0 {
+			return err
+		}
+
+		cmd := &PruneAuditRecordsCmd{
+			env:    self.env,
+			cutoff: cutoff,
+			limit:  auditPruneBatchSize,
+			ctx:    change.New().SetSourceType("audit.prune").SetChangeAuthorType(change.AuthorTypeController),
+		}
+
+		if err = self.env.GetManagers().Dispatcher.Dispatch(cmd); err != nil {
+			return err
+		}
+	}
+}
+
+func pruneAuditRecordsQuery(cutoff time.Time, limit int) string {
+	return fmt.Sprintf(`%s < datetime(%s) limit %d`, db.FieldAuditRecordTimestamp, cutoff.UTC().Format(time.RFC3339), limit)
+}
+
+// PruneAuditRecordsCmd removes up to limit audit records older than the cutoff
+type PruneAuditRecordsCmd struct {
+	env    Env
+	cutoff time.Time
+	limit  int
+	ctx    *change.Context
+}
+
+func (self *PruneAuditRecordsCmd) Apply(ctx boltz.MutateContext) error {
+	store := self.env.GetStores().AuditRecord
+	ids, _, err := store.QueryIds(ctx.Tx(), pruneAuditRecordsQuery(self.cutoff, self.limit))
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		if err = store.DeleteById(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (self *PruneAuditRecordsCmd) Encode() ([]byte, error) {
+	return cmd_pb.EncodeProtobuf(&edge_cmd_pb.PruneAuditRecordsCmd{
+		Cutoff: timestamppb.New(self.cutoff),
+		Limit:  int32(self.limit),
+		Ctx:    ContextToProtobuf(self.ctx),
+	})
+}
+
+func (self *PruneAuditRecordsCmd) Decode(env Env, msg *edge_cmd_pb.PruneAuditRecordsCmd) error {
+	self.env = env
+	self.cutoff = msg.Cutoff.AsTime()
+	self.limit = int(msg.Limit)
+	self.ctx = ProtobufToContext(msg.Ctx)
+	return nil
+}
+
+func (self *PruneAuditRecordsCmd) GetChangeContext() *change.Context {
+	return self.ctx
+}
@@ -25,6 +25,7 @@ import (
 	nfpem "github.com/openziti/foundation/v2/pem"
 	"ztna-core/ztna/common/cert"
 	"ztna-core/ztna/controller/apierror"
+	"ztna-core/ztna/controller/carevocation"
 	"ztna-core/ztna/controller/change"
 	"ztna-core/ztna/controller/db"
 	"ztna-core/ztna/controller/models"
@@ -46,15 +47,17 @@ type AuthModuleCert struct {
 	fingerprintGenerator cert.FingerprintGenerator
 	staticCaCerts        []*x509.Certificate
 	dynamicCaCache       cmap.ConcurrentMap[string, []*x509.Certificate]
+	revocationChecker    *carevocation.Checker
 }
 
-func NewAuthModuleCert(env Env, caChain []byte) *AuthModuleCert {
+func NewAuthModuleCert(env Env, caChain []byte, revocationChecker *carevocation.Checker) *AuthModuleCert {
 	return &AuthModuleCert{
 		env:                  env,
 		method:               db.MethodAuthenticatorCert,
 		fingerprintGenerator: cert.NewFingerprintGenerator(),
 		staticCaCerts:        nfpem.PemBytesToCertificates(caChain),
 		dynamicCaCache:       cmap.New[[]*x509.Certificate](),
+		revocationChecker:    revocationChecker,
 	}
 }
 
@@ -144,10 +147,18 @@ func (module *AuthModuleCert) Process(context AuthContext) (AuthResult, error) {
 		return nil, apierror.NewInvalidAuth()
 	}
 
-	targetCa := cas.getCaByChain(chains, module.env.GetFingerprintGenerator())
+	targetCa, targetChain := cas.getCaByChain(chains, module.env.GetFingerprintGenerator())
 
 	externalId := ""
 	if targetCa != nil {
+		if module.revocationChecker != nil {
+			ca := carevocation.CA{Id: targetCa.Id, Name: targetCa.Name}
+			if err = module.revocationChecker.Check(ca, targetChain); err != nil {
+				logger.WithField("caId", targetCa.Id).WithError(err).Error("client certificate failed revocation check")
+				return nil, apierror.NewInvalidAuth()
+			}
+		}
+
 		externalId, err = targetCa.GetExternalId(clientCert)
 		if err != nil {
 			logger.WithError(err).Error("encountered an error getting externalId from x509.Certificate")
@@ -411,18 +422,19 @@ type caPool struct {
 	cas   map[string]*Ca
 }
 
-func (c *caPool) getCaByChain(chains [][]*x509.Certificate, generator cert.FingerprintGenerator) *Ca {
+// getCaByChain returns the first 3rd party CA found in the chains, along with the chain it was found in
+func (c *caPool) getCaByChain(chains [][]*x509.Certificate, generator cert.FingerprintGenerator) (*Ca, []*x509.Certificate) {
 	for _, chain := range chains {
 		for _, curCert := range chain {
 			fingerprint := generator.FromCert(curCert)
 
 			if ca, ok := c.cas[fingerprint]; ok {
-				return ca
+				return ca, chain
 			}
 		}
 	}
 
-	return nil
+	return nil, nil
 }
 
 func getAuthPolicyByIdentityId(env Env, authMethod string, authenticatorId string, identityId string) (*AuthPolicy, *Identity, error) {
@@ -137,7 +137,23 @@ func (self *EdgeServiceManager) IsBindableByIdentity(id string, identityId strin
 	return result, err
 }
 
+// ReadForAdmin reads a service on behalf of an identity managing services. The service is returned even if
+// policies don't permit the identity to bind or dial it
+func (self *EdgeServiceManager) ReadForAdmin(id string, identityId string, configTypes map[string]struct{}) (*ServiceDetail, error) {
+	var service *ServiceDetail
+	err := self.GetDb().View(func(tx *bbolt.Tx) error {
+		var err error
+		service, err = self.readForIdentityInTx(tx, id, identityId, configTypes, true)
+		return err
+	})
+	return service, err
+}
+
 func (self *EdgeServiceManager) ReadForIdentityInTx(tx *bbolt.Tx, id string, identityId string, configTypes map[string]struct{}) (*ServiceDetail, error) {
+	return self.readForIdentityInTx(tx, id, identityId, configTypes, false)
+}
+
+func (self *EdgeServiceManager) readForIdentityInTx(tx *bbolt.Tx, id string, identityId string, configTypes map[string]struct{}, asAdmin bool) (*ServiceDetail, error) {
 	edgeServiceStore := self.env.GetStores().EdgeService
 	identity, err := self.GetEnv().GetManagers().Identity.readInTx(tx, identityId)
 	if err != nil {
@@ -146,7 +162,7 @@ func (self *EdgeServiceManager) ReadForIdentityInTx(tx *bbolt.Tx, id string, ide
 	isBindable := edgeServiceStore.IsBindableByIdentity(tx, id, identityId)
 	isDialable := edgeServiceStore.IsDialableByIdentity(tx, id, identityId)
 
-	if !isBindable && !isDialable && !identity.IsAdmin { // admin can view services even if policies don't permit bind/dial {
+	if !isBindable && !isDialable && !identity.IsAdmin && !asAdmin { // admin can view services even if policies don't permit bind/dial {
 		return nil, boltz.NewNotFoundError(self.GetStore().GetSingularEntityType(), "id", id)
 	}
 
@@ -183,6 +199,12 @@ func (self *EdgeServiceManager) PublicQueryForIdentity(sessionIdentity *Identity
 	return self.QueryForIdentity(sessionIdentity.Id, configTypes, query)
 }
 
+// QueryAsAdmin lists services on behalf of an identity managing services, including services which policies don't
+// permit the identity to bind or dial
+func (self *EdgeServiceManager) QueryAsAdmin(identityId string, configTypes map[string]struct{}, query ast.Query) (*ServiceListResult, error) {
+	return self.queryServices(query, identityId, configTypes, true)
+}
+
 func (self *EdgeServiceManager) QueryForIdentity(identityId string, configTypes map[string]struct{}, query ast.Query) (*ServiceListResult, error) {
 	return self.queryServices(query, identityId, configTypes, false)
 }
@@ -270,7 +292,7 @@ func (result *ServiceListResult) collect(tx *bbolt.Tx, ids []string, queryMetaDa
 
 	for _, key := range ids {
 		// service permissions for admin & non-admin identities will be set according to policies
-		service, err = result.manager.ReadForIdentityInTx(tx, key, result.identityId, result.configTypes)
+		service, err = result.manager.readForIdentityInTx(tx, key, result.identityId, result.configTypes, result.isAdmin)
 		if err != nil {
 			return err
 		}
@@ -329,6 +351,7 @@ type PolicyPostureChecks struct {
 	PostureChecks []*PostureCheck
 	PolicyType    db.PolicyType
 	PolicyName    string
+	Active        bool
 }
 
 func (self *EdgeServiceManager) GetPolicyPostureChecks(identityId, serviceId string) map[string]*PolicyPostureChecks {
@@ -342,6 +365,7 @@ func (self *EdgeServiceManager) GetPolicyPostureChecks(identityId, serviceId str
 
 	policyNameSymbol := self.env.GetStores().ServicePolicy.GetSymbol(db.FieldName)
 	policyTypeSymbol := self.env.GetStores().ServicePolicy.GetSymbol(db.FieldServicePolicyType)
+	now := time.Now()
 
 	_ = self.GetDb().View(func(tx *bbolt.Tx) error {
 		if !self.env.GetStores().PostureCheck.IterateIds(tx, ast.BoolNodeTrue).IsValid() {
@@ -369,6 +393,7 @@ func (self *EdgeServiceManager) GetPolicyPostureChecks(identityId, serviceId str
 				PostureChecks: []*PostureCheck{},
 				PolicyType:    policyType,
 				PolicyName:    *policyName,
+				Active:        self.isPolicyActiveInTx(tx, policyIdStr, now),
 			}
 
 			cursor := postureCheckLinks.IterateLinks(tx, policyIdBytes)
@@ -390,6 +415,66 @@ func (self *EdgeServiceManager) GetPolicyPostureChecks(identityId, serviceId str
 	return policyIdToChecks
 }
 
+// GetPoliciesBySchedule returns the ids of the policies of the given type which grant the identity access to the
+// service, split by whether they are currently within their schedule.
+func (self *EdgeServiceManager) GetPoliciesBySchedule(identityId, serviceId string, policyType db.PolicyType) (active []string, inactive []string) {
+	serviceLinks := self.env.GetStores().ServicePolicy.GetLinkCollection(db.EntityTypeServices)
+	policyTypeSymbol := self.env.GetStores().ServicePolicy.GetSymbol(db.FieldServicePolicyType)
+	now := time.Now()
+
+	_ = self.GetDb().View(func(tx *bbolt.Tx) error {
+		policyCursor := self.env.GetStores().Identity.GetRelatedEntitiesCursor(tx, identityId, db.EntityTypeServicePolicies, true)
+		for policyCursor.IsValid() {
+			policyId := policyCursor.Current()
+			policyCursor.Next()
+
+			if !serviceLinks.IsLinked(tx, policyId, []byte(serviceId)) {
+				continue
+			}
+
+			currentType := db.PolicyTypeDial
+			if fieldType, policyTypeValue := policyTypeSymbol.Eval(tx, policyId); fieldType == boltz.TypeInt32 {
+				currentType = db.GetPolicyTypeForId(*boltz.BytesToInt32(policyTypeValue))
+			}
+
+			if currentType != policyType {
+				continue
+			}
+
+			if self.isPolicyActiveInTx(tx, string(policyId), now) {
+				active = append(active, string(policyId))
+			} else {
+				inactive = append(inactive, string(policyId))
+			}
+		}
+		return nil
+	})
+
+	return active, inactive
+}
+
+func (self *EdgeServiceManager) isPolicyActiveInTx(tx *bbolt.Tx, policyId string, now time.Time) bool {
+	servicePolicyStore := self.env.GetStores().ServicePolicy
+	scheduled := boltz.FieldToBool(servicePolicyStore.GetSymbol(db.FieldServicePolicyScheduled).Eval(tx, []byte(policyId)))
+	if scheduled == nil || !*scheduled {
+		return true
+	}
+
+	policy, err := servicePolicyStore.LoadById(tx, policyId)
+	if err != nil {
+		pfxlog.Logger().WithField("policyId", policyId).WithError(err).Error("unable to load service policy to check schedule")
+		return false
+	}
+
+	policySchedule, err := policy.Schedule.Parse()
+	if err != nil {
+		pfxlog.Logger().WithField("policyId", policyId).WithError(err).Error("invalid service policy schedule")
+		return false
+	}
+
+	return policySchedule.IsActive(now)
+}
+
 type ServiceDetailLister struct {
 	manager *EdgeServiceManager
 }
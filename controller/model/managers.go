@@ -116,6 +116,7 @@ func (managers *Managers) Init(env Env) *Managers {
 	managers.Mfa = NewMfaManager(env)
 
 	RegisterCommand(env, &CreateEdgeTerminatorCmd{}, &edge_cmd_pb.CreateEdgeTerminatorCommand{})
+	RegisterCommand(env, &PruneAuditRecordsCmd{}, &edge_cmd_pb.PruneAuditRecordsCmd{})
 	managers.Command.registerGenericCommands()
 
 	return managers
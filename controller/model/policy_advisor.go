@@ -1,9 +1,12 @@
 package model
 
 import (
+	"github.com/openziti/foundation/v2/errorz"
 	"github.com/openziti/foundation/v2/stringz"
+	"github.com/openziti/storage/boltz"
 	"ztna-core/ztna/controller/db"
 	"go.etcd.io/bbolt"
+	"time"
 )
 
 func NewPolicyAdvisor(env Env) *PolicyAdvisor {
@@ -307,3 +310,130 @@ func (advisor *PolicyAdvisor) getServiceEdgeRouterPolicies(serviceId, edgeRouter
 
 	return result, nil
 }
+
+// Simulate computes how the effective access of identities to services would change if the given changes were made,
+// without making them. The current and proposed access are both computed from the policy graph, so only the changes
+// show up in the diff
+func (advisor *PolicyAdvisor) Simulate(changes *PolicySimulationChanges) (*db.AccessMatrixDiff, error) {
+	current, err := advisor.LoadPolicyGraph()
+	if err != nil {
+		return nil, err
+	}
+
+	proposed, err := current.Apply(changes)
+	if err != nil {
+		return nil, errorz.NewFieldError(err.Error(), "changes", nil)
+	}
+
+	at := time.Now()
+	if changes != nil && changes.At != nil {
+		at = *changes.At
+	}
+
+	return db.DiffAccessMatrix(current.AccessMatrix(at), proposed.AccessMatrix(at)), nil
+}
+
+// LoadPolicyGraph reads the current identities, services, edge routers, posture checks and policies
+func (advisor *PolicyAdvisor) LoadPolicyGraph() (*PolicyGraph, error) {
+	graph := &PolicyGraph{}
+	stores := advisor.env.GetStores()
+
+	err := advisor.env.GetDb().View(func(tx *bbolt.Tx) error {
+		var err error
+		graph.Identities, err = loadPolicyGraphItems(tx, stores.Identity, func(entity *db.Identity) *PolicyGraphEntity {
+			return &PolicyGraphEntity{Id: entity.Id, Name: entity.Name, RoleAttributes: entity.RoleAttributes}
+		})
+		if err != nil {
+			return err
+		}
+
+		graph.Services, err = loadPolicyGraphItems(tx, stores.EdgeService, func(entity *db.EdgeService) *PolicyGraphEntity {
+			return &PolicyGraphEntity{Id: entity.Id, Name: entity.Name, RoleAttributes: entity.RoleAttributes}
+		})
+		if err != nil {
+			return err
+		}
+
+		graph.EdgeRouters, err = loadPolicyGraphItems(tx, stores.EdgeRouter, func(entity *db.EdgeRouter) *PolicyGraphEntity {
+			return &PolicyGraphEntity{
+				Id:             entity.Id,
+				Name:           entity.Name,
+				RoleAttributes: entity.RoleAttributes,
+				IsOnline:       !entity.Disabled && advisor.env.IsEdgeRouterOnline(entity.Id),
+			}
+		})
+		if err != nil {
+			return err
+		}
+
+		graph.PostureChecks, err = loadPolicyGraphItems(tx, stores.PostureCheck, func(entity *db.PostureCheck) *PolicyGraphEntity {
+			return &PolicyGraphEntity{Id: entity.Id, Name: entity.Name, RoleAttributes: entity.RoleAttributes}
+		})
+		if err != nil {
+			return err
+		}
+
+		graph.ServicePolicies, err = loadPolicyGraphItems(tx, stores.ServicePolicy, func(entity *db.ServicePolicy) *PolicyGraphPolicy {
+			return &PolicyGraphPolicy{
+				Id:                entity.Id,
+				Name:              entity.Name,
+				Type:              string(entity.PolicyType),
+				Semantic:          entity.Semantic,
+				IdentityRoles:     entity.IdentityRoles,
+				ServiceRoles:      entity.ServiceRoles,
+				PostureCheckRoles: entity.PostureCheckRoles,
+				Schedule:          entity.Schedule,
+			}
+		})
+		if err != nil {
+			return err
+		}
+
+		graph.EdgeRouterPolicies, err = loadPolicyGraphItems(tx, stores.EdgeRouterPolicy, func(entity *db.EdgeRouterPolicy) *PolicyGraphPolicy {
+			return &PolicyGraphPolicy{
+				Id:              entity.Id,
+				Name:            entity.Name,
+				Semantic:        entity.Semantic,
+				IdentityRoles:   entity.IdentityRoles,
+				EdgeRouterRoles: entity.EdgeRouterRoles,
+			}
+		})
+		if err != nil {
+			return err
+		}
+
+		graph.ServiceEdgeRouterPolicies, err = loadPolicyGraphItems(tx, stores.ServiceEdgeRouterPolicy, func(entity *db.ServiceEdgeRouterPolicy) *PolicyGraphPolicy {
+			return &PolicyGraphPolicy{
+				Id:              entity.Id,
+				Name:            entity.Name,
+				Semantic:        entity.Semantic,
+				ServiceRoles:    entity.ServiceRoles,
+				EdgeRouterRoles: entity.EdgeRouterRoles,
+			}
+		})
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return graph, nil
+}
+
+func loadPolicyGraphItems[E boltz.Entity, T any](tx *bbolt.Tx, store boltz.EntityStore[E], convert func(E) T) ([]T, error) {
+	ids, _, err := store.QueryIds(tx, "true limit none")
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]T, 0, len(ids))
+	for _, id := range ids {
+		entity, err := store.LoadById(tx, id)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, convert(entity))
+	}
+	return result, nil
+}
@@ -0,0 +1,445 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"ztna-core/ztna/controller/db"
+)
+
+// SimulatedIdPrefix prefixes the ids given to entities created by a simulation
+const SimulatedIdPrefix = "simulated:"
+
+// PolicyGraphEntity is an identity, service, edge router or posture check, as seen by policies
+type PolicyGraphEntity struct {
+	Id             string   `json:"id,omitempty"`
+	Name           string   `json:"name"`
+	RoleAttributes []string `json:"roleAttributes"`
+	IsOnline       bool     `json:"-"`
+}
+
+// PolicyGraphPolicy is a service, edge router or service edge router policy. Type is only set for service policies.
+type PolicyGraphPolicy struct {
+	Id                string   `json:"id,omitempty"`
+	Name              string   `json:"name"`
+	Type              string   `json:"type,omitempty"`
+	Semantic          string   `json:"semantic,omitempty"`
+	IdentityRoles     []string `json:"identityRoles"`
+	ServiceRoles      []string `json:"serviceRoles"`
+	EdgeRouterRoles   []string `json:"edgeRouterRoles"`
+	PostureCheckRoles []string `json:"postureCheckRoles"`
+
+	Schedule *db.ServicePolicySchedule `json:"schedule,omitempty"`
+}
+
+// PolicyGraph holds the entities and policies which determine which identities may dial or bind which services, and
+// over which edge routers
+type PolicyGraph struct {
+	Identities                []*PolicyGraphEntity
+	Services                  []*PolicyGraphEntity
+	EdgeRouters               []*PolicyGraphEntity
+	PostureChecks             []*PolicyGraphEntity
+	ServicePolicies           []*PolicyGraphPolicy
+	EdgeRouterPolicies        []*PolicyGraphPolicy
+	ServiceEdgeRouterPolicies []*PolicyGraphPolicy
+}
+
+// PolicySimulationChanges are proposed changes to a policy graph. Entities and policies are matched by name, or by id
+// if given. Those which exist are updated, with unset fields left as they are, the others are created. Created edge
+// routers are assumed to be online. Access is evaluated at the given time, or now if not set, so that service policy
+// schedules are taken into account.
+type PolicySimulationChanges struct {
+	At                        *time.Time               `json:"at"`
+	Identities                []*PolicyGraphEntity     `json:"identities"`
+	Services                  []*PolicyGraphEntity     `json:"services"`
+	EdgeRouters               []*PolicyGraphEntity     `json:"edgeRouters"`
+	PostureChecks             []*PolicyGraphEntity     `json:"postureChecks"`
+	ServicePolicies           []*PolicyGraphPolicy     `json:"servicePolicies"`
+	EdgeRouterPolicies        []*PolicyGraphPolicy     `json:"edgeRouterPolicies"`
+	ServiceEdgeRouterPolicies []*PolicyGraphPolicy     `json:"serviceEdgeRouterPolicies"`
+	Delete                    *PolicySimulationDeletes `json:"delete"`
+}
+
+// PolicySimulationDeletes lists the names or ids of the entities and policies to delete
+type PolicySimulationDeletes struct {
+	Identities                []string `json:"identities"`
+	Services                  []string `json:"services"`
+	EdgeRouters               []string `json:"edgeRouters"`
+	PostureChecks             []string `json:"postureChecks"`
+	ServicePolicies           []string `json:"servicePolicies"`
+	EdgeRouterPolicies        []string `json:"edgeRouterPolicies"`
+	ServiceEdgeRouterPolicies []string `json:"serviceEdgeRouterPolicies"`
+}
+
+// Apply returns a copy of the graph with the changes made. The graph itself is left unchanged.
+func (self *PolicyGraph) Apply(changes *PolicySimulationChanges) (*PolicyGraph, error) {
+	result := &PolicyGraph{
+		Identities:                slices.Clone(self.Identities),
+		Services:                  slices.Clone(self.Services),
+		EdgeRouters:               slices.Clone(self.EdgeRouters),
+		PostureChecks:             slices.Clone(self.PostureChecks),
+		ServicePolicies:           slices.Clone(self.ServicePolicies),
+		EdgeRouterPolicies:        slices.Clone(self.EdgeRouterPolicies),
+		ServiceEdgeRouterPolicies: slices.Clone(self.ServiceEdgeRouterPolicies),
+	}
+
+	if changes == nil {
+		return result, nil
+	}
+
+	var err error
+	if deletes := changes.Delete; deletes != nil {
+		if result.Identities, err = deletePolicyGraphItems(result.Identities, deletes.Identities, db.EntityTypeIdentities); err != nil {
+			return nil, err
+		}
+		if result.Services, err = deletePolicyGraphItems(result.Services, deletes.Services, db.EntityTypeServices); err != nil {
+			return nil, err
+		}
+		if result.EdgeRouters, err = deletePolicyGraphItems(result.EdgeRouters, deletes.EdgeRouters, db.EntityTypeRouters); err != nil {
+			return nil, err
+		}
+		if result.PostureChecks, err = deletePolicyGraphItems(result.PostureChecks, deletes.PostureChecks, db.EntityTypePostureChecks); err != nil {
+			return nil, err
+		}
+		if result.ServicePolicies, err = deletePolicyGraphItems(result.ServicePolicies, deletes.ServicePolicies, db.EntityTypeServicePolicies); err != nil {
+			return nil, err
+		}
+		if result.EdgeRouterPolicies, err = deletePolicyGraphItems(result.EdgeRouterPolicies, deletes.EdgeRouterPolicies, db.EntityTypeEdgeRouterPolicies); err != nil {
+			return nil, err
+		}
+		if result.ServiceEdgeRouterPolicies, err = deletePolicyGraphItems(result.ServiceEdgeRouterPolicies, deletes.ServiceEdgeRouterPolicies, db.EntityTypeServiceEdgeRouterPolicies); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, change := range changes.Identities {
+		if result.Identities, err = applyPolicyGraphEntity(result.Identities, change, false); err != nil {
+			return nil, err
+		}
+	}
+	for _, change := range changes.Services {
+		if result.Services, err = applyPolicyGraphEntity(result.Services, change, false); err != nil {
+			return nil, err
+		}
+	}
+	for _, change := range changes.EdgeRouters {
+		if result.EdgeRouters, err = applyPolicyGraphEntity(result.EdgeRouters, change, true); err != nil {
+			return nil, err
+		}
+	}
+	for _, change := range changes.PostureChecks {
+		if result.PostureChecks, err = applyPolicyGraphEntity(result.PostureChecks, change, false); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, change := range changes.ServicePolicies {
+		if result.ServicePolicies, err = applyPolicyGraphPolicy(result.ServicePolicies, change); err != nil {
+			return nil, err
+		}
+		if err = result.validateRefs(change.Name, "identityRoles", change.IdentityRoles, result.Identities); err != nil {
+			return nil, err
+		}
+		if err = result.validateRefs(change.Name, "serviceRoles", change.ServiceRoles, result.Services); err != nil {
+			return nil, err
+		}
+		if err = result.validateRefs(change.Name, "postureCheckRoles", change.PostureCheckRoles, result.PostureChecks); err != nil {
+			return nil, err
+		}
+		if _, err = change.Schedule.Parse(); err != nil {
+			return nil, errors.Wrapf(err, "schedule of policy %s is invalid", change.Name)
+		}
+	}
+	for _, change := range changes.EdgeRouterPolicies {
+		if result.EdgeRouterPolicies, err = applyPolicyGraphPolicy(result.EdgeRouterPolicies, change); err != nil {
+			return nil, err
+		}
+		if err = result.validateRefs(change.Name, "identityRoles", change.IdentityRoles, result.Identities); err != nil {
+			return nil, err
+		}
+		if err = result.validateRefs(change.Name, "edgeRouterRoles", change.EdgeRouterRoles, result.EdgeRouters); err != nil {
+			return nil, err
+		}
+	}
+	for _, change := range changes.ServiceEdgeRouterPolicies {
+		if result.ServiceEdgeRouterPolicies, err = applyPolicyGraphPolicy(result.ServiceEdgeRouterPolicies, change); err != nil {
+			return nil, err
+		}
+		if err = result.validateRefs(change.Name, "serviceRoles", change.ServiceRoles, result.Services); err != nil {
+			return nil, err
+		}
+		if err = result.validateRefs(change.Name, "edgeRouterRoles", change.EdgeRouterRoles, result.EdgeRouters); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, policy := range result.ServicePolicies {
+		if policy.Type != db.PolicyTypeDialName && policy.Type != db.PolicyTypeBindName {
+			return nil, errors.Errorf("service policy %s has invalid type '%s', expected %s or %s",
+				policy.Name, policy.Type, db.PolicyTypeDialName, db.PolicyTypeBindName)
+		}
+	}
+
+	return result, nil
+}
+
+// validateRefs checks that the roles of a changed policy are valid, and that any @ references resolve
+func (self *PolicyGraph) validateRefs(policyName, field string, roles []string, entities []*PolicyGraphEntity) error {
+	for _, role := range roles {
+		if ref, isRef := strings.CutPrefix(role, db.EntityPrefix); isRef {
+			if findPolicyGraphEntity(entities, ref) == nil {
+				return errors.Errorf("%s of policy %s refers to unknown entity '%s'", field, policyName, ref)
+			}
+		} else if !strings.HasPrefix(role, db.RolePrefix) {
+			return errors.Errorf("%s of policy %s has '%s', which is neither a role attribute (prefixed with %s) or an entity id or name (prefixed with %s)",
+				field, policyName, role, db.RolePrefix, db.EntityPrefix)
+		}
+	}
+	return nil
+}
+
+type policyGraphItem interface {
+	*PolicyGraphEntity | *PolicyGraphPolicy
+}
+
+func policyGraphItemIdAndName[T policyGraphItem](item T) (string, string) {
+	switch v := any(item).(type) {
+	case *PolicyGraphEntity:
+		return v.Id, v.Name
+	case *PolicyGraphPolicy:
+		return v.Id, v.Name
+	}
+	return "", ""
+}
+
+func findPolicyGraphItem[T policyGraphItem](items []T, idOrName string) int {
+	if idx := slices.IndexFunc(items, func(item T) bool {
+		id, _ := policyGraphItemIdAndName(item)
+		return id == idOrName
+	}); idx >= 0 {
+		return idx
+	}
+	return slices.IndexFunc(items, func(item T) bool {
+		_, name := policyGraphItemIdAndName(item)
+		return name == idOrName
+	})
+}
+
+func findPolicyGraphEntity(entities []*PolicyGraphEntity, idOrName string) *PolicyGraphEntity {
+	if idx := findPolicyGraphItem(entities, idOrName); idx >= 0 {
+		return entities[idx]
+	}
+	return nil
+}
+
+func deletePolicyGraphItems[T policyGraphItem](items []T, idsOrNames []string, entityType string) ([]T, error) {
+	for _, idOrName := range idsOrNames {
+		idx := findPolicyGraphItem(items, idOrName)
+		if idx < 0 {
+			return nil, errors.Errorf("unable to delete %s '%s', not found", entityType, idOrName)
+		}
+		items = slices.Delete(items, idx, idx+1)
+	}
+	return items, nil
+}
+
+func policyGraphChangeKey(id, name string) (string, error) {
+	if id != "" {
+		return id, nil
+	}
+	if name == "" {
+		return "", errors.New("changes must give the name or id of each entity and policy")
+	}
+	return name, nil
+}
+
+func applyPolicyGraphEntity(entities []*PolicyGraphEntity, change *PolicyGraphEntity, isRouter bool) ([]*PolicyGraphEntity, error) {
+	key, err := policyGraphChangeKey(change.Id, change.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if idx := findPolicyGraphItem(entities, key); idx >= 0 {
+		updated := *entities[idx]
+		if change.RoleAttributes != nil {
+			updated.RoleAttributes = change.RoleAttributes
+		}
+		entities[idx] = &updated
+		return entities, nil
+	}
+
+	if change.Name == "" {
+		return nil, errors.Errorf("no entity with id '%s' found to update", change.Id)
+	}
+
+	return append(entities, &PolicyGraphEntity{
+		Id:             SimulatedIdPrefix + change.Name,
+		Name:           change.Name,
+		RoleAttributes: change.RoleAttributes,
+		IsOnline:       isRouter,
+	}), nil
+}
+
+func applyPolicyGraphPolicy(policies []*PolicyGraphPolicy, change *PolicyGraphPolicy) ([]*PolicyGraphPolicy, error) {
+	key, err := policyGraphChangeKey(change.Id, change.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if idx := findPolicyGraphItem(policies, key); idx >= 0 {
+		updated := *policies[idx]
+		if change.Type != "" {
+			updated.Type = change.Type
+		}
+		if change.Semantic != "" {
+			updated.Semantic = change.Semantic
+		}
+		if change.IdentityRoles != nil {
+			updated.IdentityRoles = change.IdentityRoles
+		}
+		if change.ServiceRoles != nil {
+			updated.ServiceRoles = change.ServiceRoles
+		}
+		if change.EdgeRouterRoles != nil {
+			updated.EdgeRouterRoles = change.EdgeRouterRoles
+		}
+		if change.PostureCheckRoles != nil {
+			updated.PostureCheckRoles = change.PostureCheckRoles
+		}
+		if change.Schedule != nil {
+			updated.Schedule = change.Schedule
+		}
+		policies[idx] = &updated
+		return policies, nil
+	}
+
+	if change.Name == "" {
+		return nil, errors.Errorf("no policy with id '%s' found to update", change.Id)
+	}
+
+	created := *change
+	created.Id = SimulatedIdPrefix + change.Name
+	return append(policies, &created), nil
+}
+
+// matchRoles returns the entities selected by a policy's roles, following the same rules as policies in the store
+func matchRoles(roles []string, semantic string, entities []*PolicyGraphEntity) []*PolicyGraphEntity {
+	var attributes []string
+	refs := map[*PolicyGraphEntity]struct{}{}
+	for _, role := range roles {
+		if attribute, isAttribute := strings.CutPrefix(role, db.RolePrefix); isAttribute {
+			attributes = append(attributes, attribute)
+		} else if ref, isRef := strings.CutPrefix(role, db.EntityPrefix); isRef {
+			if entity := findPolicyGraphEntity(entities, ref); entity != nil {
+				refs[entity] = struct{}{}
+			}
+		}
+	}
+
+	anyOf := strings.EqualFold(semantic, db.SemanticAnyOf)
+	all := slices.Contains(attributes, "all")
+
+	var result []*PolicyGraphEntity
+	for _, entity := range entities {
+		_, isRef := refs[entity]
+		if isRef || all ||
+			(!anyOf && len(attributes) > 0 && containsAll(entity.RoleAttributes, attributes)) ||
+			(anyOf && slices.ContainsFunc(attributes, func(attribute string) bool {
+				return slices.Contains(entity.RoleAttributes, attribute)
+			})) {
+			result = append(result, entity)
+		}
+	}
+	return result
+}
+
+func containsAll(values, required []string) bool {
+	for _, v := range required {
+		if !slices.Contains(values, v) {
+			return false
+		}
+	}
+	return true
+}
+
+// AccessMatrix computes the effective access of every identity to every service at the given time, in the same form
+// as db.BuildAccessMatrix, so the two can be compared with db.DiffAccessMatrix. Service policies outside their
+// schedule at that time grant nothing.
+func (self *PolicyGraph) AccessMatrix(at time.Time) *db.AccessMatrix {
+	identityRouters := map[string]map[*PolicyGraphEntity]struct{}{}
+	for _, policy := range self.EdgeRouterPolicies {
+		routers := matchRoles(policy.EdgeRouterRoles, policy.Semantic, self.EdgeRouters)
+		for _, identity := range matchRoles(policy.IdentityRoles, policy.Semantic, self.Identities) {
+			addRouters(identityRouters, identity.Id, routers)
+		}
+	}
+
+	serviceRouters := map[string]map[*PolicyGraphEntity]struct{}{}
+	for _, policy := range self.ServiceEdgeRouterPolicies {
+		routers := matchRoles(policy.EdgeRouterRoles, policy.Semantic, self.EdgeRouters)
+		for _, service := range matchRoles(policy.ServiceRoles, policy.Semantic, self.Services) {
+			addRouters(serviceRouters, service.Id, routers)
+		}
+	}
+
+	builder := db.NewAccessMatrixBuilder()
+	for _, policy := range self.ServicePolicies {
+		if !policy.Schedule.IsActive(at) {
+			continue
+		}
+
+		var checks []string
+		for _, check := range matchRoles(policy.PostureCheckRoles, policy.Semantic, self.PostureChecks) {
+			checks = append(checks, check.Name)
+		}
+
+		services := matchRoles(policy.ServiceRoles, policy.Semantic, self.Services)
+		for _, identity := range matchRoles(policy.IdentityRoles, policy.Semantic, self.Identities) {
+			for _, service := range services {
+				builder.Grant(identity.Id, identity.Name, service.Id, service.Name, policy.Type, policy.Name, checks)
+			}
+		}
+	}
+
+	return builder.Build(func(identityId, serviceId string) ([]string, []string) {
+		var common, offline []string
+		for router := range identityRouters[identityId] {
+			if _, isCommon := serviceRouters[serviceId][router]; isCommon {
+				common = append(common, router.Name)
+				if !router.IsOnline {
+					offline = append(offline, router.Name)
+				}
+			}
+		}
+		return common, offline
+	})
+}
+
+func addRouters(m map[string]map[*PolicyGraphEntity]struct{}, id string, routers []*PolicyGraphEntity) {
+	set := m[id]
+	if set == nil {
+		set = map[*PolicyGraphEntity]struct{}{}
+		m[id] = set
+	}
+	for _, router := range routers {
+		set[router] = struct{}{}
+	}
+}
@@ -0,0 +1,192 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"ztna-core/ztna/controller/db"
+)
+
+func newTestPolicyGraph() *PolicyGraph {
+	return &PolicyGraph{
+		Identities: []*PolicyGraphEntity{
+			{Id: "i1", Name: "alice", RoleAttributes: []string{"eng", "ops"}},
+			{Id: "i2", Name: "bob", RoleAttributes: []string{"eng"}},
+			{Id: "i3", Name: "web-host", RoleAttributes: []string{"hosts"}},
+		},
+		Services: []*PolicyGraphEntity{
+			{Id: "s1", Name: "web", RoleAttributes: []string{"internal"}},
+			{Id: "s2", Name: "db", RoleAttributes: []string{"internal", "sensitive"}},
+		},
+		EdgeRouters: []*PolicyGraphEntity{
+			{Id: "r1", Name: "er1", RoleAttributes: []string{"public"}, IsOnline: true},
+			{Id: "r2", Name: "er2", RoleAttributes: []string{"public"}},
+		},
+		PostureChecks: []*PolicyGraphEntity{
+			{Id: "p1", Name: "disk", RoleAttributes: []string{"strict"}},
+		},
+		ServicePolicies: []*PolicyGraphPolicy{
+			{Id: "sp1", Name: "eng-dial", Type: db.PolicyTypeDialName, Semantic: db.SemanticAllOf,
+				IdentityRoles: []string{"#eng"}, ServiceRoles: []string{"@s1"}},
+			{Id: "sp2", Name: "ops-db", Type: db.PolicyTypeDialName, Semantic: db.SemanticAllOf,
+				IdentityRoles: []string{"#eng", "#ops"}, ServiceRoles: []string{"#sensitive"}, PostureCheckRoles: []string{"#strict"}},
+			{Id: "sp3", Name: "hosts-bind", Type: db.PolicyTypeBindName, Semantic: db.SemanticAnyOf,
+				IdentityRoles: []string{"#hosts"}, ServiceRoles: []string{"#internal"}},
+		},
+		EdgeRouterPolicies: []*PolicyGraphPolicy{
+			{Id: "erp1", Name: "all", Semantic: db.SemanticAllOf, IdentityRoles: []string{"#all"}, EdgeRouterRoles: []string{"#public"}},
+		},
+		ServiceEdgeRouterPolicies: []*PolicyGraphPolicy{
+			{Id: "serp1", Name: "all", Semantic: db.SemanticAllOf, ServiceRoles: []string{"#all"}, EdgeRouterRoles: []string{"@r2"}},
+		},
+	}
+}
+
+func findAccess(matrix *db.AccessMatrix, identity, service, policyType string) *db.AccessMatrixEntry {
+	for _, entry := range matrix.Entries {
+		if entry.IdentityName == identity && entry.ServiceName == service && entry.Type == policyType {
+			return entry
+		}
+	}
+	return nil
+}
+
+func TestPolicyGraphAccess(t *testing.T) {
+	req := require.New(t)
+
+	matrix := newTestPolicyGraph().AccessMatrix(time.Now())
+	req.Len(matrix.Entries, 5)
+
+	entry := findAccess(matrix, "alice", "db", db.PolicyTypeDialName)
+	req.NotNil(entry)
+	req.Nil(findAccess(matrix, "alice", "db", db.PolicyTypeBindName))
+	req.Equal([]string{"ops-db"}, entry.Policies)
+	req.Equal([][]string{{"disk"}}, entry.PostureChecks)
+	req.Equal([]string{"er2"}, entry.EdgeRouters)
+	req.Equal([]string{"er2"}, entry.OfflineEdgeRouters)
+	req.False(entry.IsReachable())
+
+	req.Nil(findAccess(matrix, "bob", "db", db.PolicyTypeDialName))
+
+	entry = findAccess(matrix, "web-host", "web", db.PolicyTypeBindName)
+	req.NotNil(entry)
+	req.Nil(entry.PostureChecks)
+}
+
+func TestPolicyGraphSimulate(t *testing.T) {
+	req := require.New(t)
+
+	current := newTestPolicyGraph()
+	proposed, err := current.Apply(&PolicySimulationChanges{
+		Identities: []*PolicyGraphEntity{
+			{Name: "bob", RoleAttributes: []string{"eng", "ops"}},
+			{Name: "carol", RoleAttributes: []string{"eng"}},
+		},
+		EdgeRouters: []*PolicyGraphEntity{
+			{Name: "er3", RoleAttributes: []string{"public"}},
+		},
+		ServiceEdgeRouterPolicies: []*PolicyGraphPolicy{
+			{Name: "all", EdgeRouterRoles: []string{"@r2", "@er3"}},
+		},
+		Delete: &PolicySimulationDeletes{
+			ServicePolicies: []string{"hosts-bind"},
+		},
+	})
+	req.NoError(err)
+
+	// the current graph is unchanged
+	req.Len(current.Identities, 3)
+	req.Len(current.ServicePolicies, 3)
+	req.Equal([]string{"eng"}, current.Identities[1].RoleAttributes)
+
+	now := time.Now()
+	result := db.DiffAccessMatrix(current.AccessMatrix(now), proposed.AccessMatrix(now))
+	req.Equal(2, result.Added)
+	req.Equal(2, result.Removed)
+	req.Equal(3, result.Changed)
+	req.Equal(4, result.IdentitiesAffected)
+	req.Equal(2, result.ServicesAffected)
+
+	var changes []string
+	for _, change := range result.Changes {
+		entry := change.After
+		if entry == nil {
+			entry = change.Before
+		}
+		changes = append(changes, change.Change+" "+entry.IdentityName+" "+entry.ServiceName+" "+entry.Type)
+	}
+	req.Equal([]string{
+		"changed alice db Dial",
+		"changed alice web Dial",
+		"added bob db Dial",
+		"changed bob web Dial",
+		"added carol web Dial",
+		"removed web-host db Bind",
+		"removed web-host web Bind",
+	}, changes)
+
+	change := result.Changes[1]
+	req.Equal([]string{"edgeRouters"}, change.Fields)
+	req.Equal([]string{"er2", "er3"}, change.After.EdgeRouters)
+	req.True(change.After.IsReachable())
+}
+
+func TestPolicyGraphSchedules(t *testing.T) {
+	req := require.New(t)
+
+	now := time.Now()
+	start := now.Add(time.Hour)
+
+	current := newTestPolicyGraph()
+	proposed, err := current.Apply(&PolicySimulationChanges{
+		ServicePolicies: []*PolicyGraphPolicy{
+			{Name: "eng-dial", Schedule: &db.ServicePolicySchedule{NotBefore: &start}},
+		},
+	})
+	req.NoError(err)
+
+	// the policy isn't in force yet, so its access is lost now, but not once it starts
+	result := db.DiffAccessMatrix(current.AccessMatrix(now), proposed.AccessMatrix(now))
+	req.Equal(2, result.Removed)
+	req.Equal(0, result.Added+result.Changed)
+
+	later := start.Add(time.Minute)
+	result = db.DiffAccessMatrix(current.AccessMatrix(later), proposed.AccessMatrix(later))
+	req.Empty(result.Changes)
+
+	_, err = current.Apply(&PolicySimulationChanges{
+		ServicePolicies: []*PolicyGraphPolicy{
+			{Name: "eng-dial", Schedule: &db.ServicePolicySchedule{Timezone: "Nowhere/Special"}},
+		},
+	})
+	req.ErrorContains(err, "schedule of policy eng-dial is invalid")
+}
+
+func TestPolicyGraphApplyValidation(t *testing.T) {
+	req := require.New(t)
+	graph := newTestPolicyGraph()
+
+	_, err := graph.Apply(&PolicySimulationChanges{
+		ServicePolicies: []*PolicyGraphPolicy{{Name: "new", Type: db.PolicyTypeDialName, IdentityRoles: []string{"@nobody"}}},
+	})
+	req.ErrorContains(err, "unknown entity 'nobody'")
+
+	_, err = graph.Apply(&PolicySimulationChanges{
+		ServicePolicies: []*PolicyGraphPolicy{{Name: "new", Type: "Connect"}},
+	})
+	req.ErrorContains(err, "invalid type")
+
+	_, err = graph.Apply(&PolicySimulationChanges{
+		Delete: &PolicySimulationDeletes{Services: []string{"missing"}},
+	})
+	req.ErrorContains(err, "not found")
+
+	// references may be by name, including to entities created by the same changes
+	proposed, err := graph.Apply(&PolicySimulationChanges{
+		Services:        []*PolicyGraphEntity{{Name: "api"}},
+		ServicePolicies: []*PolicyGraphPolicy{{Name: "api-dial", Type: db.PolicyTypeDialName, IdentityRoles: []string{"@bob"}, ServiceRoles: []string{"@api"}}},
+	})
+	req.NoError(err)
+	req.NotNil(findAccess(proposed.AccessMatrix(time.Now()), "bob", "api", db.PolicyTypeDialName))
+}
@@ -133,6 +133,16 @@ func (self *PostureCheckManager) IsUpdated(field string) bool {
 		strings.EqualFold(field, db.FieldPostureCheckProcessMultiPath) ||
 		strings.EqualFold(field, db.FieldPostureCheckProcessMultiSignerFingerprints) ||
 		strings.EqualFold(field, db.FieldPostureCheckProcessMultiProcesses) ||
+		strings.EqualFold(field, db.FieldPostureCheckSourceIpAllowedCidrs) ||
+		strings.EqualFold(field, db.FieldPostureCheckSourceIpDeniedCidrs) ||
+		strings.EqualFold(field, db.FieldPostureCheckSourceIpAllowedCountries) ||
+		strings.EqualFold(field, db.FieldPostureCheckSourceIpDeniedCountries) ||
+		strings.EqualFold(field, db.FieldPostureCheckAttestationPublicKeys) ||
+		strings.EqualFold(field, db.FieldPostureCheckAttestationMaxAgeSeconds) ||
+		strings.EqualFold(field, db.FieldPostureCheckAttestationRequireDiskEncryption) ||
+		strings.EqualFold(field, db.FieldPostureCheckAttestationRequireFirewall) ||
+		strings.EqualFold(field, db.FieldPostureCheckAttestationEdrAgent) ||
+		strings.EqualFold(field, db.FieldPostureCheckAttestationMinEdrVersion) ||
 		strings.EqualFold(field, db.FieldSemantic)
 }
 
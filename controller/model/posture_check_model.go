@@ -65,6 +65,8 @@ const (
 	PostureCheckTypeProcessMulti = "PROCESS_MULTI"
 	PostureCheckTypeMAC          = "MAC"
 	PostureCheckTypeMFA          = "MFA"
+	PostureCheckTypeSourceIp     = "SOURCE_IP"
+	PostureCheckTypeAttestation  = "ATTESTATION"
 )
 
 var postureCheckSubTypeMap = map[string]newPostureCheckSubType{
@@ -74,6 +76,8 @@ var postureCheckSubTypeMap = map[string]newPostureCheckSubType{
 	PostureCheckTypeProcessMulti: newPostureCheckProcessMulti,
 	PostureCheckTypeMAC:          newPostureCheckMacAddresses,
 	PostureCheckTypeMFA:          newPostureCheckMfa,
+	PostureCheckTypeSourceIp:     newPostureCheckSourceIp,
+	PostureCheckTypeAttestation:  newPostureCheckAttestation,
 }
 
 func newSubType(typeId string) PostureCheckSubType {
@@ -0,0 +1,210 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
+	"ztna-core/ztna/common/attestation"
+	"ztna-core/ztna/common/pb/edge_cmd_pb"
+	"ztna-core/ztna/controller/db"
+)
+
+var _ PostureCheckSubType = &PostureCheckAttestation{}
+
+// PostureCheckAttestation requires a statement about the device, signed by a key pinned in the check, such as one
+// issued by an endpoint management agent
+type PostureCheckAttestation struct {
+	attestation.Check
+}
+
+func (p *PostureCheckAttestation) TypeId() string {
+	return db.PostureCheckTypeAttestation
+}
+
+func (p *PostureCheckAttestation) fillProtobuf(msg *edge_cmd_pb.PostureCheck) {
+	msg.Subtype = &edge_cmd_pb.PostureCheck_Attestation_{
+		Attestation: &edge_cmd_pb.PostureCheck_Attestation{
+			PublicKeys:            p.PublicKeys,
+			MaxAgeSeconds:         p.MaxAgeSeconds,
+			RequireDiskEncryption: p.RequireDiskEncryption,
+			RequireFirewall:       p.RequireFirewall,
+			EdrAgent:              p.EdrAgent,
+			MinEdrVersion:         p.MinEdrVersion,
+		},
+	}
+}
+
+func (p *PostureCheckAttestation) fillFromProtobuf(msg *edge_cmd_pb.PostureCheck) error {
+	if subType, ok := msg.Subtype.(*edge_cmd_pb.PostureCheck_Attestation_); ok {
+		if check := subType.Attestation; check != nil {
+			p.PublicKeys = check.PublicKeys
+			p.MaxAgeSeconds = check.MaxAgeSeconds
+			p.RequireDiskEncryption = check.RequireDiskEncryption
+			p.RequireFirewall = check.RequireFirewall
+			p.EdrAgent = check.EdrAgent
+			p.MinEdrVersion = check.MinEdrVersion
+		}
+	} else {
+		return errors.Errorf("expected posture check sub type data of attestation, but got %T", msg.Subtype)
+	}
+	return nil
+}
+
+func (p *PostureCheckAttestation) LastUpdatedAt(apiSessionId string, pd *PostureData) *time.Time {
+	if response := p.getResponse(apiSessionId, pd); response != nil {
+		return &response.LastUpdatedAt
+	}
+	return nil
+}
+
+func (p *PostureCheckAttestation) GetTimeoutSeconds() int64 {
+	return int64(p.MaxAge().Seconds())
+}
+
+func (p *PostureCheckAttestation) GetTimeoutRemainingSeconds(apiSessionId string, pd *PostureData) int64 {
+	return p.getTimeoutRemainingAtSeconds(apiSessionId, pd, time.Now())
+}
+
+func (p *PostureCheckAttestation) getTimeoutRemainingAtSeconds(apiSessionId string, pd *PostureData, now time.Time) int64 {
+	response := p.getResponse(apiSessionId, pd)
+	if response == nil {
+		return 0
+	}
+
+	statement, err := p.Verify(response.Statement, response.IdentityId, now)
+	if err != nil {
+		return 0
+	}
+
+	return int64(p.Remaining(statement, now).Seconds())
+}
+
+func (p *PostureCheckAttestation) FailureValues(apiSessionId string, pd *PostureData) PostureCheckFailureValues {
+	ret := &PostureCheckFailureValuesAttestation{
+		ExpectedValue: PostureCheckAttestationValues{
+			Verified:        true,
+			DiskEncrypted:   p.RequireDiskEncryption,
+			FirewallEnabled: p.RequireFirewall,
+			EdrAgent:        p.EdrAgent,
+			EdrVersion:      p.MinEdrVersion,
+		},
+	}
+
+	response := p.getResponse(apiSessionId, pd)
+	if response == nil {
+		ret.ActualValue.Reason = "no attestation statement has been submitted"
+		return ret
+	}
+
+	statement, err := p.Verify(response.Statement, response.IdentityId, time.Now())
+	if statement != nil {
+		ret.ActualValue.DiskEncrypted = statement.DiskEncrypted
+		ret.ActualValue.FirewallEnabled = statement.FirewallEnabled
+		ret.ActualValue.EdrAgent = statement.EdrAgent
+		ret.ActualValue.EdrVersion = statement.EdrVersion
+	}
+
+	if err != nil {
+		ret.ActualValue.Reason = err.Error()
+	} else {
+		ret.ActualValue.Verified = true
+	}
+
+	return ret
+}
+
+func (p *PostureCheckAttestation) Evaluate(apiSessionId string, pd *PostureData) bool {
+	return p.evaluateAt(apiSessionId, pd, time.Now())
+}
+
+func (p *PostureCheckAttestation) evaluateAt(apiSessionId string, pd *PostureData, now time.Time) bool {
+	response := p.getResponse(apiSessionId, pd)
+	if response == nil {
+		return false
+	}
+
+	_, err := p.Verify(response.Statement, response.IdentityId, now)
+	return err == nil
+}
+
+func (p *PostureCheckAttestation) getResponse(apiSessionId string, pd *PostureData) *PostureResponseAttestation {
+	if apiSessionData := pd.ApiSessions[apiSessionId]; apiSessionData != nil {
+		return apiSessionData.Attestation
+	}
+	return nil
+}
+
+func newPostureCheckAttestation() PostureCheckSubType {
+	return &PostureCheckAttestation{}
+}
+
+func (p *PostureCheckAttestation) fillFrom(_ Env, _ *bbolt.Tx, _ *db.PostureCheck, subType db.PostureCheckSubType) error {
+	subCheck := subType.(*db.PostureCheckAttestation)
+
+	if subCheck == nil {
+		return fmt.Errorf("could not convert attestation check to bolt type")
+	}
+
+	p.PublicKeys = subCheck.PublicKeys
+	p.MaxAgeSeconds = subCheck.MaxAgeSeconds
+	p.RequireDiskEncryption = subCheck.RequireDiskEncryption
+	p.RequireFirewall = subCheck.RequireFirewall
+	p.EdrAgent = subCheck.EdrAgent
+	p.MinEdrVersion = subCheck.MinEdrVersion
+	return nil
+}
+
+func (p *PostureCheckAttestation) toBoltEntityForCreate(*bbolt.Tx, Env) (db.PostureCheckSubType, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &db.PostureCheckAttestation{
+		PublicKeys:            p.PublicKeys,
+		MaxAgeSeconds:         p.MaxAgeSeconds,
+		RequireDiskEncryption: p.RequireDiskEncryption,
+		RequireFirewall:       p.RequireFirewall,
+		EdrAgent:              p.EdrAgent,
+		MinEdrVersion:         p.MinEdrVersion,
+	}, nil
+}
+
+type PostureCheckAttestationValues struct {
+	Verified        bool   `json:"verified"`
+	DiskEncrypted   bool   `json:"diskEncrypted"`
+	FirewallEnabled bool   `json:"firewallEnabled"`
+	EdrAgent        string `json:"edrAgent"`
+	EdrVersion      string `json:"edrVersion"`
+	Reason          string `json:"reason,omitempty"`
+}
+
+type PostureCheckFailureValuesAttestation struct {
+	ActualValue   PostureCheckAttestationValues
+	ExpectedValue PostureCheckAttestationValues
+}
+
+func (p PostureCheckFailureValuesAttestation) Expected() interface{} {
+	return p.ExpectedValue
+}
+
+func (p PostureCheckFailureValuesAttestation) Actual() interface{} {
+	return p.ActualValue
+}
@@ -0,0 +1,111 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+	"ztna-core/ztna/common/attestation"
+)
+
+const (
+	attestationTestApiSessionId = "jkl012"
+	attestationTestIdentityId   = "identity1"
+)
+
+func TestPostureCheckModelAttestation(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+
+	check := &PostureCheckAttestation{
+		Check: attestation.Check{
+			PublicKeys:            []string{string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))},
+			MaxAgeSeconds:         300,
+			RequireDiskEncryption: true,
+		},
+	}
+
+	newAttestedPostureData := func(identityId string, issuedAt time.Time, diskEncrypted bool) *PostureData {
+		statement := &attestation.Statement{
+			RegisteredClaims: jwt.RegisteredClaims{
+				IssuedAt: jwt.NewNumericDate(issuedAt),
+			},
+			IdentityId:    identityId,
+			DiskEncrypted: diskEncrypted,
+		}
+
+		token, err := jwt.NewWithClaims(jwt.SigningMethodES256, statement).SignedString(key)
+		require.NoError(t, err)
+
+		postureData := newPostureData()
+		postureData.ApiSessions[attestationTestApiSessionId] = &ApiSessionPostureData{
+			Attestation: &PostureResponseAttestation{
+				PostureResponse: &PostureResponse{LastUpdatedAt: now},
+				ApiSessionId:    attestationTestApiSessionId,
+				IdentityId:      attestationTestIdentityId,
+				Statement:       token,
+			},
+		}
+		return postureData
+	}
+
+	t.Run("passes for a fresh statement meeting the requirements", func(t *testing.T) {
+		postureData := newAttestedPostureData(attestationTestIdentityId, now.Add(-time.Minute), true)
+
+		req := require.New(t)
+		req.True(check.evaluateAt(attestationTestApiSessionId, postureData, now))
+		req.Equal(int64(240), check.getTimeoutRemainingAtSeconds(attestationTestApiSessionId, postureData, now))
+	})
+
+	t.Run("fails for a statement not meeting the requirements", func(t *testing.T) {
+		postureData := newAttestedPostureData(attestationTestIdentityId, now, false)
+
+		req := require.New(t)
+		req.False(check.evaluateAt(attestationTestApiSessionId, postureData, now))
+
+		failureValues := check.FailureValues(attestationTestApiSessionId, postureData).(*PostureCheckFailureValuesAttestation)
+		req.False(failureValues.ActualValue.Verified)
+		req.NotEmpty(failureValues.ActualValue.Reason)
+	})
+
+	t.Run("fails for a stale statement", func(t *testing.T) {
+		postureData := newAttestedPostureData(attestationTestIdentityId, now.Add(-10*time.Minute), true)
+		require.False(t, check.evaluateAt(attestationTestApiSessionId, postureData, now))
+	})
+
+	t.Run("fails for a statement about another identity", func(t *testing.T) {
+		postureData := newAttestedPostureData("identity2", now, true)
+		require.False(t, check.evaluateAt(attestationTestApiSessionId, postureData, now))
+	})
+
+	t.Run("fails with no statement", func(t *testing.T) {
+		require.False(t, check.evaluateAt(attestationTestApiSessionId, newPostureData(), now))
+	})
+}
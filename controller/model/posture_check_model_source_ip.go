@@ -0,0 +1,160 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
+	"ztna-core/ztna/common/geoip"
+	"ztna-core/ztna/common/pb/edge_cmd_pb"
+	"ztna-core/ztna/controller/db"
+)
+
+var _ PostureCheckSubType = &PostureCheckSourceIp{}
+
+type PostureCheckSourceIp struct {
+	geoip.Rules
+}
+
+func (p *PostureCheckSourceIp) TypeId() string {
+	return db.PostureCheckTypeSourceIp
+}
+
+func (p *PostureCheckSourceIp) fillProtobuf(msg *edge_cmd_pb.PostureCheck) {
+	msg.Subtype = &edge_cmd_pb.PostureCheck_SourceIp_{
+		SourceIp: &edge_cmd_pb.PostureCheck_SourceIp{
+			AllowedCidrs:     p.AllowedCidrs,
+			DeniedCidrs:      p.DeniedCidrs,
+			AllowedCountries: p.AllowedCountries,
+			DeniedCountries:  p.DeniedCountries,
+		},
+	}
+}
+
+func (p *PostureCheckSourceIp) fillFromProtobuf(msg *edge_cmd_pb.PostureCheck) error {
+	if sourceIp_, ok := msg.Subtype.(*edge_cmd_pb.PostureCheck_SourceIp_); ok {
+		if sourceIp := sourceIp_.SourceIp; sourceIp != nil {
+			p.AllowedCidrs = sourceIp.AllowedCidrs
+			p.DeniedCidrs = sourceIp.DeniedCidrs
+			p.AllowedCountries = sourceIp.AllowedCountries
+			p.DeniedCountries = sourceIp.DeniedCountries
+		}
+	} else {
+		return errors.Errorf("expected posture check sub type data of source ip, but got %T", msg.Subtype)
+	}
+	return nil
+}
+
+func (p *PostureCheckSourceIp) LastUpdatedAt(string, *PostureData) *time.Time {
+	return nil
+}
+
+func (p *PostureCheckSourceIp) GetTimeoutSeconds() int64 {
+	return PostureCheckNoTimeout
+}
+
+func (p *PostureCheckSourceIp) GetTimeoutRemainingSeconds(_ string, _ *PostureData) int64 {
+	return PostureCheckNoTimeout
+}
+
+func (p *PostureCheckSourceIp) FailureValues(apiSessionId string, pd *PostureData) PostureCheckFailureValues {
+	result := &PostureCheckFailureValuesSourceIp{
+		ExpectedValue: p.Rules,
+	}
+
+	if apiSessionData := pd.ApiSessions[apiSessionId]; apiSessionData != nil {
+		result.ActualValue.SourceIp = apiSessionData.SourceIp
+		result.ActualValue.Country = apiSessionData.SourceCountry
+	}
+
+	if err := p.evaluate(apiSessionId, pd); err != nil {
+		result.ActualValue.Reason = err.Error()
+	}
+
+	return result
+}
+
+func (p *PostureCheckSourceIp) Evaluate(apiSessionId string, pd *PostureData) bool {
+	return p.evaluate(apiSessionId, pd) == nil
+}
+
+func (p *PostureCheckSourceIp) evaluate(apiSessionId string, pd *PostureData) error {
+	apiSessionData := pd.ApiSessions[apiSessionId]
+	if apiSessionData == nil {
+		return p.Rules.Evaluate(nil, "")
+	}
+	return p.Rules.Evaluate(net.ParseIP(apiSessionData.SourceIp), apiSessionData.SourceCountry)
+}
+
+func newPostureCheckSourceIp() PostureCheckSubType {
+	return &PostureCheckSourceIp{}
+}
+
+func (p *PostureCheckSourceIp) fillFrom(_ Env, _ *bbolt.Tx, _ *db.PostureCheck, subType db.PostureCheckSubType) error {
+	subCheck := subType.(*db.PostureCheckSourceIp)
+
+	if subCheck == nil {
+		return fmt.Errorf("could not convert source ip check to bolt type")
+	}
+
+	p.AllowedCidrs = subCheck.AllowedCidrs
+	p.DeniedCidrs = subCheck.DeniedCidrs
+	p.AllowedCountries = subCheck.AllowedCountries
+	p.DeniedCountries = subCheck.DeniedCountries
+	return nil
+}
+
+func (p *PostureCheckSourceIp) toBoltEntityForCreate(*bbolt.Tx, Env) (db.PostureCheckSubType, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &db.PostureCheckSourceIp{
+		AllowedCidrs:     p.AllowedCidrs,
+		DeniedCidrs:      p.DeniedCidrs,
+		AllowedCountries: p.AllowedCountries,
+		DeniedCountries:  p.DeniedCountries,
+	}, nil
+}
+
+type PostureCheckSourceIpActual struct {
+	SourceIp string `json:"sourceIp"`
+	Country  string `json:"country"`
+	Reason   string `json:"reason"`
+}
+
+type PostureCheckFailureValuesSourceIp struct {
+	ActualValue   PostureCheckSourceIpActual
+	ExpectedValue geoip.Rules
+}
+
+func (p PostureCheckFailureValuesSourceIp) Expected() interface{} {
+	return map[string]interface{}{
+		"allowedCidrs":     p.ExpectedValue.AllowedCidrs,
+		"deniedCidrs":      p.ExpectedValue.DeniedCidrs,
+		"allowedCountries": p.ExpectedValue.AllowedCountries,
+		"deniedCountries":  p.ExpectedValue.DeniedCountries,
+	}
+}
+
+func (p PostureCheckFailureValuesSourceIp) Actual() interface{} {
+	return p.ActualValue
+}
@@ -0,0 +1,72 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+	"ztna-core/ztna/common/geoip"
+)
+
+const sourceIpTestApiSessionId = "ghi789"
+
+func TestPostureCheckModelSourceIp(t *testing.T) {
+	newCheckAndPostureData := func(sourceIp, country string) (*PostureCheckSourceIp, *PostureData) {
+		check := &PostureCheckSourceIp{
+			Rules: geoip.Rules{
+				AllowedCidrs:     []string{"10.0.0.0/8"},
+				AllowedCountries: []string{"CA"},
+				DeniedCountries:  []string{"KP"},
+			},
+		}
+
+		postureData := newPostureData()
+		postureData.ApiSessions[sourceIpTestApiSessionId] = &ApiSessionPostureData{
+			SourceIp:      sourceIp,
+			SourceCountry: country,
+		}
+
+		return check, postureData
+	}
+
+	t.Run("passes for an allowed network", func(t *testing.T) {
+		check, postureData := newCheckAndPostureData("10.1.2.3", "")
+		require.True(t, check.Evaluate(sourceIpTestApiSessionId, postureData))
+	})
+
+	t.Run("passes for an allowed country", func(t *testing.T) {
+		check, postureData := newCheckAndPostureData("24.1.2.3", "CA")
+		require.True(t, check.Evaluate(sourceIpTestApiSessionId, postureData))
+	})
+
+	t.Run("fails for a denied country in an allowed network", func(t *testing.T) {
+		check, postureData := newCheckAndPostureData("10.1.2.3", "KP")
+		req := require.New(t)
+		req.False(check.Evaluate(sourceIpTestApiSessionId, postureData))
+
+		failure := check.FailureValues(sourceIpTestApiSessionId, postureData).(*PostureCheckFailureValuesSourceIp)
+		req.Equal("10.1.2.3", failure.ActualValue.SourceIp)
+		req.Equal("KP", failure.ActualValue.Country)
+		req.NotEmpty(failure.ActualValue.Reason)
+	})
+
+	t.Run("fails if the source ip is unknown", func(t *testing.T) {
+		check, postureData := newCheckAndPostureData("", "")
+		require.False(t, check.Evaluate(sourceIpTestApiSessionId, postureData))
+		require.False(t, check.Evaluate("unknown", postureData))
+	})
+}
@@ -20,10 +20,13 @@ import (
 	"fmt"
 	"github.com/michaelquigley/pfxlog"
 	"github.com/openziti/storage/ast"
+	"ztna-core/ztna/common/geoip"
 	"ztna-core/ztna/controller/change"
 	"ztna-core/ztna/controller/db"
 	"go.etcd.io/bbolt"
+	"net"
 	"runtime/debug"
+	"sync"
 	"time"
 )
 
@@ -40,6 +43,8 @@ func NewPostureResponseManager(env Env) *PostureResponseManager {
 type PostureResponseManager struct {
 	env          Env
 	postureCache *PostureCache
+	geoIpOnce    sync.Once
+	geoIp        *geoip.Reader
 }
 
 func (self *PostureResponseManager) Create(identityId string, postureResponses []*PostureResponse) {
@@ -72,6 +77,28 @@ func (self *PostureResponseManager) SetMfaPosture(identityId string, apiSessionI
 	self.Create(identityId, []*PostureResponse{postureResponse})
 }
 
+// SetAttestation stores the signed statement submitted for a specific API Session owned by an identity. The statement
+// is verified when ATTESTATION posture checks are evaluated.
+func (self *PostureResponseManager) SetAttestation(identityId string, apiSessionId string, statement string) {
+	postureResponse := &PostureResponse{
+		PostureCheckId: AttestationResponseId,
+		TypeId:         PostureCheckTypeAttestation,
+		TimedOut:       false,
+		LastUpdatedAt:  time.Now().UTC(),
+	}
+
+	postureSubType := &PostureResponseAttestation{
+		ApiSessionId: apiSessionId,
+		IdentityId:   identityId,
+		Statement:    statement,
+	}
+
+	postureResponse.SubType = postureSubType
+	postureSubType.PostureResponse = postureResponse
+
+	self.Create(identityId, []*PostureResponse{postureResponse})
+}
+
 // SetMfaPostureForIdentity sets the MFA passing status for all API Sessions associated to an identity
 func (self *PostureResponseManager) SetMfaPostureForIdentity(identityId string, isPassed bool) {
 	postureResponse := &PostureResponse{
@@ -254,6 +281,69 @@ func (self *PostureResponseManager) SetSdkInfo(identityId, apiSessionId string,
 	})
 }
 
+// SetSourceIp records the address an API Session was last seen from, along with its country if a geoip database is
+// configured. Used by SOURCE_IP posture checks.
+func (self *PostureResponseManager) SetSourceIp(identityId, apiSessionId string, remoteAddr string) {
+	if identityId == "" || apiSessionId == "" || remoteAddr == "" {
+		return
+	}
+
+	host := remoteAddr
+	if h, _, err := net.SplitHostPort(remoteAddr); err == nil {
+		host = h
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil {
+		pfxlog.Logger().WithField("apiSessionId", apiSessionId).Warnf("unable to parse source ip from [%s]", remoteAddr)
+		return
+	}
+
+	country := ""
+	if reader := self.getGeoIp(); reader != nil {
+		var err error
+		if country, err = reader.Country(ip); err != nil {
+			pfxlog.Logger().WithError(err).WithField("ip", ip.String()).Debug("geoip lookup failed")
+		}
+	}
+
+	self.postureCache.Upsert(identityId, false, func(exist bool, valueInMap *PostureData, newValue *PostureData) *PostureData {
+		var postureData *PostureData
+		if exist {
+			postureData = valueInMap
+		} else {
+			postureData = newValue
+		}
+
+		if _, ok := postureData.ApiSessions[apiSessionId]; !ok {
+			postureData.ApiSessions[apiSessionId] = &ApiSessionPostureData{}
+		}
+
+		apiSessionData := postureData.ApiSessions[apiSessionId]
+		apiSessionData.SourceIp = ip.String()
+		apiSessionData.SourceCountry = country
+
+		return postureData
+	})
+}
+
+func (self *PostureResponseManager) getGeoIp() *geoip.Reader {
+	self.geoIpOnce.Do(func() {
+		cfg := self.env.GetConfig()
+		if cfg == nil || cfg.Edge == nil || cfg.Edge.GeoIp.DbFile == "" {
+			return
+		}
+
+		reader, err := geoip.Open(cfg.Edge.GeoIp.DbFile)
+		if err != nil {
+			pfxlog.Logger().WithError(err).Error("unable to load geoip database, SOURCE_IP posture checks will not match countries")
+			return
+		}
+		self.geoIp = reader
+	})
+	return self.geoIp
+}
+
 type ServiceWithTimeout struct {
 	Service *EdgeService
 	Timeout int64
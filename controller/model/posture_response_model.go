@@ -349,6 +349,9 @@ type ApiSessionPostureData struct {
 	Mfa           *PostureResponseMfa           `json:"mfa"`
 	EndpointState *PostureResponseEndpointState `json:"endpointState"`
 	SdkInfo       *SdkInfo
+	SourceIp      string                      `json:"sourceIp"`
+	SourceCountry string                      `json:"sourceCountry"`
+	Attestation   *PostureResponseAttestation `json:"attestation"`
 }
 
 func (self *ApiSessionPostureData) GetPassedMfaAt() *time.Time {
@@ -0,0 +1,45 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import "time"
+
+// AttestationResponseId is the posture check id attestation statements are submitted under, as a single statement
+// applies to every ATTESTATION posture check
+const AttestationResponseId = "attestation"
+
+// PostureResponseAttestation holds the signed statement last submitted for an API Session. The statement is verified
+// against each ATTESTATION posture check when evaluated, as each check pins its own keys.
+type PostureResponseAttestation struct {
+	*PostureResponse
+	ApiSessionId string `json:"-"`
+	IdentityId   string `json:"-"`
+	Statement    string `json:"statement"`
+}
+
+func (pr *PostureResponseAttestation) Apply(postureData *PostureData) {
+	if postureData.ApiSessions == nil {
+		postureData.ApiSessions = map[string]*ApiSessionPostureData{}
+	}
+
+	if postureData.ApiSessions[pr.ApiSessionId] == nil {
+		postureData.ApiSessions[pr.ApiSessionId] = &ApiSessionPostureData{}
+	}
+
+	postureData.ApiSessions[pr.ApiSessionId].Attestation = pr
+	postureData.ApiSessions[pr.ApiSessionId].Attestation.LastUpdatedAt = time.Now().UTC()
+}
@@ -17,8 +17,17 @@
 package model
 
 import (
+	"bytes"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"github.com/openziti/storage/ast"
 	"github.com/openziti/storage/boltz"
+	"go.etcd.io/bbolt"
+	"math/big"
+	"sync"
 	"ztna-core/ztna/common/pb/edge_cmd_pb"
+	"ztna-core/ztna/controller/certstatus"
 	"ztna-core/ztna/controller/change"
 	"ztna-core/ztna/controller/command"
 	"ztna-core/ztna/controller/db"
@@ -35,11 +44,20 @@ func NewRevocationManager(env Env) *RevocationManager {
 
 	RegisterManagerDecoder[*Revocation](env, manager)
 
+	env.GetStores().Authenticator.AddEntityIdListener(manager.invalidateIssuedSerials, boltz.EntityCreated, boltz.EntityUpdated, boltz.EntityDeleted)
+
 	return manager
 }
 
+var _ certstatus.RevocationSource = (*RevocationManager)(nil)
+
 type RevocationManager struct {
 	baseEntityManager[*Revocation, *db.Revocation]
+
+	// serials of the certificates of network issued authenticators, built on demand and discarded whenever an
+	// authenticator changes
+	issuedSerials     map[string]struct{}
+	issuedSerialsLock sync.Mutex
 }
 
 func (self *RevocationManager) ApplyUpdate(_ *command.UpdateEntityCommand[*Revocation], ctx boltz.MutateContext) error {
@@ -100,3 +118,88 @@ func (self *RevocationManager) Unmarshall(bytes []byte) (*Revocation, error) {
 		ExpiresAt: *pbTimeToTimePtr(msg.ExpiresAt),
 	}, nil
 }
+
+// ListRevokedCerts returns the revoked network issued certificates
+func (self *RevocationManager) ListRevokedCerts() ([]*certstatus.RevokedCert, error) {
+	var result []*certstatus.RevokedCert
+	err := self.GetDb().View(func(tx *bbolt.Tx) error {
+		prefix := []byte(db.CertRevocationIdPrefix)
+		cursor := self.env.GetStores().Revocation.IterateIds(tx, ast.BoolNodeTrue)
+		for cursor.Seek(prefix); cursor.IsValid() && bytes.HasPrefix(cursor.Current(), prefix); cursor.Next() {
+			revocation, err := self.readInTx(tx, string(cursor.Current()))
+			if err != nil {
+				return err
+			}
+			if revokedCert := revocation.toRevokedCert(); revokedCert != nil {
+				result = append(result, revokedCert)
+			}
+		}
+		return nil
+	})
+	return result, err
+}
+
+// GetRevokedCert returns the revocation of the network issued certificate with the given serial, or nil if it
+// hasn't been revoked
+func (self *RevocationManager) GetRevokedCert(serial *big.Int) (*certstatus.RevokedCert, error) {
+	revocation, err := self.Read(db.CertRevocationId(serial))
+	if err != nil {
+		if boltz.IsErrNotFoundErr(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return revocation.toRevokedCert(), nil
+}
+
+// IsIssuedCert returns true if the certificate with the given serial belongs to a network issued authenticator
+func (self *RevocationManager) IsIssuedCert(serial *big.Int) (bool, error) {
+	self.issuedSerialsLock.Lock()
+	defer self.issuedSerialsLock.Unlock()
+
+	if self.issuedSerials == nil {
+		issuedSerials, err := self.loadIssuedSerials()
+		if err != nil {
+			return false, err
+		}
+		self.issuedSerials = issuedSerials
+	}
+
+	_, found := self.issuedSerials[serial.Text(16)]
+	return found, nil
+}
+
+func (self *RevocationManager) invalidateIssuedSerials(string) {
+	self.issuedSerialsLock.Lock()
+	defer self.issuedSerialsLock.Unlock()
+	self.issuedSerials = nil
+}
+
+func (self *RevocationManager) loadIssuedSerials() (map[string]struct{}, error) {
+	result := map[string]struct{}{}
+	err := self.GetDb().View(func(tx *bbolt.Tx) error {
+		store := self.env.GetStores().Authenticator
+		ids, _, err := store.QueryIds(tx, fmt.Sprintf("%s = true limit none", db.FieldAuthenticatorCertIsIssuedByNetwork))
+		if err != nil {
+			return err
+		}
+
+		for _, id := range ids {
+			authenticator, err := store.LoadById(tx, id)
+			if err != nil {
+				return err
+			}
+			certAuthenticator, ok := authenticator.SubType.(*db.AuthenticatorCert)
+			if !ok {
+				continue
+			}
+			if block, _ := pem.Decode([]byte(certAuthenticator.Pem)); block != nil {
+				if cert, err := x509.ParseCertificate(block.Bytes); err == nil {
+					result[cert.SerialNumber.Text(16)] = struct{}{}
+				}
+			}
+		}
+		return nil
+	})
+	return result, err
+}
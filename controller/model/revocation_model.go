@@ -18,6 +18,9 @@ package model
 
 import (
 	"github.com/openziti/storage/boltz"
+	"math/big"
+	"strings"
+	"ztna-core/ztna/controller/certstatus"
 	"ztna-core/ztna/controller/db"
 	"ztna-core/ztna/controller/models"
 	"go.etcd.io/bbolt"
@@ -48,3 +51,22 @@ func (entity *Revocation) toBoltEntityForCreate(*bbolt.Tx, Env) (*db.Revocation,
 
 	return boltEntity, nil
 }
+
+// toRevokedCert returns the revoked certificate if this is the revocation of a network issued certificate
+func (entity *Revocation) toRevokedCert() *certstatus.RevokedCert {
+	serialHex, found := strings.CutPrefix(entity.Id, db.CertRevocationIdPrefix)
+	if !found {
+		return nil
+	}
+
+	serial, ok := new(big.Int).SetString(serialHex, 16)
+	if !ok {
+		return nil
+	}
+
+	return &certstatus.RevokedCert{
+		Serial:    serial,
+		RevokedAt: entity.CreatedAt,
+		ExpiresAt: entity.ExpiresAt,
+	}
+}
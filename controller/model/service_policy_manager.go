@@ -19,6 +19,7 @@ package model
 import (
 	"github.com/openziti/storage/boltz"
 	"ztna-core/ztna/common/pb/edge_cmd_pb"
+	"ztna-core/ztna/common/schedule"
 	"ztna-core/ztna/controller/change"
 	"ztna-core/ztna/controller/command"
 	"ztna-core/ztna/controller/db"
@@ -81,6 +82,16 @@ func (self *ServicePolicyManager) Marshall(entity *ServicePolicy) ([]byte, error
 		PolicyType:        entity.PolicyType,
 	}
 
+	if entity.Schedule != nil {
+		msg.Schedule = &edge_cmd_pb.ServicePolicySchedule{
+			Timezone:  entity.Schedule.Timezone,
+			Days:      entity.Schedule.DayNames(),
+			Windows:   entity.Schedule.WindowStrings(),
+			NotBefore: timePtrToPb(entity.Schedule.NotBefore),
+			NotAfter:  timePtrToPb(entity.Schedule.NotAfter),
+		}
+	}
+
 	return proto.Marshal(msg)
 }
 
@@ -90,6 +101,16 @@ func (self *ServicePolicyManager) Unmarshall(bytes []byte) (*ServicePolicy, erro
 		return nil, err
 	}
 
+	var policySchedule *schedule.Schedule
+	if msg.Schedule != nil {
+		var err error
+		policySchedule, err = schedule.Parse(msg.Schedule.Timezone, msg.Schedule.Days, msg.Schedule.Windows,
+			pbTimeToTimePtr(msg.Schedule.NotBefore), pbTimeToTimePtr(msg.Schedule.NotAfter))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &ServicePolicy{
 		BaseEntity: models.BaseEntity{
 			Id:   msg.Id,
@@ -101,6 +122,7 @@ func (self *ServicePolicyManager) Unmarshall(bytes []byte) (*ServicePolicy, erro
 		ServiceRoles:      msg.ServiceRoles,
 		PostureCheckRoles: msg.PostureCheckRoles,
 		PolicyType:        msg.PolicyType,
+		Schedule:          policySchedule,
 	}, nil
 }
 
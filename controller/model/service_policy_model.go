@@ -20,10 +20,12 @@ import (
 	"fmt"
 	"github.com/openziti/foundation/v2/errorz"
 	"github.com/openziti/storage/boltz"
+	"ztna-core/ztna/common/schedule"
 	"ztna-core/ztna/controller/db"
 	"ztna-core/ztna/controller/models"
 	"go.etcd.io/bbolt"
 	"strings"
+	"time"
 )
 
 type ServicePolicy struct {
@@ -34,6 +36,7 @@ type ServicePolicy struct {
 	IdentityRoles     []string
 	ServiceRoles      []string
 	PostureCheckRoles []string
+	Schedule          *schedule.Schedule
 }
 
 func (entity *ServicePolicy) validatePolicyType() error {
@@ -66,6 +69,7 @@ func (entity *ServicePolicy) toBoltEntity(checker boltz.FieldChecker) (*db.Servi
 		IdentityRoles:     entity.IdentityRoles,
 		ServiceRoles:      entity.ServiceRoles,
 		PostureCheckRoles: entity.PostureCheckRoles,
+		Schedule:          ScheduleToBolt(entity.Schedule),
 	}, nil
 }
 
@@ -85,5 +89,29 @@ func (entity *ServicePolicy) fillFrom(_ Env, _ *bbolt.Tx, boltServicePolicy *db.
 	entity.ServiceRoles = boltServicePolicy.ServiceRoles
 	entity.IdentityRoles = boltServicePolicy.IdentityRoles
 	entity.PostureCheckRoles = boltServicePolicy.PostureCheckRoles
+
+	policySchedule, err := boltServicePolicy.Schedule.Parse()
+	if err != nil {
+		return err
+	}
+	entity.Schedule = policySchedule
 	return nil
 }
+
+// IsActive returns true if the policy has no schedule or if its schedule is currently in force
+func (entity *ServicePolicy) IsActive() bool {
+	return entity.Schedule.IsActive(time.Now())
+}
+
+func ScheduleToBolt(s *schedule.Schedule) *db.ServicePolicySchedule {
+	if s == nil {
+		return nil
+	}
+	return &db.ServicePolicySchedule{
+		Timezone:  s.Timezone,
+		Days:      s.DayNames(),
+		Windows:   s.WindowStrings(),
+		NotBefore: s.NotBefore,
+		NotAfter:  s.NotAfter,
+	}
+}
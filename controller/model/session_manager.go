@@ -74,7 +74,7 @@ func (self *SessionManager) EvaluatePostureForService(identityId, apiSessionId,
 
 	for policyId, policyPostureCheck := range policyPostureCheckMap {
 
-		if policyPostureCheck.PolicyType.String() != sessionType {
+		if policyPostureCheck.PolicyType.String() != sessionType || !policyPostureCheck.Active {
 			continue
 		}
 		hasMatchingPolicies = true
@@ -154,6 +154,18 @@ func (self *SessionManager) EvaluatePostureForService(identityId, apiSessionId,
 	}
 }
 
+// checkPolicySchedules returns an error if every policy granting the session type to the service is outside of its
+// schedule
+func (self *SessionManager) checkPolicySchedules(identityId, serviceId, sessionType string) error {
+	active, inactive := self.GetEnv().GetManagers().EdgeService.GetPoliciesBySchedule(identityId, serviceId, db.PolicyType(sessionType))
+	if len(active) == 0 && len(inactive) > 0 {
+		return apierror.NewOutsidePolicySchedule(&fabricApiError.GenericCauseError{
+			Message: fmt.Sprintf("service policies outside of their schedule: %v", inactive),
+		})
+	}
+	return nil
+}
+
 func (self *SessionManager) CreateJwt(entity *Session, ctx *change.Context) (string, error) {
 	entity.Id = uuid.New().String()
 
@@ -174,6 +186,10 @@ func (self *SessionManager) CreateJwt(entity *Session, ctx *change.Context) (str
 		return "", errorz.NewFieldError("service not found", "ServiceId", entity.ServiceId)
 	}
 
+	if err = self.checkPolicySchedules(entity.IdentityId, service.Id, entity.Type); err != nil {
+		return "", err
+	}
+
 	policyResult := self.EvaluatePostureForService(entity.IdentityId, entity.ApiSessionId, entity.Type, service.Id, service.Name)
 
 	if !policyResult.Passed {
@@ -241,6 +257,10 @@ func (self *SessionManager) Create(entity *Session, ctx *change.Context) (string
 		return "", errorz.NewFieldError("service not found", "ServiceId", entity.ServiceId)
 	}
 
+	if err = self.checkPolicySchedules(apiSession.IdentityId, service.Id, entity.Type); err != nil {
+		return "", err
+	}
+
 	policyResult := self.EvaluatePostureForService(apiSession.IdentityId, apiSession.Id, entity.Type, service.Id, service.Name)
 
 	if !policyResult.Passed {
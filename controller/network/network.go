@@ -28,6 +28,7 @@ import (
 	fabricMetrics "ztna-core/ztna/common/metrics"
 	"ztna-core/ztna/common/pb/cmd_pb"
 	"ztna-core/ztna/common/pb/mgmt_pb"
+	"ztna-core/ztna/common/shaping"
 	"ztna-core/ztna/controller/config"
 	"ztna-core/ztna/controller/event"
 	"ztna-core/ztna/controller/idgen"
@@ -38,6 +39,7 @@ import (
 	"path/filepath"
 	"runtime/debug"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -54,6 +56,7 @@ import (
 	"github.com/openziti/metrics"
 	"github.com/openziti/metrics/metrics_pb"
 	"github.com/openziti/storage/boltz"
+	cmap "github.com/orcaman/concurrent-map/v2"
 	"ztna-core/ztna/common/ctrl_msg"
 	"ztna-core/ztna/common/logcontext"
 	"ztna-core/ztna/common/pb/ctrl_pb"
@@ -119,6 +122,10 @@ type Network struct {
 	Inspections       *InspectionsManager
 	RouterMessaging   *RouterMessaging
 	inspectionTargets concurrenz.CopyOnWriteSlice[InspectTarget]
+
+	// identityTagCache holds the circuit tags taken from each dialing identity, so identities needn't be read for
+	// every circuit. Entries are evicted when the identity is updated or deleted.
+	identityTagCache cmap.ConcurrentMap[string, map[string]string]
 }
 
 func NewNetwork(config Config, env model.Env) (*Network, error) {
@@ -158,7 +165,8 @@ func NewNetwork(config Config, env model.Env) (*Network, error) {
 		serviceInvalidTerminatorCounter:           serviceEventMetrics.IntervalCounter("service.dial.terminator.invalid", time.Minute),
 		serviceMisconfiguredTerminatorCounter:     serviceEventMetrics.IntervalCounter("service.dial.terminator.misconfigured", time.Minute),
 
-		config: config,
+		config:           config,
+		identityTagCache: cmap.New[map[string]string](),
 	}
 
 	env.GetManagers().Command.Decoders.RegisterF(int32(cmd_pb.CommandType_SyncSnapshot), network.decodeSyncSnapshotCommand)
@@ -171,6 +179,7 @@ func NewNetwork(config Config, env model.Env) (*Network, error) {
 	network.RouterMessaging = NewRouterMessaging(env, routerCommPool)
 
 	env.GetManagers().Router.Store.AddEntityIdListener(network.HandleRouterDelete, boltz.EntityDeletedAsync)
+	env.GetManagers().Identity.Store.AddEntityIdListener(network.identityTagCache.Remove, boltz.EntityUpdated, boltz.EntityDeleted)
 
 	network.AddCapability("ziti.fabric")
 	network.showOptions()
@@ -548,6 +557,75 @@ func (network *Network) RerouteLink(l *model.Link) {
 	}()
 }
 
+// serviceCircuitTags maps the service tags which are copied to the circuit tags, allowing routers to apply
+// per-service settings such as the xgress congestion control algorithm, bandwidth limits, link priority class and
+// payload compression, to circuit tag names
+var serviceCircuitTags = map[string]string{
+	"compression":         "compression",
+	"congestionControl":   "congestionControl",
+	"priority":            "priority",
+	shaping.IngressBpsTag: shaping.CircuitTagServiceIngressBps,
+	shaping.EgressBpsTag:  shaping.CircuitTagServiceEgressBps,
+	shaping.BurstBytesTag: shaping.CircuitTagServiceBurstBytes,
+}
+
+// identityCircuitTags maps the tags of the dialing identity which are copied to the circuit tags
+var identityCircuitTags = map[string]string{
+	shaping.IngressBpsTag: shaping.CircuitTagIdentityIngressBps,
+	shaping.EgressBpsTag:  shaping.CircuitTagIdentityEgressBps,
+	shaping.BurstBytesTag: shaping.CircuitTagIdentityBurstBytes,
+}
+
+func copyCircuitTags(tags map[string]string, source map[string]interface{}, names map[string]string) map[string]string {
+	for name, circuitTag := range names {
+		var val string
+		switch v := source[name].(type) {
+		case string:
+			val = v
+		case float64:
+			val = strconv.FormatFloat(v, 'f', -1, 64)
+		case int64:
+			val = strconv.FormatInt(v, 10)
+		case int:
+			val = strconv.Itoa(v)
+		}
+		if val != "" {
+			if tags == nil {
+				tags = map[string]string{}
+			}
+			tags[circuitTag] = val
+		}
+	}
+	return tags
+}
+
+// addCircuitTags adds the circuit tags taken from the service and, for edge circuits, from the dialing identity
+func (network *Network) addCircuitTags(tags map[string]string, svc *model.Service) map[string]string {
+	tags = copyCircuitTags(tags, svc.Tags, serviceCircuitTags)
+	if identityId := tags["clientId"]; identityId != "" {
+		for k, v := range network.getIdentityCircuitTags(identityId) {
+			tags[k] = v
+		}
+	}
+	return tags
+}
+
+// getIdentityCircuitTags returns the circuit tags taken from the given identity, reading it only if not yet cached
+func (network *Network) getIdentityCircuitTags(identityId string) map[string]string {
+	if tags, found := network.identityTagCache.Get(identityId); found {
+		return tags
+	}
+
+	identity, _ := network.Identity.Read(identityId)
+	if identity == nil {
+		return nil
+	}
+
+	tags := copyCircuitTags(map[string]string{}, identity.Tags, identityCircuitTags)
+	network.identityTagCache.Set(identityId, tags)
+	return tags
+}
+
 func (network *Network) CreateCircuit(params model.CreateCircuitParams) (*model.Circuit, error) {
 	clientId := params.GetClientId()
 	service := params.GetServiceId()
@@ -602,7 +680,7 @@ func (network *Network) CreateCircuit(params model.CreateCircuitParams) (*model.
 		}
 
 		// get circuit tags
-		tags := params.GetCircuitTags(terminator)
+		tags := network.addCircuitTags(params.GetCircuitTags(terminator), svc)
 
 		// 4a: Create Route Messages
 		rms := network.CreateRouteMessages(path, attempt, circuitId, terminator, deadline)
@@ -1093,6 +1171,9 @@ func (network *Network) rerouteCircuit(circuit *model.Circuit, deadline time.Tim
 			circuit.UpdatedAt = time.Now()
 
 			rms := network.CreateRouteMessages(cq, SmartRerouteAttempt, circuit.Id, circuit.Terminator, deadline)
+			for _, msg := range rms {
+				msg.Tags = circuit.Tags
+			}
 
 			for i := 0; i < len(cq.Nodes); i++ {
 				if _, err := sendRoute(cq.Nodes[i], rms[i], network.options.RouteTimeout); err != nil {
@@ -1123,6 +1204,9 @@ func (network *Network) smartReroute(circuit *model.Circuit, cq *model.Path, dea
 		circuit.UpdatedAt = time.Now()
 
 		rms := network.CreateRouteMessages(cq, SmartRerouteAttempt, circuit.Id, circuit.Terminator, deadline)
+		for _, msg := range rms {
+			msg.Tags = circuit.Tags
+		}
 
 		for i := 0; i < len(cq.Nodes); i++ {
 			if _, err := sendRoute(cq.Nodes[i], rms[i], network.options.RouteTimeout); err != nil {
@@ -65,6 +65,7 @@ type routeSender struct {
 	attendance      map[string]bool
 	serviceCounters ServiceCounters
 	terminators     *model.TerminatorManager
+	dialStart       time.Time
 }
 
 func newRouteSender(circuitId string, timeout time.Duration, serviceCounters ServiceCounters, terminators *model.TerminatorManager) *routeSender {
@@ -80,6 +81,7 @@ func newRouteSender(circuitId string, timeout time.Duration, serviceCounters Ser
 
 func (self *routeSender) route(attempt uint32, path *model.Path, routeMsgs []*ctrl_pb.Route, strategy xt.Strategy, terminator xt.Terminator, ctx logcontext.Context) (peerData xt.PeerData, cleanups map[string]struct{}, err CircuitError) {
 	logger := pfxlog.ChannelLogger(logcontext.EstablishPath).Wire(ctx)
+	self.dialStart = time.Now()
 
 	// send route messages
 	for i := 0; i < len(path.Nodes); i++ {
@@ -140,7 +142,7 @@ func (self *routeSender) handleRouteSend(attempt uint32, path *model.Path, strat
 			self.attendance[status.Router.Id] = true
 			if status.Router.Id == terminator.GetRouterId() {
 				peerData = status.PeerData
-				strategy.NotifyEvent(xt.NewDialSucceeded(terminator))
+				strategy.NotifyEvent(xt.NewDialSucceededAfter(terminator, time.Since(self.dialStart)))
 				self.serviceCounters.ServiceDialSuccess(terminator.GetServiceId(), terminator.GetId())
 			}
 		} else {
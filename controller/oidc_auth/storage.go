@@ -522,6 +522,8 @@ func (s *HybridStorage) createAccessToken(ctx context.Context, request op.TokenR
 		ipAddr = httpRequest.RemoteAddr
 	}
 
+	s.env.GetManagers().PostureResponse.SetSourceIp(identity.Id, claims.ApiSessionId, ipAddr)
+
 	evt := &event.ApiSessionEvent{
 		Namespace:  event.ApiSessionEventNS,
 		EventType:  eventType,
@@ -248,6 +248,11 @@ func (self *BoltDbFsm) Apply(log *raft.Log) interface{} {
 			}
 			changeCtx.RaftIndex = log.Index
 
+			// commands dispatched without a timestamp use the leader's append time, which followers also see
+			if !log.AppendedAt.IsZero() {
+				changeCtx.SetTimestampIfMissing(log.AppendedAt)
+			}
+
 			ctx := changeCtx.NewMutateContext()
 			ctx.AddPreCommitAction(func(ctx boltz.MutateContext) error {
 				return self.updateIndexInTx(ctx.Tx(), log.Index)
@@ -283,6 +283,11 @@ func (self *Controller) Dispatch(cmd command.Command) error {
 		return errors.New("unable to execute command. In a readonly state: different versions detected in cluster")
 	}
 
+	// stamp the change before it's encoded, so all nodes apply it with the same time
+	if changeCtx := cmd.GetChangeContext(); changeCtx != nil {
+		changeCtx.SetTimestampIfMissing(time.Now())
+	}
+
 	if self.IsLeader() {
 		_, err := self.applyCommand(cmd)
 		return err
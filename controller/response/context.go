@@ -21,6 +21,7 @@ import (
 	"ztna-core/edge-api/rest_model"
 	"ztna-core/ztna/common"
 	"ztna-core/ztna/controller/change"
+	"ztna-core/ztna/controller/internal/permissions"
 	"ztna-core/ztna/controller/model"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -50,8 +51,12 @@ type RequestContext struct {
 	AuthPolicy        *model.AuthPolicy
 	AuthQueries       rest_model.AuthQueryList
 	ActivePermissions []string
-	ResponseWriter    http.ResponseWriter
-	Request           *http.Request
+	Grants            []*permissions.Grant
+	// EnforceGrantScopes is set when the route is guarded by entity permissions. List results are then filtered to
+	// the entities covered by the request's scoped grants
+	EnforceGrantScopes bool
+	ResponseWriter     http.ResponseWriter
+	Request            *http.Request
 
 	entityId    string
 	entitySubId string
@@ -64,6 +69,24 @@ func (rc *RequestContext) GetId() string {
 	return rc.Id
 }
 
+// GetScope returns the scoped grants limiting the verb on the entity type, or nil if access isn't limited by scope
+func (rc *RequestContext) GetScope(entityType, verb string) []*permissions.Grant {
+	return permissions.GetScope(rc.ActivePermissions, rc.Grants, entityType, verb)
+}
+
+// GetScopeFilter returns a query predicate restricting the entity type to the entities the request may access with
+// the given verb. If access isn't limited by scope, false is returned
+func (rc *RequestContext) GetScopeFilter(entityType, verb string) (string, bool) {
+	if !rc.EnforceGrantScopes {
+		return "", false
+	}
+	scope := rc.GetScope(entityType, verb)
+	if len(scope) == 0 {
+		return "", false
+	}
+	return permissions.GetScopeFilter(scope), true
+}
+
 func (rc *RequestContext) GetBody() []byte {
 	return rc.Body
 }
@@ -118,6 +141,10 @@ func (rc *RequestContext) NewChangeContext() *change.Context {
 		changeCtx.SetChangeAuthorType(change.AuthorTypeUnattributed)
 	}
 
+	if rc.ApiSession != nil && rc.ApiSession.AuthenticatorId != "" {
+		changeCtx.SetSourceAuthenticator(rc.ApiSession.AuthenticatorId)
+	}
+
 	if rc.Request.Form.Has("traceId") {
 		changeCtx.SetTraceId(rc.Request.Form.Get("traceId"))
 	}
@@ -21,8 +21,13 @@ import (
 	"github.com/michaelquigley/pfxlog"
 	"github.com/openziti/channel/v3"
 	"github.com/openziti/storage/boltz"
+	"ztna-core/ztna/common/cert"
 	"ztna-core/ztna/common/pb/edge_ctrl_pb"
 	runner2 "ztna-core/ztna/common/runner"
+	"ztna-core/ztna/controller/audit"
+	"ztna-core/ztna/controller/carevocation"
+	"ztna-core/ztna/controller/certstatus"
+	"ztna-core/ztna/controller/db"
 	edgeconfig "ztna-core/ztna/controller/config"
 	"ztna-core/ztna/controller/env"
 	"ztna-core/ztna/controller/handler_edge_ctrl"
@@ -31,6 +36,7 @@ import (
 	"ztna-core/ztna/controller/model"
 	sync2 "ztna-core/ztna/controller/sync_strats"
 	"os"
+	"strings"
 	"sync"
 	"time"
 )
@@ -138,7 +144,11 @@ func (c *Controller) Enabled() bool {
 func (c *Controller) initializeAuthModules() {
 	c.initModulesOnce.Do(func() {
 		c.AppEnv.AuthRegistry.Add(model.NewAuthModuleUpdb(c.AppEnv))
-		c.AppEnv.AuthRegistry.Add(model.NewAuthModuleCert(c.AppEnv, c.AppEnv.GetConfig().Edge.CaPems()))
+		revocationChecker := carevocation.NewChecker(&c.AppEnv.GetConfig().Edge.CaRevocation,
+			c.AppEnv.GetEventDispatcher(), c.AppEnv.GetCloseNotifyChannel())
+		c.AppEnv.GetHostController().GetNetwork().AddInspectTarget(revocationChecker.Inspect)
+
+		c.AppEnv.AuthRegistry.Add(model.NewAuthModuleCert(c.AppEnv, c.AppEnv.GetConfig().Edge.CaPems(), revocationChecker))
 		c.AppEnv.AuthRegistry.Add(model.NewAuthModuleExtJwt(c.AppEnv))
 
 		c.AppEnv.EnrollRegistry.Add(model.NewEnrollModuleCa(c.AppEnv))
@@ -150,6 +160,43 @@ func (c *Controller) initializeAuthModules() {
 	})
 }
 
+// initializeCertStatus sets up the CRL and OCSP responder for network issued identity certificates. Must be called
+// after InitPersistence
+func (c *Controller) initializeCertStatus() {
+	if !c.config.CertStatus.Enabled {
+		return
+	}
+
+	responder := certstatus.NewResponder(&c.config.CertStatus, c.AppEnv.ApiClientCsrSigner, c.AppEnv.GetManagers().Revocation)
+
+	c.AppEnv.GetStores().Revocation.AddEntityIdListener(func(id string) {
+		if strings.HasPrefix(id, db.CertRevocationIdPrefix) {
+			responder.Invalidate()
+		}
+	}, boltz.EntityCreated, boltz.EntityDeleted)
+
+	if c.config.CertStatus.EmbedUrls {
+		if signer, ok := c.AppEnv.ApiClientCsrSigner.(*cert.ClientSigner); ok {
+			crlUrl, ocspUrl := certstatus.Urls(c.config.Api.Address)
+			signer.CrlDistributionPoints = []string{crlUrl}
+			signer.OcspServers = []string{ocspUrl}
+		}
+	}
+
+	c.AppEnv.CertStatusResponder = responder
+}
+
+// initializeAudit adds the audit log to the entity stores. Must be called after InitPersistence
+func (c *Controller) initializeAudit() {
+	if !c.config.Audit.Enabled {
+		return
+	}
+
+	auditor := audit.NewAuditor(&c.config.Audit, c.AppEnv.GetStores())
+	auditor.Register()
+	go model.NewAuditRecordPruner(c.AppEnv, c.config.Audit.MaxAge, c.config.Audit.PruneInterval).Run()
+}
+
 func (c *Controller) Initialize() {
 	if !c.Enabled() {
 		return
@@ -165,6 +212,8 @@ func (c *Controller) Initialize() {
 	}
 
 	c.initializeAuthModules()
+	c.initializeCertStatus()
+	c.initializeAudit()
 
 	//after InitPersistence
 	c.AppEnv.Broker = env.NewBroker(c.AppEnv, sync2.NewInstantStrategy(c.AppEnv, sync2.InstantStrategyOptions{
@@ -1452,6 +1452,20 @@ func newServicePolicy(storeModel *db.ServicePolicy) *edge_ctrl_pb.DataState_Serv
 		PolicyType: edge_ctrl_pb.PolicyType(storeModel.PolicyType.Id()),
 	}
 
+	if storeModel.Schedule != nil {
+		servicePolicy.Schedule = &edge_ctrl_pb.DataState_Schedule{
+			Timezone: storeModel.Schedule.Timezone,
+			Days:     storeModel.Schedule.Days,
+			Windows:  storeModel.Schedule.Windows,
+		}
+		if storeModel.Schedule.NotBefore != nil {
+			servicePolicy.Schedule.NotBefore = timestamppb.New(*storeModel.Schedule.NotBefore)
+		}
+		if storeModel.Schedule.NotAfter != nil {
+			servicePolicy.Schedule.NotAfter = timestamppb.New(*storeModel.Schedule.NotAfter)
+		}
+	}
+
 	return servicePolicy
 }
 
@@ -1585,6 +1599,26 @@ func newPostureCheck(postureModel *db.PostureCheck) *edge_ctrl_pb.DataState_Post
 				Domains: subType.Domains,
 			},
 		}
+	case *db.PostureCheckSourceIp:
+		newVal.Subtype = &edge_ctrl_pb.DataState_PostureCheck_SourceIp_{
+			SourceIp: &edge_ctrl_pb.DataState_PostureCheck_SourceIp{
+				AllowedCidrs:     subType.AllowedCidrs,
+				DeniedCidrs:      subType.DeniedCidrs,
+				AllowedCountries: subType.AllowedCountries,
+				DeniedCountries:  subType.DeniedCountries,
+			},
+		}
+	case *db.PostureCheckAttestation:
+		newVal.Subtype = &edge_ctrl_pb.DataState_PostureCheck_Attestation_{
+			Attestation: &edge_ctrl_pb.DataState_PostureCheck_Attestation{
+				PublicKeys:            subType.PublicKeys,
+				MaxAgeSeconds:         subType.MaxAgeSeconds,
+				RequireDiskEncryption: subType.RequireDiskEncryption,
+				RequireFirewall:       subType.RequireFirewall,
+				EdrAgent:              subType.EdrAgent,
+				MinEdrVersion:         subType.MinEdrVersion,
+			},
+		}
 	case *db.PostureCheckMacAddresses:
 		newVal.Subtype = &edge_ctrl_pb.DataState_PostureCheck_Mac_{
 			Mac: &edge_ctrl_pb.DataState_PostureCheck_Mac{
@@ -27,6 +27,7 @@ import (
 	"ztna-core/edge-api/rest_management_api_server"
 	"ztna-core/ztna/controller/api"
 	"ztna-core/ztna/controller/apierror"
+	"ztna-core/ztna/controller/certstatus"
 	"ztna-core/ztna/controller/env"
 	"ztna-core/ztna/controller/response"
 
@@ -125,7 +126,8 @@ func (clientApi ClientApiHandler) RootPath() string {
 }
 
 func (clientApi ClientApiHandler) IsHandler(r *http.Request) bool {
-	return strings.HasPrefix(r.URL.Path, clientApi.RootPath()) || r.URL.Path == WellKnownEstCaCerts || r.URL.Path == VersionPath || r.URL.Path == RootPath
+	return strings.HasPrefix(r.URL.Path, clientApi.RootPath()) || r.URL.Path == WellKnownEstCaCerts || r.URL.Path == VersionPath || r.URL.Path == RootPath ||
+		certstatus.IsResponderPath(r.URL.Path)
 }
 
 func (clientApi ClientApiHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
@@ -153,6 +155,17 @@ func (clientApi ClientApiHandler) newHandler(ae *env.AppEnv) http.Handler {
 	handler := http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
 		rw.Header().Set(ZitiInstanceId, ae.InstanceId)
 
+		// the CRL and OCSP responses are signed and are served without authentication, as relying parties
+		// don't have api sessions
+		if certstatus.IsResponderPath(r.URL.Path) {
+			if ae.CertStatusResponder == nil {
+				rw.WriteHeader(http.StatusNotFound)
+				return
+			}
+			ae.CertStatusResponder.ServeHTTP(rw, r)
+			return
+		}
+
 		//if not /edge prefix and not /fabric, translate to "/edge/client/v<latest>", this is a hack
 		//that should be removed once non-prefixed URLs are no longer used.
 		//This will affect older go-lang enrolled SDKs and the C-SDK.
@@ -204,6 +217,11 @@ func (clientApi ClientApiHandler) newHandler(ae *env.AppEnv) http.Handler {
 		//after request context is filled so that api session is present for session expiration headers
 		response.AddHeaders(rc)
 
+		if handler := ae.GetClientRoute(strings.TrimPrefix(r.URL.Path, ClientRestApiBaseUrlLatest)); handler != nil {
+			handler(ae, rc)
+			return
+		}
+
 		innerClientHandler.ServeHTTP(rw, r)
 	})
 
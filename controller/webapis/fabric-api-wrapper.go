@@ -25,7 +25,7 @@ type fabricWrapper struct {
 	ae *env.AppEnv
 }
 
-func (self *fabricWrapper) WrapRequest(handler api_impl.RequestHandler, request *http.Request, entityId, entitySubId string) middleware.Responder {
+func (self *fabricWrapper) WrapRequest(handler api_impl.RequestHandler, request *http.Request, entityId, entitySubId string, resolvers ...permissions.Resolver) middleware.Responder {
 	return middleware.ResponderFunc(func(writer http.ResponseWriter, producer runtime.Producer) {
 		rc, err := env.GetRequestContextFromHttpContext(request)
 
@@ -43,7 +43,11 @@ func (self *fabricWrapper) WrapRequest(handler api_impl.RequestHandler, request
 			return
 		}
 
-		if !permissions.IsAdmin().IsAllowed(rc.ActivePermissions...) {
+		if len(resolvers) == 0 {
+			resolvers = []permissions.Resolver{permissions.IsAdmin()}
+		}
+
+		if !self.ae.CheckPermissions(rc, resolvers...) {
 			rc.RespondWithApiError(errorz.NewUnauthorized())
 			return
 		}
@@ -11,6 +11,7 @@ import (
 	"ztna-core/ztna/controller/api"
 	"ztna-core/ztna/controller/api_impl"
 	"ztna-core/ztna/controller/apierror"
+	"ztna-core/ztna/controller/internal/permissions"
 	"ztna-core/ztna/controller/network"
 	"ztna-core/ztna/controller/rest_server"
 	"github.com/pkg/errors"
@@ -27,12 +28,16 @@ func OverrideRequestWrapper(rw api_impl.RequestWrapper) {
 	requestWrapper = rw
 }
 
+// certAuthPermissions are the permissions of clients of the certificate authenticated fabric api. These clients have
+// no identity or grants, only a certificate verified against the controller's trust roots, which confers admin access
+var certAuthPermissions = []string{permissions.AdminPermission}
+
 type FabricRequestWrapper struct {
 	nodeId  identity.Identity
 	network *network.Network
 }
 
-func (self *FabricRequestWrapper) WrapRequest(handler api_impl.RequestHandler, request *http.Request, entityId, entitySubId string) openApiMiddleware.Responder {
+func (self *FabricRequestWrapper) WrapRequest(handler api_impl.RequestHandler, request *http.Request, entityId, entitySubId string, resolvers ...permissions.Resolver) openApiMiddleware.Responder {
 	return openApiMiddleware.ResponderFunc(func(writer http.ResponseWriter, producer runtime.Producer) {
 		rc, err := api.GetRequestContextFromHttpContext(request)
 
@@ -50,6 +55,17 @@ func (self *FabricRequestWrapper) WrapRequest(handler api_impl.RequestHandler, r
 			return
 		}
 
+		if len(resolvers) == 0 {
+			resolvers = []permissions.Resolver{permissions.IsAdmin()}
+		}
+
+		for _, resolver := range resolvers {
+			if !resolver.IsAllowed(certAuthPermissions...) {
+				rc.RespondWithApiError(errorz.NewUnauthorized())
+				return
+			}
+		}
+
 		handler(self.network, rc)
 	})
 }
@@ -25,6 +25,7 @@ import (
 	"ztna-core/edge-api/rest_management_api_server"
 	"ztna-core/ztna/controller/api"
 	"ztna-core/ztna/controller/apierror"
+	"ztna-core/ztna/controller/certstatus"
 	"ztna-core/ztna/controller/env"
 	"ztna-core/ztna/controller/response"
 
@@ -93,7 +94,8 @@ func (managementApi ManagementApiHandler) RootPath() string {
 }
 
 func (managementApi ManagementApiHandler) IsHandler(r *http.Request) bool {
-	return strings.HasPrefix(r.URL.Path, managementApi.RootPath()) || r.URL.Path == WellKnownEstCaCerts || r.URL.Path == VersionPath || r.URL.Path == RootPath
+	return strings.HasPrefix(r.URL.Path, managementApi.RootPath()) || r.URL.Path == WellKnownEstCaCerts || r.URL.Path == VersionPath || r.URL.Path == RootPath ||
+		certstatus.IsResponderPath(r.URL.Path)
 }
 
 func (managementApi ManagementApiHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
@@ -117,6 +119,17 @@ func (managementApi ManagementApiHandler) newHandler(ae *env.AppEnv) http.Handle
 	handler := http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
 		rw.Header().Set(ZitiInstanceId, ae.InstanceId)
 
+		// the CRL and OCSP responses are signed and are served without authentication, as relying parties
+		// don't have api sessions
+		if certstatus.IsResponderPath(r.URL.Path) {
+			if ae.CertStatusResponder == nil {
+				rw.WriteHeader(http.StatusNotFound)
+				return
+			}
+			ae.CertStatusResponder.ServeHTTP(rw, r)
+			return
+		}
+
 		if r.URL.Path == ManagementRestApiSpecUrl {
 			rw.Header().Set("content-type", "application/json")
 			rw.WriteHeader(http.StatusOK)
@@ -147,6 +160,11 @@ func (managementApi ManagementApiHandler) newHandler(ae *env.AppEnv) http.Handle
 		//after request context is filled so that api session is present for session expiration headers
 		response.AddHeaders(rc)
 
+		if handler := ae.GetManagementRoute(strings.TrimPrefix(r.URL.Path, ManagementRestApiBaseUrlLatest)); handler != nil {
+			handler(ae, rc)
+			return
+		}
+
 		innerManagementHandler.ServeHTTP(rw, r)
 	})
 
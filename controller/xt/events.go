@@ -16,6 +16,8 @@
 
 package xt
 
+import "time"
+
 func NewStrategyChangeEvent(serviceId string, current, added, changed, removed []Terminator) StrategyChangeEvent {
 	return &strategyChangeEvent{
 		serviceId: serviceId,
@@ -72,6 +74,15 @@ func NewDialSucceeded(terminator Terminator) TerminatorEvent {
 	}
 }
 
+// NewDialSucceededAfter creates a dial succeeded event which includes the time taken to route the circuit
+func NewDialSucceededAfter(terminator Terminator, dialTime time.Duration) TerminatorEvent {
+	return &defaultEvent{
+		terminator: terminator,
+		eventType:  eventTypeSucceeded,
+		dialTime:   dialTime,
+	}
+}
+
 func NewCircuitRemoved(terminator Terminator) TerminatorEvent {
 	return &defaultEvent{
 		terminator: terminator,
@@ -90,12 +101,17 @@ const (
 type defaultEvent struct {
 	terminator Terminator
 	eventType  eventType
+	dialTime   time.Duration
 }
 
 func (event *defaultEvent) GetTerminator() Terminator {
 	return event.terminator
 }
 
+func (event *defaultEvent) GetDialTime() time.Duration {
+	return event.dialTime
+}
+
 func (event *defaultEvent) Accept(visitor EventVisitor) {
 	if event.eventType == eventTypeFailed {
 		visitor.VisitDialFailed(event)
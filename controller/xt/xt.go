@@ -90,6 +90,12 @@ type TerminatorEvent interface {
 	Accept(visitor EventVisitor)
 }
 
+// DialTimeEvent is implemented by terminator events which know how long the dial took. The dial time is zero if
+// it isn't known
+type DialTimeEvent interface {
+	GetDialTime() time.Duration
+}
+
 type EventVisitor interface {
 	VisitDialFailed(event TerminatorEvent)
 	VisitDialSucceeded(event TerminatorEvent)
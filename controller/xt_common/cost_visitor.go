@@ -13,6 +13,10 @@ type TerminatorCosts struct {
 	CircuitCount uint32
 	FailureCost  uint32
 	CachedCost   uint32
+
+	// DialTimeEwma is the exponentially weighted moving average of dial times, in nanoseconds. Only tracked if the
+	// visitor has a DialTimeWeight
+	DialTimeEwma int64
 }
 
 func (self *TerminatorCosts) cache(circuitCost uint32) {
@@ -37,7 +41,19 @@ func (self *TerminatorCosts) Inspect(terminatorId string) *inspect.TerminatorCos
 		CircuitCount: self.CircuitCount,
 		FailureCost:  self.FailureCost,
 		CurrentCost:  self.CachedCost,
+
+		DialTimeEwmaMillis: float64(atomic.LoadInt64(&self.DialTimeEwma)) / float64(time.Millisecond),
+	}
+}
+
+func (self *TerminatorCosts) updateDialTime(dialTime time.Duration, weight float64) {
+	current := atomic.LoadInt64(&self.DialTimeEwma)
+	if current == 0 {
+		atomic.StoreInt64(&self.DialTimeEwma, int64(dialTime))
+		return
 	}
+	next := weight*float64(dialTime) + (1-weight)*float64(current)
+	atomic.StoreInt64(&self.DialTimeEwma, max(int64(next), 1))
 }
 
 func NewCostVisitor(circuitCost, failureCost, successCredit uint16) *CostVisitor {
@@ -54,6 +70,10 @@ type CostVisitor struct {
 	CircuitCost   uint32
 	FailureCost   uint32
 	SuccessCredit uint32
+
+	// DialTimeWeight is the weight given to each new dial time in the dial time moving average. If zero, dial times
+	// aren't tracked
+	DialTimeWeight float64
 }
 
 func (self *CostVisitor) GetFailureCost(terminatorId string) uint32 {
@@ -72,6 +92,15 @@ func (self *CostVisitor) GetCircuitCount(terminatorId string) uint32 {
 	return val.CircuitCount
 }
 
+// GetDialTimeEwma returns the moving average of dial times for the terminator, or zero if there are no samples
+func (self *CostVisitor) GetDialTimeEwma(terminatorId string) time.Duration {
+	val, _ := self.Costs.Get(terminatorId)
+	if val == nil {
+		return 0
+	}
+	return time.Duration(atomic.LoadInt64(&val.DialTimeEwma))
+}
+
 func (self *CostVisitor) GetCost(terminatorId string) uint32 {
 	val, _ := self.Costs.Get(terminatorId)
 	if val == nil {
@@ -115,6 +144,12 @@ func (self *CostVisitor) VisitDialSucceeded(event xt.TerminatorEvent) {
 		if cost.CircuitCount < math.MaxUint32/self.CircuitCost {
 			cost.CircuitCount++
 		}
+
+		if self.DialTimeWeight > 0 {
+			if dialTimeEvent, ok := event.(xt.DialTimeEvent); ok && dialTimeEvent.GetDialTime() > 0 {
+				cost.updateDialTime(dialTimeEvent.GetDialTime(), self.DialTimeWeight)
+			}
+		}
 		cost.cache(self.CircuitCost)
 		return cost
 	})
@@ -0,0 +1,105 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xt_latency
+
+import (
+	"math/rand"
+	"time"
+
+	"ztna-core/ztna/controller/xt"
+	"ztna-core/ztna/controller/xt_common"
+)
+
+const (
+	Name = "latency-ewma"
+
+	// dialTimeWeight is the weight given to each new dial time in the moving average
+	dialTimeWeight = 0.3
+)
+
+/**
+The latency-ewma strategy keeps an exponentially weighted moving average of how long dials to each terminator take
+and selects terminators randomly, in inverse proportion to their average. Each unit of failure cost equal to a dial
+failure doubles the effective latency of a terminator. Terminators without samples are treated as being as fast as
+the fastest sampled terminator, so new terminators get traffic and are measured.
+*/
+
+func NewFactory() xt.Factory {
+	return &factory{}
+}
+
+type factory struct{}
+
+func (self *factory) GetStrategyName() string {
+	return Name
+}
+
+func (self *factory) NewStrategy() xt.Strategy {
+	strategy := &strategy{
+		CostVisitor: *xt_common.NewCostVisitor(2, 20, 2),
+	}
+	strategy.CostVisitor.DialTimeWeight = dialTimeWeight
+	strategy.CostVisitor.CreditOverTime(5, time.Minute)
+	return strategy
+}
+
+type strategy struct {
+	xt_common.CostVisitor
+}
+
+func (self *strategy) Select(_ xt.CreateCircuitParams, terminators []xt.CostedTerminator) (xt.CostedTerminator, xt.PeerData, error) {
+	terminators = xt.GetRelatedTerminators(terminators)
+	if len(terminators) == 1 {
+		return terminators[0], nil, nil
+	}
+
+	latencies := make([]float64, len(terminators))
+	fastest := float64(0)
+	for idx, t := range terminators {
+		latency := float64(self.GetDialTimeEwma(t.GetId()))
+		if latency > 0 && (fastest == 0 || latency < fastest) {
+			fastest = latency
+		}
+		latencies[idx] = latency
+	}
+
+	if fastest == 0 {
+		fastest = float64(time.Millisecond)
+	}
+
+	weights := make([]float64, len(terminators))
+	totalWeight := float64(0)
+	for idx, t := range terminators {
+		latency := latencies[idx]
+		if latency == 0 {
+			latency = fastest
+		}
+		latency *= 1 + float64(self.GetFailureCost(t.GetId()))/float64(self.FailureCost)
+		weights[idx] = 1 / latency
+		totalWeight += weights[idx]
+	}
+
+	selected := rand.Float64() * totalWeight
+	for idx, weight := range weights {
+		selected -= weight
+		if selected < 0 {
+			return terminators[idx], nil, nil
+		}
+	}
+
+	return terminators[len(terminators)-1], nil, nil
+}
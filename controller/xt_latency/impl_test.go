@@ -0,0 +1,148 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xt_latency
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"ztna-core/ztna/controller/xt"
+)
+
+type mockTerminator struct {
+	id         string
+	precedence xt.Precedence
+}
+
+func (m mockTerminator) GetId() string { return m.id }
+func (m mockTerminator) GetPrecedence() xt.Precedence {
+	if m.precedence == nil {
+		return xt.Precedences.Default
+	}
+	return m.precedence
+}
+func (m mockTerminator) GetCost() uint16          { return 0 }
+func (m mockTerminator) GetServiceId() string     { return "service" }
+func (m mockTerminator) GetInstanceId() string    { return "" }
+func (m mockTerminator) GetRouterId() string      { return "router" }
+func (m mockTerminator) GetBinding() string       { return "transport" }
+func (m mockTerminator) GetAddress() string       { return "" }
+func (m mockTerminator) GetPeerData() xt.PeerData { return nil }
+func (m mockTerminator) GetCreatedAt() time.Time  { return time.Time{} }
+func (m mockTerminator) GetHostId() string        { return "" }
+func (m mockTerminator) GetSourceCtrl() string    { return "" }
+func (m mockTerminator) GetRouteCost() uint32     { return 0 }
+
+func newTestStrategy() *strategy {
+	return NewFactory().NewStrategy().(*strategy)
+}
+
+func countSelections(t *testing.T, s *strategy, terminators []xt.CostedTerminator, rounds int) map[string]int {
+	result := map[string]int{}
+	for i := 0; i < rounds; i++ {
+		selected, _, err := s.Select(nil, terminators)
+		require.NoError(t, err)
+		result[selected.GetId()]++
+	}
+	return result
+}
+
+func TestDialTimeEwma(t *testing.T) {
+	req := require.New(t)
+
+	s := newTestStrategy()
+	a := mockTerminator{id: "a"}
+
+	req.Equal(time.Duration(0), s.GetDialTimeEwma("a"))
+
+	// the first sample seeds the average
+	s.NotifyEvent(xt.NewDialSucceededAfter(a, 100*time.Millisecond))
+	req.Equal(100*time.Millisecond, s.GetDialTimeEwma("a"))
+
+	// 0.3 * 200ms + 0.7 * 100ms
+	s.NotifyEvent(xt.NewDialSucceededAfter(a, 200*time.Millisecond))
+	req.Equal(130*time.Millisecond, s.GetDialTimeEwma("a"))
+
+	// dials without a known dial time don't change the average
+	s.NotifyEvent(xt.NewDialSucceeded(a))
+	req.Equal(130*time.Millisecond, s.GetDialTimeEwma("a"))
+
+	// 0.3 * 30ms + 0.7 * 130ms
+	s.NotifyEvent(xt.NewDialSucceededAfter(a, 30*time.Millisecond))
+	req.Equal(100*time.Millisecond, s.GetDialTimeEwma("a"))
+}
+
+func TestSelectsInverseToLatency(t *testing.T) {
+	req := require.New(t)
+
+	s := newTestStrategy()
+	a, b := mockTerminator{id: "a"}, mockTerminator{id: "b"}
+	terminators := []xt.CostedTerminator{b, a}
+
+	s.NotifyEvent(xt.NewDialSucceededAfter(a, 10*time.Millisecond))
+	s.NotifyEvent(xt.NewDialSucceededAfter(b, 100*time.Millisecond))
+
+	// a is ten times faster, so should get about 10 of every 11 circuits
+	counts := countSelections(t, s, terminators, 1000)
+	req.Greater(counts["a"], 800)
+	req.Greater(counts["b"], 0)
+
+	// each failure adds a's base latency again, taking its effective latency to 210ms
+	for i := 0; i < 20; i++ {
+		s.NotifyEvent(xt.NewDialFailedEvent(a))
+	}
+	counts = countSelections(t, s, terminators, 1000)
+	req.Greater(counts["b"], counts["a"])
+}
+
+func TestUnsampledTerminatorsUseFastestLatency(t *testing.T) {
+	req := require.New(t)
+
+	s := newTestStrategy()
+	a, b, c := mockTerminator{id: "a"}, mockTerminator{id: "b"}, mockTerminator{id: "c"}
+	terminators := []xt.CostedTerminator{a, b, c}
+
+	// with no samples at all, terminators are selected evenly
+	counts := countSelections(t, s, terminators, 3000)
+	for _, id := range []string{"a", "b", "c"} {
+		req.Greater(counts[id], 800, id)
+	}
+
+	// c has no samples, so is treated as being as fast as a
+	s.NotifyEvent(xt.NewDialSucceededAfter(a, 10*time.Millisecond))
+	s.NotifyEvent(xt.NewDialSucceededAfter(b, 100*time.Millisecond))
+
+	counts = countSelections(t, s, terminators, 2000)
+	req.Greater(counts["a"], 4*counts["b"])
+	req.Greater(counts["c"], 4*counts["b"])
+}
+
+func TestSelectsOnlyFromHighestPrecedence(t *testing.T) {
+	req := require.New(t)
+
+	s := newTestStrategy()
+	a := mockTerminator{id: "a", precedence: xt.Precedences.Required}
+	b := mockTerminator{id: "b"}
+	terminators := []xt.CostedTerminator{a, b}
+
+	s.NotifyEvent(xt.NewDialSucceededAfter(a, time.Second))
+	s.NotifyEvent(xt.NewDialSucceededAfter(b, time.Millisecond))
+
+	counts := countSelections(t, s, terminators, 100)
+	req.Equal(100, counts["a"])
+}
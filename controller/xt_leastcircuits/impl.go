@@ -0,0 +1,84 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xt_leastcircuits
+
+import (
+	"math"
+	"math/rand"
+	"time"
+
+	"ztna-core/ztna/controller/xt"
+	"ztna-core/ztna/controller/xt_common"
+)
+
+const (
+	Name = "least-circuits"
+)
+
+/**
+The least-circuits strategy selects the terminator with the fewest active circuits. Circuits are counted when a dial
+succeeds and released when the circuit is removed. Ties are broken by failure cost, then randomly, so that bursts of
+dials are spread across equally loaded terminators.
+*/
+
+func NewFactory() xt.Factory {
+	return &factory{}
+}
+
+type factory struct{}
+
+func (self *factory) GetStrategyName() string {
+	return Name
+}
+
+func (self *factory) NewStrategy() xt.Strategy {
+	strategy := &strategy{
+		CostVisitor: *xt_common.NewCostVisitor(2, 20, 2),
+	}
+	strategy.CostVisitor.CreditOverTime(5, time.Minute)
+	return strategy
+}
+
+type strategy struct {
+	xt_common.CostVisitor
+}
+
+func (self *strategy) Select(_ xt.CreateCircuitParams, terminators []xt.CostedTerminator) (xt.CostedTerminator, xt.PeerData, error) {
+	terminators = xt.GetRelatedTerminators(terminators)
+	if len(terminators) == 1 {
+		return terminators[0], nil, nil
+	}
+
+	var candidates []xt.CostedTerminator
+	minCircuits := uint32(math.MaxUint32)
+	minFailureCost := uint32(math.MaxUint32)
+
+	for _, t := range terminators {
+		circuits := self.GetCircuitCount(t.GetId())
+		failureCost := self.GetFailureCost(t.GetId())
+
+		if circuits < minCircuits || (circuits == minCircuits && failureCost < minFailureCost) {
+			minCircuits = circuits
+			minFailureCost = failureCost
+			candidates = append(candidates[:0], t)
+		} else if circuits == minCircuits && failureCost == minFailureCost {
+			candidates = append(candidates, t)
+		}
+	}
+
+	return candidates[rand.Intn(len(candidates))], nil, nil
+}
@@ -0,0 +1,82 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xt_leastcircuits
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"ztna-core/ztna/controller/xt"
+)
+
+type mockTerminator struct {
+	id string
+}
+
+func (m mockTerminator) GetId() string                { return m.id }
+func (m mockTerminator) GetPrecedence() xt.Precedence { return xt.Precedences.Default }
+func (m mockTerminator) GetCost() uint16              { return 0 }
+func (m mockTerminator) GetServiceId() string         { return "service" }
+func (m mockTerminator) GetInstanceId() string        { return "" }
+func (m mockTerminator) GetRouterId() string          { return "router" }
+func (m mockTerminator) GetBinding() string           { return "transport" }
+func (m mockTerminator) GetAddress() string           { return "" }
+func (m mockTerminator) GetPeerData() xt.PeerData     { return nil }
+func (m mockTerminator) GetCreatedAt() time.Time      { return time.Time{} }
+func (m mockTerminator) GetHostId() string            { return "" }
+func (m mockTerminator) GetSourceCtrl() string        { return "" }
+func (m mockTerminator) GetRouteCost() uint32         { return 0 }
+
+func TestSelectsLeastCircuits(t *testing.T) {
+	req := require.New(t)
+
+	s := NewFactory().NewStrategy()
+	a, b, c := mockTerminator{id: "a"}, mockTerminator{id: "b"}, mockTerminator{id: "c"}
+	terminators := []xt.CostedTerminator{a, b, c}
+
+	for i := 0; i < 3; i++ {
+		s.NotifyEvent(xt.NewDialSucceeded(a))
+		s.NotifyEvent(xt.NewDialSucceeded(b))
+	}
+	s.NotifyEvent(xt.NewDialSucceeded(c))
+
+	selected, _, err := s.Select(nil, terminators)
+	req.NoError(err)
+	req.Equal("c", selected.GetId())
+
+	for i := 0; i < 3; i++ {
+		s.NotifyEvent(xt.NewCircuitRemoved(a))
+	}
+
+	selected, _, err = s.Select(nil, terminators)
+	req.NoError(err)
+	req.Equal("a", selected.GetId())
+
+	// equal circuit counts, b has failures, so a or c must be picked
+	s.NotifyEvent(xt.NewDialSucceeded(a))
+	s.NotifyEvent(xt.NewDialFailedEvent(b))
+	for i := 0; i < 3; i++ {
+		s.NotifyEvent(xt.NewCircuitRemoved(b))
+	}
+	s.NotifyEvent(xt.NewDialSucceeded(b))
+	for i := 0; i < 20; i++ {
+		selected, _, err = s.Select(nil, terminators)
+		req.NoError(err)
+		req.NotEqual("b", selected.GetId())
+	}
+}
@@ -256,6 +256,11 @@ func NameFilter(name string) string {
 	return fmt.Sprintf("name = \"%s\"", name)
 }
 
+// TagFilter matches every entity with the given tag value, without a page limit
+func TagFilter(key, value string) string {
+	return fmt.Sprintf("tags.%s = \"%s\" limit none", key, value)
+}
+
 func NewClient() (*rest_management_api_client.ZitiEdgeManagement, error) {
 	cachedCreds, _, loadErr := util.LoadRestClientConfig()
 	if loadErr != nil {
@@ -148,6 +148,13 @@ func (forwarder *Forwarder) Route(ctrlId string, route *ctrl_pb.Route) error {
 		}
 		circuitFt.setForwardAddress(xgress.Address(forward.SrcAddress), xgress.Address(forward.DstAddress))
 	}
+	if priorityName, found := route.Tags[xgress.PriorityTag]; found {
+		priority, err := xgress.ParsePriorityClass(priorityName)
+		if err != nil {
+			pfxlog.Logger().WithField("circuitId", circuitId).WithError(err).Warn("invalid circuit priority, using default")
+		}
+		circuitFt.setPriority(priority)
+	}
 	forwarder.circuits.setForwardTable(circuitId, circuitFt)
 	return nil
 }
@@ -186,6 +193,7 @@ func (forwarder *Forwarder) forwardPayload(srcAddr xgress.Address, payload *xgre
 				} else if timeout == 0 {
 					payloadType = xgress.PayloadTypeFwd
 				}
+				payload.Priority = forwardTable.getPriority()
 				if err := dst.SendPayload(payload, timeout, payloadType); err != nil {
 					return err
 				}
@@ -334,6 +342,7 @@ func (forwarder *Forwarder) InspectCircuit(circuitId string, getRelatedGoroutine
 			XgressDetails: map[string]*inspect.XgressDetail{},
 			LinkDetails:   map[string]*inspect.LinkInspectDetail{},
 		}
+		result.Priority = ft.getPriority().String()
 		result.SetIncludeGoroutines(getRelatedGoroutines)
 
 		ft.destinations.IterCb(func(key string, dest string) {
@@ -69,6 +69,7 @@ func (st *circuitTable) debug() string {
 type forwardTable struct {
 	ctrlId       string
 	last         int64
+	priority     atomic.Uint32
 	destinations cmap.ConcurrentMap[string, string]
 }
 
@@ -90,6 +91,14 @@ func (ft *forwardTable) getForwardAddress(src xgress.Address) (xgress.Address, b
 	return "", false
 }
 
+func (ft *forwardTable) setPriority(priority xgress.PriorityClass) {
+	ft.priority.Store(uint32(priority))
+}
+
+func (ft *forwardTable) getPriority() xgress.PriorityClass {
+	return xgress.PriorityClass(ft.priority.Load())
+}
+
 func (ft *forwardTable) debug() string {
 	out := ""
 	for i := range ft.destinations.IterBuffered() {
@@ -145,6 +145,11 @@ func NewXgressPeekHandler(registry metrics.UsageRegistry) xgress.PeekHandler {
 	egressTxMsgSizeHistogram := registry.Histogram("egress.tx.msgsize")
 	egressRxMsgSizeHistogram := registry.Histogram("egress.rx.msgsize")
 
+	shapingIngressThrottledMeter := registry.Meter("shaping.ingress.throttled")
+	shapingIngressDelayTimer := registry.Timer("shaping.ingress.delay")
+	shapingEgressThrottledMeter := registry.Meter("shaping.egress.throttled")
+	shapingEgressDelayTimer := registry.Timer("shaping.egress.delay")
+
 	return &xgressPeekHandler{
 		ingressTxBytesMeter: ingressTxBytesMeter,
 		ingressTxMsgMeter:   ingressTxMsgMeter,
@@ -160,6 +165,11 @@ func NewXgressPeekHandler(registry metrics.UsageRegistry) xgress.PeekHandler {
 		egressTxMsgSizeHistogram:  egressTxMsgSizeHistogram,
 		egressRxMsgSizeHistogram:  egressRxMsgSizeHistogram,
 
+		shapingIngressThrottledMeter: shapingIngressThrottledMeter,
+		shapingIngressDelayTimer:     shapingIngressDelayTimer,
+		shapingEgressThrottledMeter:  shapingEgressThrottledMeter,
+		shapingEgressDelayTimer:      shapingEgressDelayTimer,
+
 		usageCounter: registry.UsageCounter("usage", env.IntervalSize),
 	}
 }
@@ -189,6 +199,11 @@ type xgressPeekHandler struct {
 	egressTxMsgSizeHistogram  metrics.Histogram
 	egressRxMsgSizeHistogram  metrics.Histogram
 
+	shapingIngressThrottledMeter metrics.Meter
+	shapingIngressDelayTimer     metrics.Timer
+	shapingEgressThrottledMeter  metrics.Meter
+	shapingEgressDelayTimer      metrics.Timer
+
 	usageCounter metrics.UsageCounter
 }
 
@@ -225,3 +240,16 @@ func (handler *xgressPeekHandler) Tx(x *xgress.Xgress, payload *xgress.Payload)
 
 func (handler *xgressPeekHandler) Close(*xgress.Xgress) {
 }
+
+// Throttled tracks how much data bandwidth shaping delayed, and for how long, both overall and per circuit
+func (handler *xgressPeekHandler) Throttled(x *xgress.Xgress, direction xgress.ShapingDirection, size int, delay time.Duration) {
+	if direction == xgress.ShapingIngress {
+		handler.usageCounter.Update(x, "shaping.ingress.throttled", time.Now(), uint64(size))
+		handler.shapingIngressThrottledMeter.Mark(int64(size))
+		handler.shapingIngressDelayTimer.Update(delay)
+	} else {
+		handler.usageCounter.Update(x, "shaping.egress.throttled", time.Now(), uint64(size))
+		handler.shapingEgressThrottledMeter.Mark(int64(size))
+		handler.shapingEgressDelayTimer.Update(delay)
+	}
+}
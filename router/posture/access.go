@@ -3,6 +3,7 @@ package posture
 import (
 	"fmt"
 	"github.com/michaelquigley/pfxlog"
+	"time"
 	"ztna-core/ztna/common"
 	"ztna-core/ztna/common/eid"
 	"ztna-core/ztna/common/pb/edge_ctrl_pb"
@@ -43,6 +44,12 @@ func IsPassing(accessPolicies *common.AccessPolicies, cache *Cache) (*common.Ser
 			Errors: []error{},
 		}
 
+		if !policy.IsActive(time.Now()) {
+			policyErr.Errors = append(policyErr.Errors, &OutsideScheduleError{})
+			*errs = append(*errs, policyErr)
+			continue
+		}
+
 		policy.PostureChecks.IterCb(func(postureCheckId string, _ struct{}) {
 			postureCheck, ok := accessPolicies.PostureChecks[postureCheckId]
 
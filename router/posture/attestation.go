@@ -0,0 +1,43 @@
+package posture
+
+import (
+	"time"
+	"ztna-core/ztna/common/attestation"
+	"ztna-core/ztna/common/pb/edge_ctrl_pb"
+)
+
+// AttestationCheck verifies the last statement the client submitted against the pinned keys and requirements. If no
+// statement has been submitted the check fails.
+type AttestationCheck struct {
+	*edge_ctrl_pb.DataState_PostureCheck
+	*edge_ctrl_pb.DataState_PostureCheck_Attestation
+}
+
+func (m *AttestationCheck) Evaluate(state *Cache) *CheckError {
+	if state == nil {
+		return &CheckError{
+			Id:    m.Id,
+			Name:  m.Name,
+			Cause: NilStateError,
+		}
+	}
+
+	check := &attestation.Check{
+		PublicKeys:            m.PublicKeys,
+		MaxAgeSeconds:         m.MaxAgeSeconds,
+		RequireDiskEncryption: m.RequireDiskEncryption,
+		RequireFirewall:       m.RequireFirewall,
+		EdrAgent:              m.EdrAgent,
+		MinEdrVersion:         m.MinEdrVersion,
+	}
+
+	if _, err := check.Verify(state.Attestation, state.IdentityId, time.Now()); err != nil {
+		return &CheckError{
+			Id:    m.Id,
+			Name:  m.Name,
+			Cause: err,
+		}
+	}
+
+	return nil
+}
@@ -1,7 +1,9 @@
 package posture
 
 import (
+	"net"
 	"ztna-core/sdk-golang/pb/edge_client_pb"
+	"ztna-core/ztna/common/geoip"
 	"ztna-core/ztna/common/pb/edge_ctrl_pb"
 	"time"
 )
@@ -14,6 +16,15 @@ type Cache struct {
 	Woken       *edge_client_pb.PostureResponse_Woken
 	ProcessList *edge_client_pb.PostureResponse_ProcessList
 	PassedMfaAt *time.Time
+
+	// SourceIp is the remote address of the client's edge connection. GeoIp resolves its country, and is nil if the
+	// router has no geoip database
+	SourceIp net.IP
+	GeoIp    geoip.CountryLookup
+
+	// Attestation is the last signed statement submitted by the client for the identity IdentityId
+	Attestation string
+	IdentityId  string
 }
 
 type Check interface {
@@ -62,6 +73,16 @@ func CtrlCheckToLogic(postureCheck *edge_ctrl_pb.DataState_PostureCheck) Check {
 			DataState_PostureCheck:     postureCheck,
 			DataState_PostureCheck_Mfa: subCheck.Mfa,
 		}
+	case *edge_ctrl_pb.DataState_PostureCheck_SourceIp_:
+		return &SourceIpCheck{
+			DataState_PostureCheck:          postureCheck,
+			DataState_PostureCheck_SourceIp: subCheck.SourceIp,
+		}
+	case *edge_ctrl_pb.DataState_PostureCheck_Attestation_:
+		return &AttestationCheck{
+			DataState_PostureCheck:             postureCheck,
+			DataState_PostureCheck_Attestation: subCheck.Attestation,
+		}
 	}
 
 	return nil
@@ -23,6 +23,13 @@ func (e *NoPoliciesError) Error() string {
 	return "no policies provide access"
 }
 
+type OutsideScheduleError struct {
+}
+
+func (e *OutsideScheduleError) Error() string {
+	return "policy is outside of its schedule"
+}
+
 type PolicyAccessError struct {
 	Id     string
 	Name   string
@@ -0,0 +1,40 @@
+package posture
+
+import (
+	"ztna-core/ztna/common/geoip"
+	"ztna-core/ztna/common/pb/edge_ctrl_pb"
+)
+
+// SourceIpCheck evaluates the address of the client's edge connection. Countries are resolved with the router's
+// geoip database, if one is configured. If the address isn't known the check fails.
+type SourceIpCheck struct {
+	*edge_ctrl_pb.DataState_PostureCheck
+	*edge_ctrl_pb.DataState_PostureCheck_SourceIp
+}
+
+func (m *SourceIpCheck) Evaluate(state *Cache) *CheckError {
+	if state == nil {
+		return &CheckError{
+			Id:    m.Id,
+			Name:  m.Name,
+			Cause: NilStateError,
+		}
+	}
+
+	rules := &geoip.Rules{
+		AllowedCidrs:     m.AllowedCidrs,
+		DeniedCidrs:      m.DeniedCidrs,
+		AllowedCountries: m.AllowedCountries,
+		DeniedCountries:  m.DeniedCountries,
+	}
+
+	if err := rules.EvaluateWithLookup(state.SourceIp, state.GeoIp); err != nil {
+		return &CheckError{
+			Id:    m.Id,
+			Name:  m.Name,
+			Cause: err,
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,269 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress
+
+import (
+	"encoding/binary"
+	"sync"
+	"sync/atomic"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/michaelquigley/pfxlog"
+	"github.com/pierrec/lz4/v4"
+	"github.com/pkg/errors"
+)
+
+type CompressionAlgorithm uint8
+
+const (
+	CompressionNone CompressionAlgorithm = 0
+	CompressionZstd CompressionAlgorithm = 1
+	CompressionLz4  CompressionAlgorithm = 2
+
+	// CompressionTag is the circuit tag selecting the compression algorithm. The controller sets it from the
+	// compression tag on the service.
+	CompressionTag = "compression"
+
+	// compressionMinSize is the smallest payload worth compressing
+	compressionMinSize = 256
+
+	// maxDecompressedSize bounds the memory a single compressed payload may expand to
+	maxDecompressedSize = 4 * 1024 * 1024
+
+	// after compressionSkipThreshold payloads in a row don't compress, only every compressionProbeInterval'th
+	// payload is tried, so streams of already compressed or encrypted data don't pay for compression
+	compressionSkipThreshold = 8
+	compressionProbeInterval = 64
+)
+
+var compressionAlgorithmNames = map[CompressionAlgorithm]string{
+	CompressionNone: "none",
+	CompressionZstd: "zstd",
+	CompressionLz4:  "lz4",
+}
+
+// zstdCodec is shared by all circuits. EncodeAll and DecodeAll may be called concurrently, each using one of a set of
+// internal encoders or decoders, sized to GOMAXPROCS.
+var zstdCodec struct {
+	once    sync.Once
+	encoder *zstd.Encoder
+	decoder *zstd.Decoder
+	err     error
+}
+
+func getZstdCodec() (*zstd.Encoder, *zstd.Decoder, error) {
+	zstdCodec.once.Do(func() {
+		zstdCodec.encoder, zstdCodec.err = zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedFastest), zstd.WithLowerEncoderMem(true))
+		if zstdCodec.err != nil {
+			zstdCodec.err = errors.Wrap(zstdCodec.err, "unable to create zstd encoder")
+			return
+		}
+		zstdCodec.decoder, zstdCodec.err = zstd.NewReader(nil, zstd.WithDecoderConcurrency(0), zstd.WithDecodeAllCapLimit(true), zstd.WithDecoderMaxMemory(maxDecompressedSize))
+		if zstdCodec.err != nil {
+			zstdCodec.err = errors.Wrap(zstdCodec.err, "unable to create zstd decoder")
+		}
+	})
+	return zstdCodec.encoder, zstdCodec.decoder, zstdCodec.err
+}
+
+func (self CompressionAlgorithm) String() string {
+	if name, found := compressionAlgorithmNames[self]; found {
+		return name
+	}
+	return "unknown"
+}
+
+// capability returns the circuit capability flag indicating the algorithm can be decompressed
+func (self CompressionAlgorithm) capability() uint32 {
+	switch self {
+	case CompressionZstd:
+		return CircuitCapabilityZstd
+	case CompressionLz4:
+		return CircuitCapabilityLz4
+	}
+	return 0
+}
+
+func ParseCompressionAlgorithm(name string) (CompressionAlgorithm, error) {
+	if name == "" {
+		return CompressionNone, nil
+	}
+	for algorithm, algorithmName := range compressionAlgorithmNames {
+		if algorithmName == name {
+			return algorithm, nil
+		}
+	}
+	return CompressionNone, errors.Errorf("unknown compression algorithm '%s', valid values are none, zstd and lz4", name)
+}
+
+// compress returns the compressed data, prefixed with the uncompressed length, or nil if the data didn't get smaller
+func (self CompressionAlgorithm) compress(data []byte) []byte {
+	switch self {
+	case CompressionZstd:
+		encoder, _, err := getZstdCodec()
+		if err != nil {
+			pfxlog.Logger().WithError(err).Error("zstd unavailable, payload won't be compressed")
+			return nil
+		}
+		result := binary.AppendUvarint(make([]byte, 0, len(data)), uint64(len(data)))
+		result = encoder.EncodeAll(data, result)
+		if len(result) >= len(data) {
+			return nil
+		}
+		return result
+	case CompressionLz4:
+		result := binary.AppendUvarint(make([]byte, 0, binary.MaxVarintLen64+lz4.CompressBlockBound(len(data))), uint64(len(data)))
+		offset := len(result)
+		n, err := lz4.CompressBlock(data, result[offset:cap(result)], nil)
+		if err != nil || n == 0 || offset+n >= len(data) {
+			return nil
+		}
+		return result[:offset+n]
+	}
+	return nil
+}
+
+func (self CompressionAlgorithm) decompress(data []byte) ([]byte, error) {
+	size, offset := binary.Uvarint(data)
+	if offset <= 0 {
+		return nil, errors.New("invalid compressed payload length")
+	}
+	if size > maxDecompressedSize {
+		return nil, errors.Errorf("compressed payload length %d exceeds maximum of %d", size, maxDecompressedSize)
+	}
+
+	data = data[offset:]
+	result := make([]byte, size)
+	var n int
+
+	switch self {
+	case CompressionZstd:
+		_, decoder, err := getZstdCodec()
+		if err != nil {
+			return nil, err
+		}
+		decoded, err := decoder.DecodeAll(data, result[:0])
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to decompress zstd payload")
+		}
+		n = len(decoded)
+	case CompressionLz4:
+		var err error
+		if n, err = lz4.UncompressBlock(data, result); err != nil {
+			return nil, errors.Wrap(err, "unable to decompress lz4 payload")
+		}
+	default:
+		return nil, errors.Errorf("unsupported compression algorithm %d", self)
+	}
+
+	if uint64(n) != size {
+		return nil, errors.Errorf("decompressed payload length %d doesn't match expected length %d", n, size)
+	}
+	return result, nil
+}
+
+// payloadCompressor compresses the data an xgress sends into the network, once the peer has shown it can decompress it
+type payloadCompressor struct {
+	algorithm      CompressionAlgorithm
+	disabled       atomic.Bool
+	incompressible uint32
+	skipped        uint32
+}
+
+// newPayloadCompressor returns a compressor for the algorithm selected by the circuit tags, or nil if the circuit
+// isn't compressed. Circuits chunked to an MTU aren't compressed, so compressed payloads are never split into chunks.
+func newPayloadCompressor(tags map[string]string, options *Options) *payloadCompressor {
+	algorithm, err := ParseCompressionAlgorithm(tags[CompressionTag])
+	if err != nil {
+		pfxlog.Logger().WithError(err).Warn("invalid compression circuit tag, circuit won't be compressed")
+	}
+	if algorithm == CompressionNone {
+		return nil
+	}
+	if options.Mtu > 0 {
+		pfxlog.Logger().Warnf("compression %v requested, but isn't supported with an mtu of %d, circuit won't be compressed", algorithm, options.Mtu)
+		return nil
+	}
+	return &payloadCompressor{algorithm: algorithm}
+}
+
+// compress returns the data to send and the headers to send it with. It's only called from the rx goroutine.
+func (self *payloadCompressor) compress(data []byte, headers map[uint8][]byte, peerCapabilities uint32) ([]byte, map[uint8][]byte) {
+	if len(data) < compressionMinSize || self.disabled.Load() || peerCapabilities&self.algorithm.capability() == 0 {
+		return data, headers
+	}
+
+	if self.incompressible >= compressionSkipThreshold {
+		self.skipped++
+		if self.skipped%compressionProbeInterval != 0 {
+			return data, headers
+		}
+	}
+
+	compressed := self.algorithm.compress(data)
+	if compressed == nil {
+		self.incompressible++
+		compressionSkippedMeter.Mark(1)
+		return data, headers
+	}
+
+	self.incompressible = 0
+	self.skipped = 0
+	compressionInputMeter.Mark(int64(len(data)))
+	compressionOutputMeter.Mark(int64(len(compressed)))
+	compressionRatioHistogram.Update(int64(len(compressed) * 100 / len(data)))
+
+	result := make(map[uint8][]byte, len(headers)+1)
+	for k, v := range headers {
+		result[k] = v
+	}
+	result[HeaderKeyCompression] = []byte{byte(self.algorithm)}
+	return compressed, result
+}
+
+// DisableCompression stops the xgress compressing the data it sends, for example because the data is end-to-end
+// encrypted and won't compress
+func (self *Xgress) DisableCompression() {
+	if self.compressor != nil {
+		self.compressor.disabled.Store(true)
+	}
+}
+
+// decompressPayload returns the payload data and headers to write to the peer, decompressing the data if needed.
+// The payload itself is left as is, since the receive buffer accounting relies on its size.
+func decompressPayload(payload *Payload) ([]byte, map[uint8][]byte, error) {
+	val, found := payload.Headers[HeaderKeyCompression]
+	if !found {
+		return payload.Data, payload.Headers, nil
+	}
+	if len(val) != 1 {
+		return nil, nil, errors.New("invalid compression header")
+	}
+
+	data, err := CompressionAlgorithm(val[0]).decompress(payload.Data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	headers := make(map[uint8][]byte, len(payload.Headers)-1)
+	for k, v := range payload.Headers {
+		if k != HeaderKeyCompression {
+			headers[k] = v
+		}
+	}
+	return data, headers, nil
+}
@@ -0,0 +1,208 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io"
+	mathrand "math/rand"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openziti/channel/v3"
+	"github.com/openziti/metrics"
+	cmap "github.com/orcaman/concurrent-map/v2"
+	"github.com/stretchr/testify/require"
+	"ztna-core/ztna/controller/idgen"
+)
+
+func TestCompressionAlgorithm_roundTrip(t *testing.T) {
+	data := bytes.Repeat([]byte("some compressible log line\n"), 100)
+
+	for _, algorithm := range []CompressionAlgorithm{CompressionZstd, CompressionLz4} {
+		t.Run(algorithm.String(), func(t *testing.T) {
+			req := require.New(t)
+
+			compressed := algorithm.compress(data)
+			req.NotNil(compressed)
+			req.Less(len(compressed), len(data))
+
+			decompressed, err := algorithm.decompress(compressed)
+			req.NoError(err)
+			req.Equal(data, decompressed)
+
+			random := make([]byte, 1024)
+			_, err = rand.Read(random)
+			req.NoError(err)
+			req.Nil(algorithm.compress(random))
+		})
+	}
+}
+
+func TestPayloadCompressor(t *testing.T) {
+	req := require.New(t)
+	InitMetrics(metrics.NewRegistry("test", nil))
+
+	options := DefaultOptions()
+	compressor := newPayloadCompressor(map[string]string{CompressionTag: "lz4"}, options)
+	req.NotNil(compressor)
+	req.Nil(newPayloadCompressor(map[string]string{CompressionTag: "none"}, options))
+	req.Nil(newPayloadCompressor(map[string]string{CompressionTag: "invalid"}, options))
+
+	mtuOptions := DefaultOptions()
+	mtuOptions.Mtu = 1400
+	req.Nil(newPayloadCompressor(map[string]string{CompressionTag: "lz4"}, mtuOptions))
+
+	data := bytes.Repeat([]byte("abcd"), 1000)
+	headers := map[uint8][]byte{HeaderKeyUUID: []byte("uuid")}
+
+	// peer hasn't shown it can decompress lz4
+	result, resultHeaders := compressor.compress(data, headers, CircuitCapabilityZstd)
+	req.Equal(data, result)
+	req.Equal(headers, resultHeaders)
+
+	result, resultHeaders = compressor.compress(data, headers, CircuitCapabilityLz4)
+	req.Less(len(result), len(data))
+	req.Len(headers, 1)
+
+	payload := &Payload{Data: result, Headers: resultHeaders}
+	decompressed, decompressedHeaders, err := decompressPayload(payload)
+	req.NoError(err)
+	req.Equal(data, decompressed)
+	req.Equal(headers, decompressedHeaders)
+	req.Equal(result, payload.Data)
+
+	compressor.disabled.Store(true)
+	result, _ = compressor.compress(data, headers, CircuitCapabilityLz4)
+	req.Equal(data, result)
+}
+
+// testStreamConn reads data as a single payload and collects the data written to it
+type testStreamConn struct {
+	lock     sync.Mutex
+	data     []byte
+	expected int
+	received []byte
+	done     chan struct{}
+}
+
+func (self *testStreamConn) Close() error {
+	return nil
+}
+
+func (self *testStreamConn) LogContext() string {
+	return "test"
+}
+
+func (self *testStreamConn) ReadPayload() ([]byte, map[uint8][]byte, error) {
+	self.lock.Lock()
+	data := self.data
+	self.data = nil
+	self.lock.Unlock()
+
+	if data == nil {
+		<-self.done
+	}
+	return data, nil, io.EOF
+}
+
+func (self *testStreamConn) WritePayload(buf []byte, _ map[uint8][]byte) (int, error) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	self.received = append(self.received, buf...)
+	if len(self.received) == self.expected {
+		close(self.done)
+	}
+	return len(buf), nil
+}
+
+func (self *testStreamConn) HandleControlMsg(ControlType, channel.Headers, ControlReceiver) error {
+	return nil
+}
+
+func TestCompressionWithMtu(t *testing.T) {
+	metricsRegistry := metrics.NewRegistry("test", nil)
+	InitMetrics(metricsRegistry)
+
+	closeNotify := make(chan struct{})
+	defer close(closeNotify)
+
+	InitPayloadIngester(closeNotify)
+	InitRetransmitter(mockForwarder{}, mockFaulter{}, metricsRegistry, closeNotify)
+
+	ackHandler := &testAcker{
+		destinations: cmap.New[*Xgress](),
+	}
+	acker = ackHandler
+
+	for _, algorithm := range []CompressionAlgorithm{CompressionZstd, CompressionLz4} {
+		t.Run(algorithm.String(), func(t *testing.T) {
+			req := require.New(t)
+
+			options := DefaultOptions()
+			options.Mtu = 1400
+			tags := map[string]string{CompressionTag: algorithm.String()}
+
+			// compressible, but still larger than the mtu once compressed, so it would be chunked either way
+			words := []string{"alpha ", "bravo ", "charlie ", "delta ", "echo ", "foxtrot ", "golf ", "hotel "}
+			random := mathrand.New(mathrand.NewSource(1))
+			var data []byte
+			for len(data) < 64*1024 {
+				data = append(data, words[random.Intn(len(words))]...)
+			}
+			compressed := algorithm.compress(data)
+			req.NotNil(compressed)
+			req.Greater(len(compressed), int(options.Mtu))
+
+			circuitId := idgen.New()
+			srcConn := &testStreamConn{data: data, done: make(chan struct{})}
+			dstConn := &testStreamConn{expected: len(data), done: make(chan struct{})}
+
+			srcXg := NewXgress(circuitId, "ctrl", "src", srcConn, Initiator, options, tags)
+			dstXg := NewXgress(circuitId, "ctrl", "dst", dstConn, Terminator, options, tags)
+			req.Nil(srcXg.compressor)
+			req.Nil(dstXg.compressor)
+
+			ackHandler.destinations.Set("src", dstXg)
+			ackHandler.destinations.Set("dst", srcXg)
+
+			// no circuit start payload is sent, so exchange capabilities directly
+			srcXg.setPeerCapabilities(dstXg.capabilities())
+			dstXg.setPeerCapabilities(srcXg.capabilities())
+
+			msgStrategy := channel.DatagramMessageStrategy(UnmarshallPacketPayload)
+			srcXg.receiveHandler = &testIntermediary{circuitId: circuitId, dest: dstXg, msgs: msgStrategy}
+			dstXg.receiveHandler = &testIntermediary{circuitId: circuitId, dest: srcXg, msgs: msgStrategy}
+
+			srcXg.Start()
+			dstXg.Start()
+
+			select {
+			case <-dstConn.done:
+			case <-time.After(5 * time.Second):
+				req.Fail("timed out waiting for data")
+			}
+
+			dstConn.lock.Lock()
+			defer dstConn.lock.Unlock()
+			req.Equal(data, dstConn.received)
+		})
+	}
+}
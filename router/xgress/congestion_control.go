@@ -0,0 +1,201 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"ztna-core/ztna/common/inspect"
+)
+
+const (
+	// CongestionControlPortal is the original AIMD style windowing, driven by the TxPortal* and Retx* options
+	CongestionControlPortal = "portal"
+	// CongestionControlBbr sizes the window from the estimated bottleneck bandwidth and minimum round trip time
+	CongestionControlBbr = "bbr"
+
+	// CongestionControlTag is the circuit tag which, if set, overrides the congestion control configured on the
+	// router. The controller copies it from the service tag of the same name
+	CongestionControlTag = "congestionControl"
+)
+
+// CongestionControl decides how much unacknowledged data a LinkSendBuffer may have outstanding and how long a
+// payload may go unacknowledged before being retransmitted. Implementations are only accessed from the send buffer's
+// run loop, so need not be thread-safe.
+type CongestionControl interface {
+	Name() string
+	// WindowSize returns the number of bytes which may be sent but not yet acknowledged
+	WindowSize() uint32
+	// RetxThreshold returns the number of milliseconds after which an unacknowledged payload is retransmitted
+	RetxThreshold() uint32
+	// Acked is called for each newly acknowledged payload. inFlight is the number of bytes still unacknowledged
+	Acked(size uint32, inFlight uint32, now int64)
+	DuplicateAck()
+	// RttSample is called with the smoothed round trip time, in milliseconds, whenever an ack carries an RTT
+	RttSample(rtt uint16, now int64)
+	Retransmitted(now int64)
+	// Inspect fills in the algorithm specific parts of the send buffer inspection
+	Inspect(detail *inspect.XgressSendBufferDetail)
+}
+
+type CongestionControlFactory func(options *Options) CongestionControl
+
+var congestionControls = map[string]CongestionControlFactory{
+	CongestionControlPortal: newPortalCongestionControl,
+	CongestionControlBbr:    newBbrCongestionControl,
+}
+var congestionControlsLock sync.RWMutex
+
+// RegisterCongestionControl makes a congestion control algorithm available for selection by name, either in the
+// xgress options or through the congestionControl circuit tag
+func RegisterCongestionControl(name string, factory CongestionControlFactory) {
+	congestionControlsLock.Lock()
+	defer congestionControlsLock.Unlock()
+	congestionControls[name] = factory
+}
+
+func getCongestionControlFactory(name string) (CongestionControlFactory, bool) {
+	congestionControlsLock.RLock()
+	defer congestionControlsLock.RUnlock()
+	factory, found := congestionControls[name]
+	return factory, found
+}
+
+func validateCongestionControl(name string) error {
+	if _, found := getCongestionControlFactory(name); !found {
+		congestionControlsLock.RLock()
+		defer congestionControlsLock.RUnlock()
+		var names []string
+		for k := range congestionControls {
+			names = append(names, k)
+		}
+		sort.Strings(names)
+		return fmt.Errorf("unknown congestion control '%s', valid values: %v", name, names)
+	}
+	return nil
+}
+
+// newCongestionControl creates the congestion control for the given xgress. The circuit tag takes precedence over
+// the configured options, and falls back to them if it names an unknown algorithm
+func newCongestionControl(x *Xgress) (CongestionControl, error) {
+	var err error
+	if name, found := x.tags[CongestionControlTag]; found && name != "" {
+		if factory, found := getCongestionControlFactory(name); found {
+			return factory(x.Options), nil
+		}
+		err = fmt.Errorf("unknown congestion control '%s' requested by circuit tag", name)
+	}
+
+	if factory, found := getCongestionControlFactory(x.Options.CongestionControl); found {
+		return factory(x.Options), err
+	}
+	return newPortalCongestionControl(x.Options), err
+}
+
+type portalCongestionControl struct {
+	options        *Options
+	windowSize     uint32
+	accumulator    uint32
+	successfulAcks uint32
+	duplicateAcks  uint32
+	retransmits    uint32
+	retxScale      float64
+	retxThreshold  uint32
+}
+
+func newPortalCongestionControl(options *Options) CongestionControl {
+	return &portalCongestionControl{
+		options:       options,
+		windowSize:    options.TxPortalStartSize,
+		retxThreshold: options.RetxStartMs,
+		retxScale:     options.RetxScale,
+	}
+}
+
+func (self *portalCongestionControl) Name() string {
+	return CongestionControlPortal
+}
+
+func (self *portalCongestionControl) WindowSize() uint32 {
+	return self.windowSize
+}
+
+func (self *portalCongestionControl) RetxThreshold() uint32 {
+	return self.retxThreshold
+}
+
+func (self *portalCongestionControl) Acked(size uint32, _ uint32, _ int64) {
+	self.accumulator += size
+	self.successfulAcks++
+
+	if self.successfulAcks >= self.options.TxPortalIncreaseThresh {
+		self.successfulAcks = 0
+		delta := uint32(float64(self.accumulator) * self.options.TxPortalIncreaseScale)
+		self.windowSize += delta
+		if self.windowSize > self.options.TxPortalMaxSize {
+			self.windowSize = self.options.TxPortalMaxSize
+		}
+		self.retxScale -= 0.01
+		if self.retxScale < self.options.RetxScale {
+			self.retxScale = self.options.RetxScale
+		}
+	}
+}
+
+func (self *portalCongestionControl) DuplicateAck() {
+	self.duplicateAcks++
+	if self.duplicateAcks >= self.options.TxPortalDupAckThresh {
+		self.duplicateAcks = 0
+		self.retxScale += 0.2
+	}
+}
+
+func (self *portalCongestionControl) RttSample(rtt uint16, _ int64) {
+	self.retxThreshold = uint32(float64(rtt)*self.retxScale) + self.options.RetxAddMs
+}
+
+func (self *portalCongestionControl) Retransmitted(_ int64) {
+	self.retransmits++
+	if self.retransmits >= self.options.TxPortalRetxThresh {
+		self.accumulator = 0
+		self.retransmits = 0
+		self.scale(self.options.TxPortalRetxScale)
+	}
+}
+
+func (self *portalCongestionControl) scale(factor float64) {
+	self.windowSize = uint32(float64(self.windowSize) * factor)
+	if factor > 1 {
+		if self.windowSize > self.options.TxPortalMaxSize {
+			self.windowSize = self.options.TxPortalMaxSize
+		}
+	} else if self.windowSize < self.options.TxPortalMinSize {
+		self.windowSize = self.options.TxPortalMinSize
+	}
+}
+
+func (self *portalCongestionControl) Inspect(detail *inspect.XgressSendBufferDetail) {
+	detail.WindowSize = self.windowSize
+	detail.Accumulator = self.accumulator
+	detail.SuccessfulAcks = self.successfulAcks
+	detail.DuplicateAcks = self.duplicateAcks
+	detail.Retransmits = self.retransmits
+	detail.RetxScale = self.retxScale
+	detail.RetxThreshold = self.retxThreshold
+}
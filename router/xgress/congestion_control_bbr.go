@@ -0,0 +1,249 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress
+
+import (
+	"ztna-core/ztna/common/inspect"
+)
+
+const (
+	BbrStateStartup  = "startup"
+	BbrStateDrain    = "drain"
+	BbrStateProbeBw  = "probeBw"
+	BbrStateProbeRtt = "probeRtt"
+)
+
+const (
+	// bbrHighGain is 2/ln(2), enough to double the delivery rate every round while in startup
+	bbrHighGain              = 2.885
+	bbrCwndGain              = 2.0
+	bbrBandwidthFilterRounds = 10
+	bbrFullBandwidthGrowth   = 1.25
+	bbrFullBandwidthRounds   = 3
+	bbrMinRoundMs            = 10
+	bbrMinRttWindowMs        = 10_000
+	bbrProbeRttMs            = 200
+)
+
+var bbrProbeBwGains = [...]float64{1.25, 0.75, 1, 1, 1, 1, 1, 1}
+
+// bbrCongestionControl is a window based adaptation of BBR. Rather than reacting to retransmits, it estimates the
+// bottleneck bandwidth, as the maximum delivery rate over the last few rounds, and the minimum round trip time, and
+// keeps the window at a multiple of their product. Since xgress doesn't pace, the phase gains are applied to the
+// window instead of the sending rate. TxPortalStartSize, TxPortalMinSize and TxPortalMaxSize still bound the window.
+type bbrCongestionControl struct {
+	options       *Options
+	state         string
+	gain          float64
+	windowSize    uint32
+	retxThreshold uint32
+	retransmits   uint32
+
+	rtt               uint16
+	minRtt            uint16
+	minRttStamp       int64
+	probeRttDoneStamp int64
+
+	round            uint64
+	roundStart       int64
+	roundDelivered   uint64
+	roundMaxInFlight uint32
+	bandwidthSamples [bbrBandwidthFilterRounds]uint64
+	bandwidth        uint64
+
+	fullBandwidth       uint64
+	fullBandwidthRounds int
+	cycleIndex          int
+}
+
+func newBbrCongestionControl(options *Options) CongestionControl {
+	return &bbrCongestionControl{
+		options:       options,
+		state:         BbrStateStartup,
+		gain:          bbrHighGain,
+		windowSize:    options.TxPortalStartSize,
+		retxThreshold: options.RetxStartMs,
+	}
+}
+
+func (self *bbrCongestionControl) Name() string {
+	return CongestionControlBbr
+}
+
+func (self *bbrCongestionControl) WindowSize() uint32 {
+	return self.windowSize
+}
+
+func (self *bbrCongestionControl) RetxThreshold() uint32 {
+	return self.retxThreshold
+}
+
+func (self *bbrCongestionControl) Acked(size uint32, inFlight uint32, now int64) {
+	if self.roundStart == 0 {
+		self.roundStart = now
+	}
+
+	self.roundDelivered += uint64(size)
+	if inFlight+size > self.roundMaxInFlight {
+		self.roundMaxInFlight = inFlight + size
+	}
+
+	if self.state == BbrStateProbeRtt && now >= self.probeRttDoneStamp {
+		self.exitProbeRtt(now)
+	} else if self.state != BbrStateProbeRtt && self.minRttStamp != 0 && now-self.minRttStamp > bbrMinRttWindowMs {
+		self.enterProbeRtt(now)
+	}
+
+	if elapsed := now - self.roundStart; elapsed >= int64(self.roundLength()) {
+		self.endRound(elapsed, inFlight, now)
+	}
+}
+
+func (self *bbrCongestionControl) roundLength() uint32 {
+	if self.minRttStamp == 0 {
+		return self.options.RetxStartMs
+	}
+	return max(uint32(self.minRtt), bbrMinRoundMs)
+}
+
+func (self *bbrCongestionControl) endRound(elapsed int64, inFlight uint32, now int64) {
+	sample := self.roundDelivered * 1000 / uint64(elapsed)
+
+	// if we weren't sending enough to fill the window, a lower delivery rate says nothing about the path
+	if self.roundMaxInFlight < self.windowSize/2 && sample < self.bandwidth {
+		sample = self.bandwidth
+	}
+
+	self.round++
+	self.bandwidthSamples[self.round%bbrBandwidthFilterRounds] = sample
+	self.bandwidth = 0
+	for _, v := range self.bandwidthSamples {
+		self.bandwidth = max(self.bandwidth, v)
+	}
+
+	self.roundStart = now
+	self.roundDelivered = 0
+	self.roundMaxInFlight = 0
+
+	switch self.state {
+	case BbrStateStartup:
+		if self.isFullBandwidth() {
+			self.state = BbrStateDrain
+			self.gain = 1 / bbrHighGain
+		}
+	case BbrStateDrain:
+		if uint64(inFlight) <= self.bdp() {
+			self.enterProbeBw()
+		}
+	case BbrStateProbeBw:
+		self.cycleIndex = (self.cycleIndex + 1) % len(bbrProbeBwGains)
+		self.gain = bbrProbeBwGains[self.cycleIndex]
+	}
+
+	self.updateWindow()
+}
+
+// isFullBandwidth returns true once the bandwidth estimate has stopped growing by at least 25% for a few rounds
+func (self *bbrCongestionControl) isFullBandwidth() bool {
+	if float64(self.bandwidth) >= float64(self.fullBandwidth)*bbrFullBandwidthGrowth {
+		self.fullBandwidth = self.bandwidth
+		self.fullBandwidthRounds = 0
+		return false
+	}
+	self.fullBandwidthRounds++
+	return self.fullBandwidthRounds >= bbrFullBandwidthRounds
+}
+
+func (self *bbrCongestionControl) enterProbeBw() {
+	self.state = BbrStateProbeBw
+	// skip the probing and draining phases when starting the cycle
+	self.cycleIndex = 2
+	self.gain = bbrProbeBwGains[self.cycleIndex]
+}
+
+// enterProbeRtt shrinks the window to the minimum so queues drain and a fresh minimum RTT can be measured
+func (self *bbrCongestionControl) enterProbeRtt(now int64) {
+	self.state = BbrStateProbeRtt
+	self.gain = 1
+	self.probeRttDoneStamp = now + int64(max(bbrProbeRttMs, self.roundLength()))
+	self.minRtt = 0
+	self.minRttStamp = 0
+	self.windowSize = self.options.TxPortalMinSize
+}
+
+func (self *bbrCongestionControl) exitProbeRtt(now int64) {
+	if self.minRttStamp == 0 {
+		self.minRtt = self.rtt
+		self.minRttStamp = now
+	}
+
+	if self.fullBandwidthRounds >= bbrFullBandwidthRounds {
+		self.enterProbeBw()
+	} else {
+		self.state = BbrStateStartup
+		self.gain = bbrHighGain
+	}
+	self.updateWindow()
+}
+
+func (self *bbrCongestionControl) bdp() uint64 {
+	return self.bandwidth * uint64(max(self.minRtt, 1)) / 1000
+}
+
+func (self *bbrCongestionControl) updateWindow() {
+	if self.state == BbrStateProbeRtt || self.bandwidth == 0 {
+		return
+	}
+
+	window := uint64(float64(self.bdp()) * bbrCwndGain * self.gain)
+
+	// startup only grows the window, it'll be brought down to size when draining
+	if self.state == BbrStateStartup && window < uint64(self.windowSize) {
+		return
+	}
+
+	self.windowSize = uint32(min(window, uint64(self.options.TxPortalMaxSize)))
+	if self.windowSize < self.options.TxPortalMinSize {
+		self.windowSize = self.options.TxPortalMinSize
+	}
+}
+
+func (self *bbrCongestionControl) DuplicateAck() {}
+
+func (self *bbrCongestionControl) RttSample(rtt uint16, now int64) {
+	self.rtt = rtt
+	self.retxThreshold = uint32(float64(rtt)*self.options.RetxScale) + self.options.RetxAddMs
+	if self.minRttStamp == 0 || rtt <= self.minRtt {
+		self.minRtt = rtt
+		self.minRttStamp = now
+	}
+}
+
+func (self *bbrCongestionControl) Retransmitted(int64) {
+	self.retransmits++
+}
+
+func (self *bbrCongestionControl) Inspect(detail *inspect.XgressSendBufferDetail) {
+	detail.WindowSize = self.windowSize
+	detail.Retransmits = self.retransmits
+	detail.RetxScale = self.options.RetxScale
+	detail.RetxThreshold = self.retxThreshold
+	detail.CongestionState = self.state
+	detail.MinRtt = self.minRtt
+	detail.BandwidthEstimate = self.bandwidth
+	detail.Gain = self.gain
+}
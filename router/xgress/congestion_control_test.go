@@ -0,0 +1,117 @@
+package xgress
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCongestionControl(t *testing.T) {
+	req := require.New(t)
+
+	options := DefaultOptions()
+	x := &Xgress{Options: options}
+
+	cc, err := newCongestionControl(x)
+	req.NoError(err)
+	req.Equal(CongestionControlPortal, cc.Name())
+
+	x.tags = map[string]string{CongestionControlTag: CongestionControlBbr}
+	cc, err = newCongestionControl(x)
+	req.NoError(err)
+	req.Equal(CongestionControlBbr, cc.Name())
+
+	x.tags = map[string]string{CongestionControlTag: "unknown"}
+	cc, err = newCongestionControl(x)
+	req.Error(err)
+	req.Equal(CongestionControlPortal, cc.Name())
+
+	req.NoError(validateCongestionControl(CongestionControlBbr))
+	req.Error(validateCongestionControl("unknown"))
+}
+
+func TestPortalCongestionControl(t *testing.T) {
+	req := require.New(t)
+
+	options := DefaultOptions()
+	options.TxPortalStartSize = 64 * 1024
+	cc := newPortalCongestionControl(options).(*portalCongestionControl)
+
+	for i := uint32(0); i < options.TxPortalIncreaseThresh; i++ {
+		cc.Acked(1000, 0, 0)
+	}
+	req.Equal(64*1024+28*1000, int(cc.WindowSize()))
+
+	for i := uint32(0); i < options.TxPortalRetxThresh; i++ {
+		cc.Retransmitted(0)
+	}
+	req.Equal(uint32(float64(64*1024+28*1000)*options.TxPortalRetxScale), cc.WindowSize())
+	req.Equal(uint32(0), cc.accumulator)
+
+	for i := 0; i < 20; i++ {
+		cc.scale(options.TxPortalRetxScale)
+	}
+	req.Equal(options.TxPortalMinSize, cc.WindowSize())
+
+	cc.RttSample(100, 0)
+	req.Equal(uint32(150), cc.RetxThreshold())
+}
+
+// bbrPath simulates sending over a path with the given bottleneck bandwidth, in bytes per millisecond, and RTT
+type bbrPath struct {
+	cc        *bbrCongestionControl
+	bandwidth uint32
+	rtt       uint16
+	now       int64
+}
+
+func (self *bbrPath) run(ms int) {
+	bdp := self.bandwidth * uint32(self.rtt)
+	for i := 0; i < ms; i++ {
+		self.now++
+		window := self.cc.WindowSize()
+		delivered := min(self.bandwidth, window/uint32(self.rtt))
+		inFlight := min(window, bdp)
+		self.cc.RttSample(self.rtt, self.now)
+		self.cc.Acked(delivered, inFlight-delivered, self.now)
+	}
+}
+
+func TestBbrCongestionControl(t *testing.T) {
+	req := require.New(t)
+
+	options := DefaultOptions()
+	options.TxPortalStartSize = 64 * 1024
+	options.TxPortalMaxSize = 64 * 1024 * 1024
+
+	cc := newBbrCongestionControl(options).(*bbrCongestionControl)
+	path := &bbrPath{
+		cc:        cc,
+		bandwidth: 10_000, // 10MB/s
+		rtt:       100,
+		now:       1000,
+	}
+
+	req.Equal(BbrStateStartup, cc.state)
+	path.run(5000)
+
+	req.Equal(BbrStateProbeBw, cc.state)
+	req.InDelta(10_000_000, cc.bandwidth, 100_000)
+	req.Equal(uint16(100), cc.minRtt)
+	req.Equal(uint32(150), cc.RetxThreshold())
+
+	// the window should stay at twice the BDP of 1MB, adjusted by the probing gain
+	req.GreaterOrEqual(cc.WindowSize(), uint32(1_400_000))
+	req.LessOrEqual(cc.WindowSize(), uint32(2_600_000))
+
+	// once the min RTT hasn't been confirmed for a while, the window is dropped to re-measure it
+	path.rtt = 120
+	path.run(bbrMinRttWindowMs + 50)
+	req.Equal(BbrStateProbeRtt, cc.state)
+	req.Equal(options.TxPortalMinSize, cc.WindowSize())
+
+	path.run(bbrProbeRttMs + 50)
+	req.Equal(BbrStateProbeBw, cc.state)
+	req.Equal(uint16(120), cc.minRtt)
+	req.Greater(cc.WindowSize(), options.TxPortalMinSize)
+}
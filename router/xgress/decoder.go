@@ -40,6 +40,9 @@ func (d Decoder) Decode(msg *channel.Message) ([]byte, bool) {
 			meta := channel.NewTraceMessageDecode(DECODER, "Acknowledgement")
 			meta["circuitId"] = ack.CircuitId
 			meta["sequence"] = fmt.Sprintf("len(%d)", len(ack.Sequence))
+			if ack.Sack != nil {
+				meta["sack"] = fmt.Sprintf("%d+len(%d)", ack.Sack.Cumulative, len(ack.Sack.Ranges))
+			}
 			switch ack.GetOriginator() {
 			case Initiator:
 				meta["originator"] = "i"
@@ -86,6 +86,30 @@ func (buffer *LinkReceiveBuffer) Remove(payload *Payload) {
 	buffer.sequence = payload.Sequence
 }
 
+// getSack describes the received payloads, reporting at most maxRanges ranges above the contiguously received
+// sequences. Only maxScan buffered payloads are examined, so under-reporting is possible, but never over-reporting
+func (buffer *LinkReceiveBuffer) getSack(maxRanges int, maxScan int) *Sack {
+	result := &Sack{
+		Cumulative: buffer.sequence,
+	}
+
+	it := buffer.tree.Iterator()
+	for i := 0; i < maxScan && it.Next(); i++ {
+		seq := it.Key().(int32)
+		if len(result.Ranges) == 0 && seq == result.Cumulative+1 {
+			result.Cumulative = seq
+		} else if len(result.Ranges) > 0 && seq == result.Ranges[len(result.Ranges)-1].End+1 {
+			result.Ranges[len(result.Ranges)-1].End = seq
+		} else if len(result.Ranges) < maxRanges {
+			result.Ranges = append(result.Ranges, SackRange{Start: seq, End: seq})
+		} else {
+			break
+		}
+	}
+
+	return result
+}
+
 func (buffer *LinkReceiveBuffer) getLastBufferSizeSent() uint32 {
 	return atomic.LoadUint32(&buffer.lastBufferSizeSent)
 }
@@ -0,0 +1,51 @@
+package xgress
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinkReceiveBuffer_getSack(t *testing.T) {
+	req := require.New(t)
+
+	buffer := NewLinkReceiveBuffer()
+	receive := func(sequences ...int32) {
+		for _, seq := range sequences {
+			req.True(buffer.ReceiveUnordered(&Payload{Sequence: seq, Data: []byte{1}}, 1024))
+		}
+	}
+
+	sack := buffer.getSack(4, 100)
+	req.Equal(int32(-1), sack.Cumulative)
+	req.Empty(sack.Ranges)
+
+	receive(0, 1, 2)
+	sack = buffer.getSack(4, 100)
+	req.Equal(int32(2), sack.Cumulative)
+	req.Empty(sack.Ranges)
+
+	receive(4, 5, 7, 9, 10, 11, 13)
+	sack = buffer.getSack(4, 100)
+	req.Equal(int32(2), sack.Cumulative)
+	req.Equal([]SackRange{{4, 5}, {7, 7}, {9, 11}, {13, 13}}, sack.Ranges)
+	req.Equal(int32(13), sack.MaxSequence())
+
+	// ranges beyond the limit are left out, rather than merged
+	sack = buffer.getSack(2, 100)
+	req.Equal([]SackRange{{4, 5}, {7, 7}}, sack.Ranges)
+
+	// delivered and buffered payloads are both covered by the cumulative sequence
+	for head := buffer.PeekHead(); head != nil; head = buffer.PeekHead() {
+		buffer.Remove(head)
+	}
+	receive(3)
+	sack = buffer.getSack(4, 100)
+	req.Equal(int32(5), sack.Cumulative)
+	req.Equal([]SackRange{{7, 7}, {9, 11}, {13, 13}}, sack.Ranges)
+
+	// scanning fewer entries can only under report
+	sack = buffer.getSack(4, 4)
+	req.Equal(int32(5), sack.Cumulative)
+	req.Equal([]SackRange{{7, 7}}, sack.Ranges)
+}
@@ -37,36 +37,35 @@ type PayloadBufferForwarder interface {
 // https://pkg.go.dev/sync/atomic#pkg-note-BUG
 // https://github.com/golang/go/issues/36606
 type LinkSendBuffer struct {
-	retxScale             float64
 	x                     *Xgress
 	buffer                map[int32]*txPayload
 	newlyBuffered         chan *txPayload
 	newlyReceivedAcks     chan *Acknowledgement
-	windowsSize           uint32
+	congestionControl     CongestionControl
 	linkSendBufferSize    uint32
 	linkRecvBufferSize    uint32
-	accumulator           uint32
-	successfulAcks        uint32
-	duplicateAcks         uint32
-	retransmits           uint32
 	closeNotify           chan struct{}
 	closed                atomic.Bool
 	blockedByLocalWindow  bool
 	blockedByRemoteWindow bool
-	retxThreshold         uint32
 	lastRtt               uint16
+	maxSequence           int32
+	sackCumulative        int32
+	fastRetransmits       uint32
 	lastRetransmitTime    int64
 	closeWhenEmpty        atomic.Bool
 	inspectRequests       chan *sendBufferInspectEvent
 }
 
 type txPayload struct {
-	age        int64
-	payload    *Payload
-	retxQueued int32
-	x          *Xgress
-	next       *txPayload
-	prev       *txPayload
+	age               int64
+	payload           *Payload
+	retxQueued        int32
+	x                 *Xgress
+	next              *txPayload
+	prev              *txPayload
+	missing           uint32
+	fastRetransmitted bool
 }
 
 func (self *txPayload) markSent() {
@@ -99,7 +98,13 @@ func (self *txPayload) isRetransmittable() bool {
 }
 
 func NewLinkSendBuffer(x *Xgress) *LinkSendBuffer {
-	logrus.Debugf("txPortalStartSize = %d, txPortalMinSize = %d",
+	congestionControl, err := newCongestionControl(x)
+	if err != nil {
+		pfxlog.ContextLogger(x.Label()).WithError(err).Warnf("using congestion control '%s'", congestionControl.Name())
+	}
+
+	logrus.Debugf("congestionControl = %s, txPortalStartSize = %d, txPortalMinSize = %d",
+		congestionControl.Name(),
 		x.Options.TxPortalStartSize,
 		x.Options.TxPortalMinSize)
 
@@ -111,9 +116,9 @@ func NewLinkSendBuffer(x *Xgress) *LinkSendBuffer {
 		newlyBuffered:     make(chan *txPayload),
 		newlyReceivedAcks: make(chan *Acknowledgement, 2),
 		closeNotify:       make(chan struct{}),
-		windowsSize:       x.Options.TxPortalStartSize,
-		retxThreshold:     x.Options.RetxStartMs,
-		retxScale:         x.Options.RetxScale,
+		congestionControl: congestionControl,
+		maxSequence:       -1,
+		sackCumulative:    -1,
 		inspectRequests:   make(chan *sendBufferInspectEvent, 1),
 	}
 
@@ -173,7 +178,7 @@ func (buffer *LinkSendBuffer) isBlocked() bool {
 		atomic.AddInt64(&buffersBlockedByRemoteWindow, -1)
 	}
 
-	if buffer.windowsSize < buffer.linkSendBufferSize {
+	if buffer.congestionControl.WindowSize() < buffer.linkSendBufferSize {
 		blocked = true
 		if !buffer.blockedByLocalWindow {
 			buffer.blockedByLocalWindow = true
@@ -185,7 +190,7 @@ func (buffer *LinkSendBuffer) isBlocked() bool {
 	}
 
 	if blocked {
-		pfxlog.ContextLogger(buffer.x.Label()).Debugf("blocked=%v win_size=%v tx_buffer_size=%v rx_buffer_size=%v", blocked, buffer.windowsSize, buffer.linkSendBufferSize, buffer.linkRecvBufferSize)
+		pfxlog.ContextLogger(buffer.x.Label()).Debugf("blocked=%v win_size=%v tx_buffer_size=%v rx_buffer_size=%v", blocked, buffer.congestionControl.WindowSize(), buffer.linkSendBufferSize, buffer.linkRecvBufferSize)
 	}
 
 	return blocked
@@ -221,7 +226,7 @@ func (buffer *LinkSendBuffer) run() {
 
 			select {
 			case txPayload := <-buffered:
-				buffer.buffer[txPayload.payload.GetSequence()] = txPayload
+				buffer.addToBuffer(txPayload)
 				payloadSize := len(txPayload.payload.Data)
 				buffer.linkSendBufferSize += uint32(payloadSize)
 				atomic.AddInt64(&outstandingPayloads, 1)
@@ -247,7 +252,7 @@ func (buffer *LinkSendBuffer) run() {
 			}
 
 		case txPayload := <-buffered:
-			buffer.buffer[txPayload.payload.GetSequence()] = txPayload
+			buffer.addToBuffer(txPayload)
 			payloadSize := len(txPayload.payload.Data)
 			buffer.linkSendBufferSize += uint32(payloadSize)
 			atomic.AddInt64(&outstandingPayloads, 1)
@@ -265,6 +270,14 @@ func (buffer *LinkSendBuffer) run() {
 	}
 }
 
+func (buffer *LinkSendBuffer) addToBuffer(txPayload *txPayload) {
+	sequence := txPayload.payload.GetSequence()
+	buffer.buffer[sequence] = txPayload
+	if sequence > buffer.maxSequence {
+		buffer.maxSequence = sequence
+	}
+}
+
 func (buffer *LinkSendBuffer) close() {
 	if buffer.blockedByLocalWindow {
 		atomic.AddInt64(&buffersBlockedByLocalWindow, -1)
@@ -276,53 +289,96 @@ func (buffer *LinkSendBuffer) close() {
 
 func (buffer *LinkSendBuffer) receiveAcknowledgement(ack *Acknowledgement) {
 	log := pfxlog.ContextLogger(buffer.x.Label()).WithFields(ack.GetLoggerFields())
+	now := info.NowInMilliseconds()
 
 	for _, sequence := range ack.Sequence {
-		if txPayload, found := buffer.buffer[sequence]; found {
-			if txPayload.markAcked() { // if it's been queued for retransmission, remove it from the queue
-				retransmitter.queue(txPayload)
-			}
-
-			payloadSize := uint32(len(txPayload.payload.Data))
-			buffer.accumulator += payloadSize
-			buffer.successfulAcks++
-			delete(buffer.buffer, sequence)
-			atomic.AddInt64(&outstandingPayloads, -1)
-			atomic.AddInt64(&outstandingPayloadBytes, -int64(payloadSize))
-			buffer.linkSendBufferSize -= payloadSize
-			log.Debugf("removing payload %v with size %v. payload buffer size: %v",
-				txPayload.payload.Sequence, len(txPayload.payload.Data), buffer.linkSendBufferSize)
-
-			if buffer.successfulAcks >= buffer.x.Options.TxPortalIncreaseThresh {
-				buffer.successfulAcks = 0
-				delta := uint32(float64(buffer.accumulator) * buffer.x.Options.TxPortalIncreaseScale)
-				buffer.windowsSize += delta
-				if buffer.windowsSize > buffer.x.Options.TxPortalMaxSize {
-					buffer.windowsSize = buffer.x.Options.TxPortalMaxSize
-				}
-				buffer.retxScale -= 0.01
-				if buffer.retxScale < buffer.x.Options.RetxScale {
-					buffer.retxScale = buffer.x.Options.RetxScale
-				}
-			}
-		} else { // duplicate ack
+		if !buffer.acknowledge(sequence, log, now) { // duplicate ack
 			duplicateAcksMeter.Mark(1)
-			buffer.duplicateAcks++
-			if buffer.duplicateAcks >= buffer.x.Options.TxPortalDupAckThresh {
-				buffer.duplicateAcks = 0
-				buffer.retxScale += 0.2
-			}
+			buffer.congestionControl.DuplicateAck()
 		}
 	}
 
+	if ack.Capabilities != 0 {
+		buffer.x.setPeerCapabilities(ack.Capabilities)
+	}
+
+	if ack.Sack != nil && buffer.x.isSackEnabled() {
+		buffer.receiveSack(ack.Sack, log, now)
+	}
+
 	buffer.linkRecvBufferSize = ack.RecvBufferSize
 	if ack.RTT > 0 {
-		rtt := uint16(info.NowInMilliseconds()) - ack.RTT
+		rtt := uint16(now) - ack.RTT
 		if buffer.lastRtt > 0 {
 			rtt = (rtt + buffer.lastRtt) >> 1
 		}
 		buffer.lastRtt = rtt
-		buffer.retxThreshold = uint32(float64(rtt)*buffer.retxScale) + buffer.x.Options.RetxAddMs
+		buffer.congestionControl.RttSample(rtt, now)
+	}
+}
+
+// acknowledge removes the payload with the given sequence from the buffer, returning false if it wasn't found
+func (buffer *LinkSendBuffer) acknowledge(sequence int32, log *logrus.Entry, now int64) bool {
+	txPayload, found := buffer.buffer[sequence]
+	if !found {
+		return false
+	}
+
+	if txPayload.markAcked() { // if it's been queued for retransmission, remove it from the queue
+		retransmitter.queue(txPayload)
+	}
+
+	payloadSize := uint32(len(txPayload.payload.Data))
+	delete(buffer.buffer, sequence)
+	atomic.AddInt64(&outstandingPayloads, -1)
+	atomic.AddInt64(&outstandingPayloadBytes, -int64(payloadSize))
+	buffer.linkSendBufferSize -= payloadSize
+	log.Debugf("removing payload %v with size %v. payload buffer size: %v",
+		txPayload.payload.Sequence, len(txPayload.payload.Data), buffer.linkSendBufferSize)
+
+	buffer.congestionControl.Acked(payloadSize, buffer.linkSendBufferSize, now)
+	return true
+}
+
+// receiveSack acknowledges everything the peer reports holding, which covers for lost acks, and counts the gaps
+// against the payloads missing from them, so they can be fast retransmitted
+func (buffer *LinkSendBuffer) receiveSack(sack *Sack, log *logrus.Entry, now int64) {
+	cumulative := min(sack.Cumulative, buffer.maxSequence)
+	for seq := buffer.sackCumulative + 1; seq <= cumulative; seq++ {
+		buffer.acknowledge(seq, log, now)
+	}
+	buffer.sackCumulative = max(buffer.sackCumulative, cumulative)
+
+	next := cumulative + 1
+	for _, r := range sack.Ranges {
+		if r.Start < next || r.End < r.Start || r.End > buffer.maxSequence {
+			log.Debugf("ignoring invalid sack range %+v", r)
+			return
+		}
+		for seq := next; seq < r.Start; seq++ {
+			buffer.reportMissing(seq, now)
+		}
+		for seq := r.Start; seq <= r.End; seq++ {
+			buffer.acknowledge(seq, log, now)
+		}
+		next = r.End + 1
+	}
+}
+
+func (buffer *LinkSendBuffer) reportMissing(sequence int32, now int64) {
+	txPayload, found := buffer.buffer[sequence]
+	if !found || txPayload.fastRetransmitted || buffer.x.Options.FastRetxThresh == 0 {
+		return
+	}
+
+	txPayload.missing++
+	if txPayload.missing >= buffer.x.Options.FastRetxThresh && txPayload.getAge() != math.MaxInt64 && txPayload.isRetransmittable() {
+		pfxlog.ContextLogger(buffer.x.Label()).Debugf("fast retransmitting payload %v", sequence)
+		txPayload.fastRetransmitted = true
+		txPayload.markQueued()
+		retransmitter.queue(txPayload)
+		buffer.fastRetransmits++
+		buffer.congestionControl.Retransmitted(now)
 	}
 }
 
@@ -332,10 +388,11 @@ func (buffer *LinkSendBuffer) retransmit() {
 		log := pfxlog.ContextLogger(buffer.x.Label())
 
 		retransmitted := 0
+		retxThreshold := buffer.congestionControl.RetxThreshold()
 		var rtxList []*txPayload
 		for _, v := range buffer.buffer {
 			age := v.getAge()
-			if age != math.MaxInt64 && v.isRetransmittable() && uint32(now-age) >= buffer.retxThreshold {
+			if age != math.MaxInt64 && v.isRetransmittable() && uint32(now-age) >= retxThreshold {
 				rtxList = append(rtxList, v)
 			}
 		}
@@ -348,12 +405,7 @@ func (buffer *LinkSendBuffer) retransmit() {
 			v.markQueued()
 			retransmitter.queue(v)
 			retransmitted++
-			buffer.retransmits++
-			if buffer.retransmits >= buffer.x.Options.TxPortalRetxThresh {
-				buffer.accumulator = 0
-				buffer.retransmits = 0
-				buffer.scale(buffer.x.Options.TxPortalRetxScale)
-			}
+			buffer.congestionControl.Retransmitted(now)
 		}
 
 		if retransmitted > 0 {
@@ -363,35 +415,22 @@ func (buffer *LinkSendBuffer) retransmit() {
 	}
 }
 
-func (buffer *LinkSendBuffer) scale(factor float64) {
-	buffer.windowsSize = uint32(float64(buffer.windowsSize) * factor)
-	if factor > 1 {
-		if buffer.windowsSize > buffer.x.Options.TxPortalMaxSize {
-			buffer.windowsSize = buffer.x.Options.TxPortalMaxSize
-		}
-	} else if buffer.windowsSize < buffer.x.Options.TxPortalMinSize {
-		buffer.windowsSize = buffer.x.Options.TxPortalMinSize
-	}
-}
-
 func (buffer *LinkSendBuffer) inspect() *inspect.XgressSendBufferDetail {
 	timeSinceLastRetransmit := time.Duration(info.NowInMilliseconds()-buffer.lastRetransmitTime) * time.Millisecond
 	result := &inspect.XgressSendBufferDetail{
-		WindowSize:            buffer.windowsSize,
 		LinkSendBufferSize:    buffer.linkSendBufferSize,
 		LinkRecvBufferSize:    buffer.linkRecvBufferSize,
-		Accumulator:           buffer.accumulator,
-		SuccessfulAcks:        buffer.successfulAcks,
-		DuplicateAcks:         buffer.duplicateAcks,
-		Retransmits:           buffer.retransmits,
 		Closed:                buffer.closed.Load(),
 		BlockedByLocalWindow:  buffer.blockedByLocalWindow,
 		BlockedByRemoteWindow: buffer.blockedByRemoteWindow,
-		RetxScale:             buffer.retxScale,
-		RetxThreshold:         buffer.retxThreshold,
 		TimeSinceLastRetx:     timeSinceLastRetransmit.String(),
 		CloseWhenEmpty:        buffer.closeWhenEmpty.Load(),
+		CongestionControl:     buffer.congestionControl.Name(),
+		Rtt:                   buffer.lastRtt,
+		Sack:                  buffer.x.isSackEnabled(),
+		FastRetransmits:       buffer.fastRetransmits,
 	}
+	buffer.congestionControl.Inspect(result)
 	return result
 }
 
@@ -0,0 +1,103 @@
+package xgress
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinkSendBuffer_receiveSack(t *testing.T) {
+	req := require.New(t)
+
+	// capture payloads queued for retransmission, rather than sending them
+	queued := make(chan *txPayload, 16)
+	prevRetransmitter := retransmitter
+	retransmitter = &Retransmitter{retransmitIngest: queued}
+	defer func() {
+		retransmitter = prevRetransmitter
+	}()
+
+	options := DefaultOptions()
+	options.Sack = true
+	options.FastRetxThresh = 3
+	x := &Xgress{Options: options}
+	x.setPeerCapabilities(CircuitCapabilitySack)
+
+	buffer := &LinkSendBuffer{
+		x:                 x,
+		buffer:            map[int32]*txPayload{},
+		congestionControl: newPortalCongestionControl(options),
+		maxSequence:       -1,
+		sackCumulative:    -1,
+	}
+
+	for seq := int32(0); seq < 10; seq++ {
+		txPayload := &txPayload{payload: &Payload{Sequence: seq, Data: []byte{1}}, x: x}
+		txPayload.markSent()
+		buffer.addToBuffer(txPayload)
+		buffer.linkSendBufferSize++
+	}
+
+	receiveSack := func(cumulative int32, ranges ...SackRange) {
+		buffer.receiveAcknowledgement(&Acknowledgement{Sack: &Sack{Cumulative: cumulative, Ranges: ranges}})
+	}
+
+	requireBuffered := func(sequences ...int32) {
+		var buffered []int32
+		for seq := range buffer.buffer {
+			buffered = append(buffered, seq)
+		}
+		req.ElementsMatch(sequences, buffered)
+	}
+
+	// payload 2 is missing, everything else reported is acknowledged
+	receiveSack(1, SackRange{Start: 3, End: 4})
+	requireBuffered(2, 5, 6, 7, 8, 9)
+	req.Equal(uint32(1), buffer.buffer[2].missing)
+
+	// payload 6 is reported missing for the first time, once 7 arrives
+	receiveSack(1, SackRange{Start: 3, End: 5}, SackRange{Start: 7, End: 7})
+	requireBuffered(2, 6, 8, 9)
+	req.Equal(uint32(2), buffer.buffer[2].missing)
+	req.Equal(uint32(1), buffer.buffer[6].missing)
+	req.Empty(queued)
+
+	// the third report reaches the threshold, so payload 2 is queued for retransmission
+	receiveSack(1, SackRange{Start: 3, End: 5}, SackRange{Start: 7, End: 7})
+	req.Len(queued, 1)
+	req.Equal(int32(2), (<-queued).payload.Sequence)
+	req.True(buffer.buffer[2].fastRetransmitted)
+	req.Equal(uint32(1), buffer.fastRetransmits)
+
+	// further reports don't queue it again
+	receiveSack(1, SackRange{Start: 3, End: 5})
+	receiveSack(1, SackRange{Start: 3, End: 5})
+	req.Empty(queued)
+	req.Equal(uint32(1), buffer.fastRetransmits)
+	req.Equal(uint32(2), buffer.buffer[6].missing)
+
+	// invalid ranges are ignored: reversed, overlapping the cumulative sequence, out of order, and beyond anything sent
+	for _, ranges := range [][]SackRange{
+		{{Start: 9, End: 8}},
+		{{Start: 1, End: 3}},
+		{{Start: 4, End: 4}, {Start: 2, End: 2}},
+		{{Start: 9, End: 12}},
+	} {
+		prevMissing := buffer.buffer[6].missing
+		receiveSack(1, ranges...)
+		req.Equal(prevMissing, buffer.buffer[6].missing, "%+v", ranges)
+	}
+	requireBuffered(2, 6, 8, 9)
+	req.Empty(queued)
+	req.Equal(uint32(1), buffer.fastRetransmits)
+
+	// a cumulative sequence beyond anything sent only acknowledges what was sent. Payload 2 is passed back to the
+	// retransmitter, so it can be dropped from the retransmit queue
+	receiveSack(20)
+	requireBuffered()
+	req.Equal(int32(9), buffer.sackCumulative)
+	req.Len(queued, 1)
+	txPayload := <-queued
+	req.Equal(int32(2), txPayload.payload.Sequence)
+	req.True(txPayload.isAcked())
+}
@@ -37,6 +37,8 @@ const (
 	HeaderKeyRecvBufferSize = 2259
 	HeaderKeyRTT            = 2260
 	HeaderPayloadRaw        = 2261
+	HeaderKeyCapabilities   = 2262
+	HeaderKeySack           = 2263
 
 	ContentTypePayloadType         = 1100
 	ContentTypeAcknowledgementType = 1101
@@ -85,6 +87,61 @@ type Acknowledgement struct {
 	RecvBufferSize uint32
 	RTT            uint16
 	Sequence       []int32
+	Capabilities   uint32
+	Sack           *Sack
+}
+
+// Sack describes everything held by the receiving side of a circuit: every sequence up to and including Cumulative,
+// plus the given ranges. It lets the sender see gaps, and recover from lost acks, without waiting for timeouts.
+type Sack struct {
+	Cumulative int32
+	Ranges     []SackRange
+}
+
+// SackRange is an inclusive range of received sequences
+type SackRange struct {
+	Start int32
+	End   int32
+}
+
+// MaxSequence returns the highest sequence known to have been received
+func (self *Sack) MaxSequence() int32 {
+	if len(self.Ranges) == 0 {
+		return self.Cumulative
+	}
+	return self.Ranges[len(self.Ranges)-1].End
+}
+
+func (self *Sack) marshall() []byte {
+	buf := make([]byte, 4+len(self.Ranges)*8)
+	binary.BigEndian.PutUint32(buf, uint32(self.Cumulative))
+	nextWriteBuf := buf[4:]
+	for _, r := range self.Ranges {
+		binary.BigEndian.PutUint32(nextWriteBuf, uint32(r.Start))
+		binary.BigEndian.PutUint32(nextWriteBuf[4:], uint32(r.End))
+		nextWriteBuf = nextWriteBuf[8:]
+	}
+	return buf
+}
+
+func unmarshallSack(data []byte) (*Sack, error) {
+	if len(data) < 4 || (len(data)-4)%8 != 0 {
+		return nil, fmt.Errorf("received sack with wrong number of bytes: %v", len(data))
+	}
+
+	result := &Sack{
+		Cumulative: int32(binary.BigEndian.Uint32(data)),
+	}
+
+	nextReadBuf := data[4:]
+	for len(nextReadBuf) > 0 {
+		result.Ranges = append(result.Ranges, SackRange{
+			Start: int32(binary.BigEndian.Uint32(nextReadBuf)),
+			End:   int32(binary.BigEndian.Uint32(nextReadBuf[4:])),
+		})
+		nextReadBuf = nextReadBuf[8:]
+	}
+	return result, nil
 }
 
 func (ack *Acknowledgement) GetCircuitId() string {
@@ -145,6 +202,12 @@ func (ack *Acknowledgement) Marshall() *channel.Message {
 		msg.PutUint32Header(HeaderKeyFlags, ack.Flags)
 	}
 	msg.PutUint32Header(HeaderKeyRecvBufferSize, ack.RecvBufferSize)
+	if ack.Capabilities != 0 {
+		msg.PutUint32Header(HeaderKeyCapabilities, ack.Capabilities)
+	}
+	if ack.Sack != nil {
+		msg.Headers[HeaderKeySack] = ack.Sack.marshall()
+	}
 	return msg
 }
 
@@ -166,6 +229,15 @@ func UnmarshallAcknowledgement(msg *channel.Message) (*Acknowledgement, error) {
 	}
 
 	ack.RTT, _ = msg.GetUint16Header(HeaderKeyRTT)
+	ack.Capabilities, _ = msg.GetUint32Header(HeaderKeyCapabilities)
+
+	if sackData, found := msg.Headers[HeaderKeySack]; found {
+		sack, err := unmarshallSack(sackData)
+		if err != nil {
+			return nil, err
+		}
+		ack.Sack = sack
+	}
 
 	if err := ack.unmarshallSequence(msg.Body); err != nil {
 		return nil, err
@@ -175,12 +247,16 @@ func UnmarshallAcknowledgement(msg *channel.Message) (*Acknowledgement, error) {
 }
 
 func (ack *Acknowledgement) GetLoggerFields() logrus.Fields {
-	return logrus.Fields{
+	fields := logrus.Fields{
 		"circuitId":          ack.CircuitId,
 		"linkRecvBufferSize": ack.RecvBufferSize,
 		"seq":                fmt.Sprintf("%+v", ack.Sequence),
 		"RTT":                ack.RTT,
 	}
+	if ack.Sack != nil {
+		fields["sack"] = fmt.Sprintf("%d%+v", ack.Sack.Cumulative, ack.Sack.Ranges)
+	}
+	return fields
 }
 
 type PayloadType byte
@@ -198,7 +274,9 @@ type Payload struct {
 	Sequence  int32
 	Headers   map[uint8][]byte
 	Data      []byte
-	raw       []byte
+	// Priority is set by the forwarder from the circuit's route and is not sent on the wire
+	Priority PriorityClass
+	raw      []byte
 }
 
 func (payload *Payload) GetSequence() int32 {
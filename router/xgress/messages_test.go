@@ -121,3 +121,32 @@ func TestAcknowledgement_marshallSequence(t *testing.T) {
 		})
 	}
 }
+
+func TestAcknowledgement_sack(t *testing.T) {
+	tests := []struct {
+		name string
+		sack *Sack
+	}{
+		{name: "none", sack: nil},
+		{name: "cumulative only", sack: &Sack{Cumulative: 10}},
+		{name: "nothing received", sack: &Sack{Cumulative: -1, Ranges: []SackRange{{Start: 1, End: 3}}}},
+		{name: "ranges", sack: &Sack{Cumulative: 10, Ranges: []SackRange{{Start: 12, End: 15}, {Start: 20, End: 20}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ack := &Acknowledgement{
+				CircuitId:      "test",
+				RecvBufferSize: 1000,
+				Sequence:       []int32{11},
+				Capabilities:   CircuitCapabilitySack,
+				Sack:           tt.sack,
+			}
+			ack2, err := UnmarshallAcknowledgement(ack.Marshall())
+			assert.NoError(t, err)
+			assert.Equal(t, ack, ack2)
+		})
+	}
+
+	_, err := unmarshallSack([]byte{1, 2, 3, 4, 5})
+	assert.Error(t, err)
+}
@@ -15,6 +15,10 @@ var ackFailures metrics.Meter
 var payloadWriteTimer metrics.Timer
 var duplicateAcksMeter metrics.Meter
 var duplicatePayloadsMeter metrics.Meter
+var compressionInputMeter metrics.Meter
+var compressionOutputMeter metrics.Meter
+var compressionSkippedMeter metrics.Meter
+var compressionRatioHistogram metrics.Histogram
 
 var buffersBlockedByLocalWindow int64
 var buffersBlockedByRemoteWindow int64
@@ -31,6 +35,10 @@ func InitMetrics(registry metrics.Registry) {
 	payloadWriteTimer = registry.Timer("xgress.tx_write_time")
 	duplicateAcksMeter = registry.Meter("xgress.ack_duplicates")
 	duplicatePayloadsMeter = registry.Meter("xgress.payload_duplicates")
+	compressionInputMeter = registry.Meter("xgress.compression.input_bytes")
+	compressionOutputMeter = registry.Meter("xgress.compression.output_bytes")
+	compressionSkippedMeter = registry.Meter("xgress.compression.skipped")
+	compressionRatioHistogram = registry.Histogram("xgress.compression.ratio")
 
 	registry.FuncGauge("xgress.blocked_by_local_window", func() int64 {
 		return atomic.LoadInt64(&buffersBlockedByLocalWindow)
@@ -47,4 +55,6 @@ func InitMetrics(registry metrics.Registry) {
 	registry.FuncGauge("xgress.tx_unacked_payload_bytes", func() int64 {
 		return atomic.LoadInt64(&outstandingPayloadBytes)
 	})
+
+	registry.FuncGauge("xgress.shaped_identities", identityShaping.count)
 }
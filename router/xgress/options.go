@@ -29,6 +29,10 @@ type Options struct {
 	Drop1InN    int32
 	TxQueueSize int32
 
+	// CongestionControl names the algorithm used to size the send window, see CongestionControlPortal and
+	// CongestionControlBbr. It may be overridden per service using the congestionControl service tag
+	CongestionControl string
+
 	TxPortalStartSize      uint32
 	TxPortalMaxSize        uint32
 	TxPortalMinSize        uint32
@@ -44,6 +48,12 @@ type Options struct {
 	RetxScale    float64
 	RetxAddMs    uint32
 
+	// Sack enables selective acknowledgements on circuits where both ends support them
+	Sack bool
+	// FastRetxThresh is the number of selective acknowledgements reporting a payload missing, while later payloads
+	// have arrived, before it is retransmitted without waiting for the retransmit timeout. 0 disables fast retransmit
+	FastRetxThresh uint32
+
 	MaxCloseWait        time.Duration
 	GetCircuitTimeout   time.Duration
 	CircuitStartTimeout time.Duration
@@ -69,6 +79,13 @@ func LoadOptions(data OptionsData) (*Options, error) {
 			options.TxQueueSize = int32(value.(int))
 		}
 
+		if value, found := data["congestionControl"]; found {
+			options.CongestionControl = value.(string)
+			if err := validateCongestionControl(options.CongestionControl); err != nil {
+				return nil, errors.Wrap(err, "invalid 'congestionControl' value")
+			}
+		}
+
 		if value, found := data["txPortalStartSize"]; found {
 			options.TxPortalStartSize = uint32(value.(int))
 		}
@@ -110,6 +127,13 @@ func LoadOptions(data OptionsData) (*Options, error) {
 			options.RetxAddMs = uint32(value.(int))
 		}
 
+		if value, found := data["sack"]; found {
+			options.Sack = value.(bool)
+		}
+		if value, found := data["fastRetxThresh"]; found {
+			options.FastRetxThresh = uint32(value.(int))
+		}
+
 		if value, found := data["maxCloseWaitMs"]; found {
 			options.MaxCloseWait = time.Duration(value.(int)) * time.Millisecond
 		}
@@ -148,6 +172,7 @@ func DefaultOptions() *Options {
 		RandomDrops:            false,
 		Drop1InN:               100,
 		TxQueueSize:            1,
+		CongestionControl:      CongestionControlPortal,
 		TxPortalStartSize:      4 * 1024 * 1024,
 		TxPortalMinSize:        16 * 1024,
 		TxPortalMaxSize:        4 * 1024 * 1024,
@@ -161,6 +186,8 @@ func DefaultOptions() *Options {
 		RetxStartMs:            200,
 		RetxScale:              1.5,
 		RetxAddMs:              0,
+		Sack:                   true,
+		FastRetxThresh:         3,
 		MaxCloseWait:           30 * time.Second,
 		GetCircuitTimeout:      30 * time.Second,
 		CircuitStartTimeout:    3 * time.Minute,
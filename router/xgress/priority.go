@@ -0,0 +1,69 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress
+
+import (
+	"github.com/pkg/errors"
+)
+
+// PriorityClass determines how payloads of a circuit are scheduled on links shared with other circuits. Each class
+// gets a share of the link in proportion to its weight when the link is congested.
+type PriorityClass uint32
+
+const (
+	PriorityDefault PriorityClass = iota
+	PriorityInteractive
+	PriorityBulk
+
+	PriorityClassCount = 3
+
+	// PriorityTag is the circuit tag holding the priority class name. The controller sets it from the priority
+	// tag on the service.
+	PriorityTag = "priority"
+)
+
+var priorityClassNames = [PriorityClassCount]string{"default", "interactive", "bulk"}
+
+// priorityWeights are the relative link shares of the priority classes
+var priorityWeights = [PriorityClassCount]int{4, 16, 1}
+
+func (self PriorityClass) String() string {
+	if self < PriorityClassCount {
+		return priorityClassNames[self]
+	}
+	return priorityClassNames[PriorityDefault]
+}
+
+// Weight returns the relative share of congested links given to the priority class
+func (self PriorityClass) Weight() int {
+	if self < PriorityClassCount {
+		return priorityWeights[self]
+	}
+	return priorityWeights[PriorityDefault]
+}
+
+func ParsePriorityClass(name string) (PriorityClass, error) {
+	if name == "" {
+		return PriorityDefault, nil
+	}
+	for i, className := range priorityClassNames {
+		if className == name {
+			return PriorityClass(i), nil
+		}
+	}
+	return PriorityDefault, errors.Errorf("unknown priority class '%s', valid values are %v", name, priorityClassNames)
+}
@@ -0,0 +1,288 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress
+
+import (
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"ztna-core/ztna/common/shaping"
+)
+
+type ShapingDirection string
+
+const (
+	// ShapingIngress is data read from the initiating client, on its way into the network
+	ShapingIngress ShapingDirection = "ingress"
+	// ShapingEgress is data written to the initiating client, on its way out of the network
+	ShapingEgress ShapingDirection = "egress"
+)
+
+const (
+	shapingTagClientId = "clientId"
+
+	// minShapingBurst ensures a bucket can always take at least a few reasonably sized payloads at once
+	minShapingBurst = 64 * 1024
+)
+
+// ThrottleHandler may be implemented by a PeekHandler to be notified when shaping delays data on a circuit
+type ThrottleHandler interface {
+	Throttled(x *Xgress, direction ShapingDirection, size int, delay time.Duration)
+}
+
+// tokenBucket allows rate bytes per second, with bursts up to burst bytes. Callers may take more tokens than are
+// available, putting the bucket into debt, and are told how long to wait for the debt to be repaid. This keeps
+// payloads larger than the burst size moving and shares the rate between concurrent callers in arrival order.
+type tokenBucket struct {
+	lock   sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate, burst uint64) *tokenBucket {
+	result := &tokenBucket{last: time.Now()}
+	result.setLimits(rate, burst)
+	result.tokens = result.burst
+	return result
+}
+
+func (self *tokenBucket) setLimits(rate, burst uint64) {
+	if burst == 0 {
+		burst = rate
+	}
+	self.rate = float64(rate)
+	self.burst = math.Max(float64(burst), minShapingBurst)
+	if self.tokens > self.burst {
+		self.tokens = self.burst
+	}
+}
+
+// reserve takes n tokens and returns how long the caller must wait before using them
+func (self *tokenBucket) reserve(n int, now time.Time) time.Duration {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if now.After(self.last) {
+		self.tokens = math.Min(self.burst, self.tokens+now.Sub(self.last).Seconds()*self.rate)
+		self.last = now
+	}
+
+	self.tokens -= float64(n)
+	if self.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-self.tokens / self.rate * float64(time.Second))
+}
+
+type shapingLimits struct {
+	ingressBps uint64
+	egressBps  uint64
+	burstBytes uint64
+}
+
+func (self shapingLimits) isSet() bool {
+	return self.ingressBps > 0 || self.egressBps > 0
+}
+
+func (self shapingLimits) newBucket(direction ShapingDirection) *tokenBucket {
+	if rate := self.rate(direction); rate > 0 {
+		return newTokenBucket(rate, self.burstBytes)
+	}
+	return nil
+}
+
+func (self shapingLimits) rate(direction ShapingDirection) uint64 {
+	if direction == ShapingIngress {
+		return self.ingressBps
+	}
+	return self.egressBps
+}
+
+func parseShapingLimits(tags map[string]string, ingressTag, egressTag, burstTag string) shapingLimits {
+	parse := func(tag string) uint64 {
+		val, found := tags[tag]
+		if !found || val == "" {
+			return 0
+		}
+		result, err := strconv.ParseFloat(val, 64)
+		if err != nil || result < 0 {
+			pfxlog.Logger().WithError(err).Warnf("invalid value '%s' for circuit tag %s, ignoring", val, tag)
+			return 0
+		}
+		return uint64(result)
+	}
+	return shapingLimits{
+		ingressBps: parse(ingressTag),
+		egressBps:  parse(egressTag),
+		burstBytes: parse(burstTag),
+	}
+}
+
+// identityBuckets are the buckets shared by the circuits of one identity
+type identityBuckets struct {
+	ingress *tokenBucket
+	egress  *tokenBucket
+	refs    int
+}
+
+type shapingRegistry struct {
+	lock       sync.Mutex
+	identities map[string]*identityBuckets
+}
+
+var identityShaping = &shapingRegistry{
+	identities: map[string]*identityBuckets{},
+}
+
+// acquire returns the shared buckets for the identity, updating them to the given limits, which may have changed
+// since they were created
+func (self *shapingRegistry) acquire(identityId string, limits shapingLimits) *identityBuckets {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	buckets, found := self.identities[identityId]
+	if !found {
+		buckets = &identityBuckets{}
+		self.identities[identityId] = buckets
+	}
+	buckets.refs++
+	buckets.ingress = updateBucket(buckets.ingress, limits, ShapingIngress)
+	buckets.egress = updateBucket(buckets.egress, limits, ShapingEgress)
+	return buckets
+}
+
+func updateBucket(bucket *tokenBucket, limits shapingLimits, direction ShapingDirection) *tokenBucket {
+	rate := limits.rate(direction)
+	if rate == 0 || bucket == nil {
+		return limits.newBucket(direction)
+	}
+	bucket.lock.Lock()
+	bucket.setLimits(rate, limits.burstBytes)
+	bucket.lock.Unlock()
+	return bucket
+}
+
+func (self *shapingRegistry) release(identityId string) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if buckets, found := self.identities[identityId]; found {
+		buckets.refs--
+		if buckets.refs <= 0 {
+			delete(self.identities, identityId)
+		}
+	}
+}
+
+func (self *shapingRegistry) count() int64 {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	return int64(len(self.identities))
+}
+
+// circuitShaper holds the buckets which apply to one circuit
+type circuitShaper struct {
+	identityId string
+	ingress    []*tokenBucket
+	egress     []*tokenBucket
+}
+
+// newCircuitShaper returns a shaper for the limits in the circuit tags, or nil if there are none
+func newCircuitShaper(tags map[string]string) *circuitShaper {
+	serviceLimits := parseShapingLimits(tags, shaping.CircuitTagServiceIngressBps, shaping.CircuitTagServiceEgressBps, shaping.CircuitTagServiceBurstBytes)
+	identityLimits := parseShapingLimits(tags, shaping.CircuitTagIdentityIngressBps, shaping.CircuitTagIdentityEgressBps, shaping.CircuitTagIdentityBurstBytes)
+	identityId := tags[shapingTagClientId]
+
+	if !serviceLimits.isSet() && (!identityLimits.isSet() || identityId == "") {
+		return nil
+	}
+
+	result := &circuitShaper{}
+	if bucket := serviceLimits.newBucket(ShapingIngress); bucket != nil {
+		result.ingress = append(result.ingress, bucket)
+	}
+	if bucket := serviceLimits.newBucket(ShapingEgress); bucket != nil {
+		result.egress = append(result.egress, bucket)
+	}
+
+	if identityLimits.isSet() && identityId != "" {
+		result.identityId = identityId
+		buckets := identityShaping.acquire(identityId, identityLimits)
+		if buckets.ingress != nil {
+			result.ingress = append(result.ingress, buckets.ingress)
+		}
+		if buckets.egress != nil {
+			result.egress = append(result.egress, buckets.egress)
+		}
+	}
+
+	return result
+}
+
+// delay reserves n bytes from each bucket in the given direction and returns the longest wait
+func (self *circuitShaper) delay(direction ShapingDirection, n int) time.Duration {
+	buckets := self.ingress
+	if direction == ShapingEgress {
+		buckets = self.egress
+	}
+
+	now := time.Now()
+	var result time.Duration
+	for _, bucket := range buckets {
+		result = max(result, bucket.reserve(n, now))
+	}
+	return result
+}
+
+func (self *circuitShaper) close() {
+	if self.identityId != "" {
+		identityShaping.release(self.identityId)
+	}
+}
+
+// shape waits until n bytes may pass in the given direction. It returns false if the xgress was closed while waiting
+func (self *Xgress) shape(direction ShapingDirection, n int) bool {
+	if self.shaper == nil || n == 0 {
+		return true
+	}
+
+	delay := self.shaper.delay(direction, n)
+	if delay <= 0 {
+		return true
+	}
+
+	for _, peekHandler := range self.peekHandlers {
+		if throttleHandler, ok := peekHandler.(ThrottleHandler); ok {
+			throttleHandler.Throttled(self, direction, n, delay)
+		}
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-self.closeNotify:
+		return false
+	}
+}
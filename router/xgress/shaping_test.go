@@ -0,0 +1,69 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"ztna-core/ztna/common/shaping"
+)
+
+func TestTokenBucket_reserve(t *testing.T) {
+	req := require.New(t)
+
+	now := time.Now()
+	bucket := newTokenBucket(100_000, 100_000)
+	bucket.last = now
+
+	req.Equal(time.Duration(0), bucket.reserve(100_000, now))
+	req.Equal(500*time.Millisecond, bucket.reserve(50_000, now))
+
+	// the debt is repaid after half a second, after which tokens accumulate again
+	now = now.Add(time.Second)
+	req.Equal(time.Duration(0), bucket.reserve(50_000, now))
+	req.Equal(time.Duration(0), bucket.reserve(0, now.Add(time.Hour)))
+	req.Equal(float64(100_000), bucket.tokens)
+}
+
+func TestShapingRegistry(t *testing.T) {
+	req := require.New(t)
+
+	tags := map[string]string{
+		shapingTagClientId:                   "identity1",
+		shaping.CircuitTagIdentityIngressBps: "1e6",
+		shaping.CircuitTagServiceEgressBps:   "500000",
+	}
+
+	shaper1 := newCircuitShaper(tags)
+	req.NotNil(shaper1)
+	req.Len(shaper1.ingress, 1)
+	req.Len(shaper1.egress, 1)
+
+	shaper2 := newCircuitShaper(tags)
+	req.Equal(int64(1), identityShaping.count())
+	req.Same(shaper1.ingress[0], shaper2.ingress[0])
+	req.NotSame(shaper1.egress[0], shaper2.egress[0])
+
+	shaper1.close()
+	req.Equal(int64(1), identityShaping.count())
+	shaper2.close()
+	req.Equal(int64(0), identityShaping.count())
+
+	req.Nil(newCircuitShaper(map[string]string{shaping.CircuitTagIdentityIngressBps: "1e6"}))
+}
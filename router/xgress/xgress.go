@@ -44,6 +44,21 @@ import (
 
 const (
 	HeaderKeyUUID = 0
+	// HeaderKeyCircuitCapabilities carries the initiator's circuit capabilities on the circuit start payload. The terminator
+	// returns its own on the acknowledgement of that payload
+	HeaderKeyCircuitCapabilities = 1
+	// HeaderKeyCompression marks payload data as compressed and holds the CompressionAlgorithm used
+	HeaderKeyCompression = 2
+
+	// CircuitCapabilitySack indicates the xgress sends selective acknowledgements, and uses them for fast retransmit
+	CircuitCapabilitySack uint32 = 1
+	// CircuitCapabilityZstd and CircuitCapabilityLz4 indicate the xgress can decompress payloads using the algorithm
+	CircuitCapabilityZstd uint32 = 2
+	CircuitCapabilityLz4  uint32 = 4
+
+	// maxSackRanges and maxSackScan bound the size and cost of the selective acknowledgement sent with each ack
+	maxSackRanges = 8
+	maxSackScan   = 1024
 
 	closedFlag            = 0
 	rxerStartedFlag       = 1
@@ -156,6 +171,9 @@ type Xgress struct {
 	flags                concurrenz.AtomicBitSet
 	timeOfLastRxFromLink int64
 	tags                 map[string]string
+	peerCapabilities     atomic.Uint32
+	shaper               *circuitShaper
+	compressor           *payloadCompressor
 }
 
 func (self *Xgress) GetIntervalId() string {
@@ -180,8 +198,12 @@ func NewXgress(circuitId string, ctrlId string, address Address, peer Connection
 		linkRxBuffer:         NewLinkReceiveBuffer(),
 		timeOfLastRxFromLink: info.NowInMilliseconds(),
 		tags:                 tags,
+		compressor:           newPayloadCompressor(tags, options),
 	}
 	result.payloadBuffer = NewLinkSendBuffer(result)
+	if originator == Initiator {
+		result.shaper = newCircuitShaper(tags)
+	}
 	return result
 }
 
@@ -270,9 +292,33 @@ func (self *Xgress) GetStartCircuit() *Payload {
 		Sequence:  int32(self.nextReceiveSequence()),
 		Data:      nil,
 	}
+	if capabilities := self.capabilities(); capabilities != 0 {
+		startCircuit.Headers = map[uint8][]byte{
+			HeaderKeyCircuitCapabilities: binary.BigEndian.AppendUint32(nil, capabilities),
+		}
+	}
 	return startCircuit
 }
 
+// capabilities returns the circuit capabilities supported by this xgress
+func (self *Xgress) capabilities() uint32 {
+	var result uint32
+	if self.Options.Sack {
+		result |= CircuitCapabilitySack
+	}
+	return result | CircuitCapabilityZstd | CircuitCapabilityLz4
+}
+
+func (self *Xgress) setPeerCapabilities(capabilities uint32) {
+	self.peerCapabilities.Store(capabilities)
+}
+
+// isSackEnabled returns true if both this xgress and its peer support selective acknowledgements. Peers running
+// older versions, or connected through routers which don't pass the negotiation along, fall back to plain acks
+func (self *Xgress) isSackEnabled() bool {
+	return self.Options.Sack && self.peerCapabilities.Load()&CircuitCapabilitySack != 0
+}
+
 func (self *Xgress) GetEndCircuit() *Payload {
 	endCircuit := &Payload{
 		CircuitId: self.circuitId,
@@ -332,6 +378,10 @@ func (self *Xgress) Close() {
 
 		self.payloadBuffer.Close()
 
+		if self.shaper != nil {
+			self.shaper.close()
+		}
+
 		for _, peekHandler := range self.peekHandlers {
 			peekHandler.Close(self)
 		}
@@ -474,8 +524,19 @@ func (self *Xgress) tx() {
 		}
 
 		if !payload.IsCircuitStartFlagSet() {
+			data, headers, err := decompressPayload(payload)
+			if err != nil {
+				payloadLogger.WithError(err).Error("unable to decompress payload, closing xgress")
+				self.Close()
+				return false
+			}
+
+			if !self.shape(ShapingEgress, len(data)) {
+				return false
+			}
+
 			start := time.Now()
-			n, err := self.peer.WritePayload(payload.Data, payload.Headers)
+			n, err := self.peer.WritePayload(data, headers)
 			if err != nil {
 				payloadLogger.Warnf("write failed (%s), closing xgress", err)
 				self.Close()
@@ -666,6 +727,15 @@ func (self *Xgress) rx() {
 			return
 		}
 
+		if !self.shape(ShapingIngress, n) {
+			return
+		}
+
+		if self.compressor != nil {
+			buffer, headers = self.compressor.compress(buffer, headers, self.peerCapabilities.Load())
+			n = len(buffer)
+		}
+
 		if self.Options.Mtu == 0 {
 			if !self.sendUnchunkedBuffer(buffer, headers) {
 				return
@@ -841,6 +911,17 @@ func (self *Xgress) PayloadReceived(payload *Payload) {
 		ack.Sequence = append(ack.Sequence, payload.Sequence)
 		ack.RTT = payload.RTT
 
+		if payload.IsCircuitStartFlagSet() {
+			if val, found := payload.Headers[HeaderKeyCircuitCapabilities]; found && len(val) == 4 {
+				self.setPeerCapabilities(binary.BigEndian.Uint32(val))
+			}
+			ack.Capabilities = self.capabilities()
+		}
+
+		if self.isSackEnabled() {
+			ack.Sack = self.linkRxBuffer.getSack(maxSackRanges, maxSackScan)
+		}
+
 		atomic.StoreUint32(&self.linkRxBuffer.lastBufferSizeSent, ack.RecvBufferSize)
 		acker.ack(ack, self.address)
 	} else {
@@ -876,6 +957,13 @@ func (self *Xgress) InspectCircuit(detail *inspect.CircuitInspectDetail) {
 		Flags:                 strconv.FormatUint(uint64(self.flags.Load()), 2),
 	}
 
+	if self.compressor != nil {
+		xgressDetail.Compression = self.compressor.algorithm.String()
+		if self.compressor.disabled.Load() {
+			xgressDetail.Compression += " (disabled)"
+		}
+	}
+
 	detail.XgressDetails[string(self.address)] = xgressDetail
 
 	if detail.IncludeGoroutines() {
@@ -117,6 +117,9 @@ func (dialer *dialer) Dial(params xgress.DialParams) (xt.PeerData, error) {
 		// On the terminator, which this is, this only starts the txer, which pulls data from the link
 		// Since the opposing xgress doesn't start until this call returns, nothing should be coming this way yet
 		x := xgress.NewXgress(circuitId.Token, params.GetCtrlId(), params.GetAddress(), conn, xgress.Terminator, &dialer.options.Options, params.GetCircuitTags())
+		if isEndToEndEncrypted(circuitId.Data, terminator.hostData) {
+			x.DisableCompression()
+		}
 		params.GetBindHandler().HandleXgressBind(x)
 		conn.ctrlRx = x
 		x.Start()
@@ -182,6 +185,9 @@ func (dialer *dialer) Dial(params xgress.DialParams) (xt.PeerData, error) {
 		}
 
 		x := xgress.NewXgress(circuitId.Token, params.GetCtrlId(), params.GetAddress(), conn, xgress.Terminator, &dialer.options.Options, params.GetCircuitTags())
+		if isEndToEndEncrypted(circuitId.Data, terminator.hostData) {
+			x.DisableCompression()
+		}
 		params.GetBindHandler().HandleXgressBind(x)
 		conn.ctrlRx = x
 		x.Start()
@@ -192,6 +198,14 @@ func (dialer *dialer) Dial(params xgress.DialParams) (xt.PeerData, error) {
 	}
 }
 
+// isEndToEndEncrypted returns true if both the dialing and hosting sdks provided public keys, in which case the
+// circuit data is encrypted by the sdks and won't compress
+func isEndToEndEncrypted(dialerData, hostData map[uint32][]byte) bool {
+	_, dialerHasKey := dialerData[edge.PublicKeyHeader]
+	_, hostHasKey := hostData[edge.PublicKeyHeader]
+	return dialerHasKey && hostHasKey
+}
+
 func (dialer *dialer) Inspect(key string, timeout time.Duration) any {
 	if key == "sdk-terminators" {
 		return dialer.factory.hostedServices.Inspect(timeout)
@@ -218,6 +218,7 @@ func (options *Options) ToLoggableString() string {
 	buf.WriteString(fmt.Sprintf("randomDrops=%v\n", options.RandomDrops))
 	buf.WriteString(fmt.Sprintf("drop1InN=%v\n", options.Drop1InN))
 	buf.WriteString(fmt.Sprintf("txQueueSize=%v\n", options.TxQueueSize))
+	buf.WriteString(fmt.Sprintf("congestionControl=%v\n", options.CongestionControl))
 	buf.WriteString(fmt.Sprintf("txPortalStartSize=%v\n", options.TxPortalStartSize))
 	buf.WriteString(fmt.Sprintf("txPortalMaxSize=%v\n", options.TxPortalMaxSize))
 	buf.WriteString(fmt.Sprintf("txPortalMinSize=%v\n", options.TxPortalMinSize))
@@ -231,6 +232,8 @@ func (options *Options) ToLoggableString() string {
 	buf.WriteString(fmt.Sprintf("retxStartMs=%v\n", options.RetxStartMs))
 	buf.WriteString(fmt.Sprintf("retxScale=%v\n", options.RetxScale))
 	buf.WriteString(fmt.Sprintf("retxAddMs=%v\n", options.RetxAddMs))
+	buf.WriteString(fmt.Sprintf("sack=%v\n", options.Sack))
+	buf.WriteString(fmt.Sprintf("fastRetxThresh=%v\n", options.FastRetxThresh))
 	buf.WriteString(fmt.Sprintf("maxCloseWait=%v\n", options.MaxCloseWait))
 	buf.WriteString(fmt.Sprintf("getCircuitTimeout=%v\n", options.GetCircuitTimeout))
 
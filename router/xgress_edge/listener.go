@@ -205,6 +205,9 @@ func (self *edgeClientConn) processConnect(manager state.Manager, req *channel.M
 	self.mapResponsePeerData(response.PeerData)
 
 	x := xgress.NewXgress(response.CircuitId, ctrlCh.Id(), xgress.Address(response.Address), conn, xgress.Initiator, &self.listener.options.Options, response.Tags)
+	if isEndToEndEncrypted(peerData, response.PeerData) {
+		x.DisableCompression()
+	}
 	self.listener.bindHandler.HandleXgressBind(x)
 	conn.ctrlRx = x
 	// send the state_connected before starting the xgress. That way we can't get a state_closed before we get state_connected
@@ -28,6 +28,7 @@ import (
 	"ztna-core/ztna/router/env"
 	"ztna-core/ztna/router/state"
 	"ztna-core/ztna/router/xgress"
+	"ztna-core/ztna/tunnel/intercept/tproxy"
 	"github.com/pkg/errors"
 	"strings"
 	"time"
@@ -127,6 +128,7 @@ type Options struct {
 	resolver         string
 	dnsSvcIpRange    string
 	lanIf            string
+	firewall         string
 	services         []string
 	udpIdleTimeout   time.Duration
 	udpCheckInterval time.Duration
@@ -206,6 +208,14 @@ func (options *Options) load(data xgress.OptionsData) error {
 			}
 		}
 
+		if value, found := data["firewall"]; found {
+			if strVal, ok := value.(string); ok && stringz.Contains([]string{tproxy.FirewallAuto, tproxy.FirewallIptables, tproxy.FirewallNftables}, strVal) {
+				options.firewall = strVal
+			} else {
+				return errors.Errorf(`invalid value '%v' for firewall, must be one of ["auto", "iptables", "nftables"]`, value)
+			}
+		}
+
 		if value, found := data["udpIdleTimeout"]; found {
 			if strVal, ok := value.(string); ok {
 				dur, err := time.ParseDuration(strVal)
@@ -241,6 +251,7 @@ func (options *Options) ToLoggableString() string {
 	buf.WriteString(fmt.Sprintf("randomDrops=%v\n", options.RandomDrops))
 	buf.WriteString(fmt.Sprintf("drop1InN=%v\n", options.Drop1InN))
 	buf.WriteString(fmt.Sprintf("txQueueSize=%v\n", options.TxQueueSize))
+	buf.WriteString(fmt.Sprintf("congestionControl=%v\n", options.CongestionControl))
 	buf.WriteString(fmt.Sprintf("txPortalStartSize=%v\n", options.TxPortalStartSize))
 	buf.WriteString(fmt.Sprintf("txPortalMaxSize=%v\n", options.TxPortalMaxSize))
 	buf.WriteString(fmt.Sprintf("txPortalMinSize=%v\n", options.TxPortalMinSize))
@@ -254,6 +265,8 @@ func (options *Options) ToLoggableString() string {
 	buf.WriteString(fmt.Sprintf("retxStartMs=%v\n", options.RetxStartMs))
 	buf.WriteString(fmt.Sprintf("retxScale=%v\n", options.RetxScale))
 	buf.WriteString(fmt.Sprintf("retxAddMs=%v\n", options.RetxAddMs))
+	buf.WriteString(fmt.Sprintf("sack=%v\n", options.Sack))
+	buf.WriteString(fmt.Sprintf("fastRetxThresh=%v\n", options.FastRetxThresh))
 	buf.WriteString(fmt.Sprintf("maxCloseWait=%v\n", options.MaxCloseWait))
 	buf.WriteString(fmt.Sprintf("getCircuitTimeout=%v\n", options.GetCircuitTimeout))
 
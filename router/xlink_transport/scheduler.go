@@ -0,0 +1,268 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xlink_transport
+
+import (
+	"sync"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/channel/v3"
+	"github.com/openziti/metrics"
+	"github.com/pkg/errors"
+	"ztna-core/ztna/router/xgress"
+)
+
+const (
+	// schedulerQueueSize is the number of payloads which may be queued per priority class before sends are dropped
+	// or block, depending on the send timeout
+	schedulerQueueSize = 16
+
+	// schedulerQuantum is the number of bytes credited to a priority class per unit of weight in each round
+	schedulerQuantum = 1500
+)
+
+var (
+	errSchedulerClosed    = errors.New("link payload scheduler closed")
+	errLinkNotInitialized = errors.New("link not initialized, payloads can not be sent")
+)
+
+// scheduledPayload is a queued payload. The deadline is derived from the timeout given when it was queued, and is
+// zero if the payload should be dropped rather than wait for the channel
+type scheduledPayload struct {
+	msg      *channel.Message
+	deadline time.Time
+}
+
+type priorityQueue struct {
+	weight  int
+	deficit int
+	msgs    []*scheduledPayload
+	slots   chan struct{}
+}
+
+// payloadScheduler sends payloads to a link channel using deficit weighted round-robin across the circuit priority
+// classes, so bulk circuits don't add latency to interactive circuits sharing the link. Payloads queue in the
+// scheduler rather than in the channel when the link is congested, which is where the weighting takes effect.
+//
+// Payloads are sent with the time remaining from the timeout they were queued with, see sendScheduledPayload. A
+// failed send closes the scheduler and faults the link, since payloads can no longer be delivered in order. The
+// failure is returned from subsequent attempts to queue payloads.
+type payloadScheduler struct {
+	lock        sync.Mutex
+	queues      [xgress.PriorityClassCount]*priorityQueue
+	current     int
+	credited    bool
+	pending     int
+	err         error
+	notify      chan struct{}
+	closeNotify chan struct{}
+	closeOnce   sync.Once
+	send        func(msg *channel.Message, deadline time.Time) error
+	fault       func(err error)
+}
+
+func newPayloadScheduler(send func(msg *channel.Message, deadline time.Time) error, fault func(err error)) *payloadScheduler {
+	result := &payloadScheduler{
+		notify:      make(chan struct{}, 1),
+		closeNotify: make(chan struct{}),
+		send:        send,
+		fault:       fault,
+	}
+	for i := range result.queues {
+		result.queues[i] = &priorityQueue{
+			weight: xgress.PriorityClass(i).Weight(),
+			slots:  make(chan struct{}, schedulerQueueSize),
+		}
+	}
+	return result
+}
+
+// enqueue queues the message. With a zero timeout it returns false if the queue for the priority class is full,
+// otherwise it waits up to timeout for space.
+func (self *payloadScheduler) enqueue(msg *channel.Message, priority xgress.PriorityClass, timeout time.Duration) (bool, error) {
+	if priority >= xgress.PriorityClassCount {
+		priority = xgress.PriorityDefault
+	}
+	queue := self.queues[priority]
+
+	// check first, as select picks randomly between ready cases and a slot may be free after close
+	select {
+	case <-self.closeNotify:
+		return false, self.closedErr()
+	default:
+	}
+
+	if timeout == 0 {
+		select {
+		case queue.slots <- struct{}{}:
+		case <-self.closeNotify:
+			return false, self.closedErr()
+		default:
+			return false, nil
+		}
+	} else {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+
+		select {
+		case queue.slots <- struct{}{}:
+		case <-self.closeNotify:
+			return false, self.closedErr()
+		case <-timer.C:
+			return false, errors.Errorf("timeout waiting to queue payload with priority %v", priority)
+		}
+	}
+
+	payload := &scheduledPayload{msg: msg}
+	if timeout != 0 {
+		payload.deadline = time.Now().Add(timeout)
+	}
+
+	self.lock.Lock()
+	queue.msgs = append(queue.msgs, payload)
+	self.pending++
+	self.lock.Unlock()
+
+	select {
+	case self.notify <- struct{}{}:
+	default:
+	}
+	return true, nil
+}
+
+// next returns the next message to send, or nil if nothing is queued. Each class with queued payloads is credited
+// with its quantum once per round and sends payloads while it has enough credit.
+func (self *payloadScheduler) next() (*scheduledPayload, *priorityQueue) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if self.pending == 0 {
+		return nil, nil
+	}
+
+	for {
+		queue := self.queues[self.current]
+		if len(queue.msgs) == 0 {
+			queue.deficit = 0
+		} else {
+			if !self.credited {
+				queue.deficit += queue.weight * schedulerQuantum
+				self.credited = true
+			}
+			payload := queue.msgs[0]
+			if size := len(payload.msg.Body); size <= queue.deficit {
+				queue.msgs[0] = nil
+				queue.msgs = queue.msgs[1:]
+				queue.deficit -= size
+				self.pending--
+				return payload, queue
+			}
+		}
+		self.current = (self.current + 1) % len(self.queues)
+		self.credited = false
+	}
+}
+
+func (self *payloadScheduler) run() {
+	for {
+		payload, queue := self.next()
+		if payload == nil {
+			select {
+			case <-self.notify:
+				continue
+			case <-self.closeNotify:
+				return
+			}
+		}
+
+		err := self.send(payload.msg, payload.deadline)
+		<-queue.slots
+		if err != nil {
+			self.fail(err)
+			return
+		}
+	}
+}
+
+// sendScheduledPayload sends a payload released by the scheduler to the link channel. Payloads queued without a
+// timeout are dropped if the channel can't take them immediately. Otherwise the send waits until the deadline, so the
+// timeout covers both the time spent queued in the scheduler and the time waiting for the channel. Dropped and timed
+// out payloads are marked on the meter and don't fault the link.
+func sendScheduledPayload(ch channel.Channel, msg *channel.Message, deadline time.Time, droppedMsgMeter metrics.Meter) error {
+	if deadline.IsZero() {
+		sent, err := ch.TrySend(msg)
+		if err == nil && !sent {
+			droppedMsgMeter.Mark(1)
+		}
+		return err
+	}
+
+	if err := msg.WithTimeout(time.Until(deadline)).Send(ch); err != nil {
+		if channel.IsTimeout(err) {
+			droppedMsgMeter.Mark(1)
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+// fail closes the scheduler and faults the link after a send error
+func (self *payloadScheduler) fail(err error) {
+	self.lock.Lock()
+	self.err = err
+	self.lock.Unlock()
+
+	self.close()
+
+	if _, closed := err.(channel.ClosedError); !closed {
+		pfxlog.Logger().WithError(err).Error("failure sending scheduled link payload, faulting link")
+	}
+	if self.fault != nil {
+		self.fault(err)
+	}
+}
+
+func (self *payloadScheduler) close() {
+	self.closeOnce.Do(func() {
+		close(self.closeNotify)
+	})
+}
+
+// closedErr returns the error for a closed scheduler, including the send error which closed it, if any
+func (self *payloadScheduler) closedErr() error {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if self.err != nil {
+		return errors.Wrap(self.err, errSchedulerClosed.Error())
+	}
+	return errSchedulerClosed
+}
+
+// queueSizes returns the number of payloads queued per priority class
+func (self *payloadScheduler) queueSizes() map[string]int {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	result := map[string]int{}
+	for i, queue := range self.queues {
+		result[xgress.PriorityClass(i).String()] = len(queue.msgs)
+	}
+	return result
+}
@@ -0,0 +1,164 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xlink_transport
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openziti/channel/v3"
+	"github.com/stretchr/testify/require"
+	"ztna-core/ztna/router/xgress"
+)
+
+func TestPayloadScheduler_weights(t *testing.T) {
+	req := require.New(t)
+
+	var lock sync.Mutex
+	var sent []xgress.PriorityClass
+	release := make(chan struct{})
+
+	scheduler := newPayloadScheduler(func(msg *channel.Message, _ time.Time) error {
+		<-release
+		lock.Lock()
+		sent = append(sent, xgress.PriorityClass(msg.Body[0]))
+		lock.Unlock()
+		return nil
+	}, nil)
+	defer scheduler.close()
+
+	newMsg := func(priority xgress.PriorityClass) *channel.Message {
+		body := make([]byte, 1500)
+		body[0] = byte(priority)
+		return channel.NewMessage(xgress.ContentTypePayloadType, body)
+	}
+
+	for i := 0; i < schedulerQueueSize; i++ {
+		for _, priority := range []xgress.PriorityClass{xgress.PriorityBulk, xgress.PriorityDefault, xgress.PriorityInteractive} {
+			queued, err := scheduler.enqueue(newMsg(priority), priority, 0)
+			req.NoError(err)
+			req.True(queued)
+		}
+	}
+
+	queued, err := scheduler.enqueue(newMsg(xgress.PriorityBulk), xgress.PriorityBulk, 0)
+	req.NoError(err)
+	req.False(queued)
+
+	_, err = scheduler.enqueue(newMsg(xgress.PriorityBulk), xgress.PriorityBulk, 10*time.Millisecond)
+	req.Error(err)
+
+	go scheduler.run()
+	for i := 0; i < 21; i++ {
+		release <- struct{}{}
+	}
+
+	req.Eventually(func() bool {
+		lock.Lock()
+		defer lock.Unlock()
+		return len(sent) == 21
+	}, time.Second, time.Millisecond)
+
+	counts := map[xgress.PriorityClass]int{}
+	lock.Lock()
+	for _, priority := range sent {
+		counts[priority]++
+	}
+	lock.Unlock()
+
+	req.Equal(4, counts[xgress.PriorityDefault])
+	req.Equal(16, counts[xgress.PriorityInteractive])
+	req.Equal(1, counts[xgress.PriorityBulk])
+}
+
+func TestPayloadScheduler_sendFailureFaultsLink(t *testing.T) {
+	req := require.New(t)
+
+	sendErr := errors.New("write failed")
+	faults := make(chan error, 1)
+
+	scheduler := newPayloadScheduler(func(msg *channel.Message, _ time.Time) error {
+		return sendErr
+	}, func(err error) {
+		faults <- err
+	})
+	go scheduler.run()
+
+	queued, err := scheduler.enqueue(channel.NewMessage(xgress.ContentTypePayloadType, []byte{1}), xgress.PriorityDefault, 0)
+	req.NoError(err)
+	req.True(queued)
+
+	select {
+	case err = <-faults:
+		req.Equal(sendErr, err)
+	case <-time.After(time.Second):
+		req.Fail("link not faulted")
+	}
+
+	// the send error is surfaced to later sends, for every priority and whether or not they would wait
+	for _, timeout := range []time.Duration{0, time.Second} {
+		for _, priority := range []xgress.PriorityClass{xgress.PriorityBulk, xgress.PriorityDefault, xgress.PriorityInteractive} {
+			queued, err = scheduler.enqueue(channel.NewMessage(xgress.ContentTypePayloadType, []byte{1}), priority, timeout)
+			req.False(queued)
+			req.ErrorIs(err, sendErr)
+		}
+	}
+}
+
+func TestPayloadScheduler_enqueueAfterClose(t *testing.T) {
+	req := require.New(t)
+
+	scheduler := newPayloadScheduler(func(msg *channel.Message, _ time.Time) error {
+		return nil
+	}, nil)
+	scheduler.close()
+
+	// slots are free, but nothing may be queued once closed
+	for i := 0; i < 100; i++ {
+		queued, err := scheduler.enqueue(channel.NewMessage(xgress.ContentTypePayloadType, []byte{1}), xgress.PriorityDefault, time.Second)
+		req.False(queued)
+		req.Equal(errSchedulerClosed, err)
+	}
+	req.Empty(scheduler.queues[xgress.PriorityDefault].slots)
+}
+
+func TestPayloadScheduler_deadlines(t *testing.T) {
+	req := require.New(t)
+
+	deadlines := make(chan time.Time, 2)
+	scheduler := newPayloadScheduler(func(msg *channel.Message, deadline time.Time) error {
+		deadlines <- deadline
+		return nil
+	}, nil)
+	defer scheduler.close()
+
+	start := time.Now()
+	for _, timeout := range []time.Duration{0, time.Second} {
+		queued, err := scheduler.enqueue(channel.NewMessage(xgress.ContentTypePayloadType, []byte{1}), xgress.PriorityDefault, timeout)
+		req.NoError(err)
+		req.True(queued)
+	}
+	go scheduler.run()
+
+	// payloads queued without a timeout may be dropped, others keep the deadline from when they were queued
+	req.True((<-deadlines).IsZero())
+	deadline := <-deadlines
+	req.False(deadline.Before(start.Add(time.Second)))
+	req.True(deadline.Before(time.Now().Add(time.Second)))
+}
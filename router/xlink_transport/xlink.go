@@ -44,6 +44,8 @@ type impl struct {
 	droppedXgMsgMeter  metrics.Meter
 	droppedRtxMsgMeter metrics.Meter
 	droppedFwdMsgMeter metrics.Meter
+
+	scheduler *payloadScheduler
 }
 
 func (self *impl) Id() string {
@@ -64,27 +66,33 @@ func (self *impl) Init(metricsRegistry metrics.Registry) error {
 		self.droppedXgMsgMeter = metricsRegistry.Meter("link.dropped_xg_msgs:" + self.id)
 		self.droppedRtxMsgMeter = metricsRegistry.Meter("link.dropped_rtx_msgs:" + self.id)
 		self.droppedFwdMsgMeter = metricsRegistry.Meter("link.dropped_fwd_msgs:" + self.id)
+		self.scheduler = newPayloadScheduler(func(msg *channel.Message, deadline time.Time) error {
+			return sendScheduledPayload(self.ch, msg, deadline, self.droppedMsgMeter)
+		}, func(error) {
+			_ = self.Close()
+		})
+		go self.scheduler.run()
 	}
 	return nil
 }
 
 func (self *impl) SendPayload(msg *xgress.Payload, timeout time.Duration, payloadType xgress.PayloadType) error {
-	if timeout == 0 {
-		sent, err := self.ch.TrySend(msg.Marshall())
-		if err == nil && !sent {
-			self.droppedMsgMeter.Mark(1)
-			if payloadType == xgress.PayloadTypeXg {
-				self.droppedXgMsgMeter.Mark(1)
-			} else if payloadType == xgress.PayloadTypeRtx {
-				self.droppedRtxMsgMeter.Mark(1)
-			} else if payloadType == xgress.PayloadTypeFwd {
-				self.droppedFwdMsgMeter.Mark(1)
-			}
-		}
-		return err
+	if self.scheduler == nil {
+		return errLinkNotInitialized
 	}
 
-	return msg.Marshall().WithTimeout(timeout).Send(self.ch)
+	sent, err := self.scheduler.enqueue(msg.Marshall(), msg.Priority, timeout)
+	if err == nil && !sent {
+		self.droppedMsgMeter.Mark(1)
+		if payloadType == xgress.PayloadTypeXg {
+			self.droppedXgMsgMeter.Mark(1)
+		} else if payloadType == xgress.PayloadTypeRtx {
+			self.droppedRtxMsgMeter.Mark(1)
+		} else if payloadType == xgress.PayloadTypeFwd {
+			self.droppedFwdMsgMeter.Mark(1)
+		}
+	}
+	return err
 }
 
 func (self *impl) SendAcknowledgement(msg *xgress.Acknowledgement) error {
@@ -105,6 +113,9 @@ func (self *impl) SendControl(msg *xgress.Control) error {
 
 func (self *impl) Close() error {
 	self.droppedMsgMeter.Dispose()
+	if self.scheduler != nil {
+		self.scheduler.close()
+	}
 	return self.ch.Close()
 }
 
@@ -152,7 +163,7 @@ func (self *impl) InspectCircuit(detail *inspect.CircuitInspectDetail) {
 }
 
 func (self *impl) InspectLink() *inspect.LinkInspectDetail {
-	return &inspect.LinkInspectDetail{
+	result := &inspect.LinkInspectDetail{
 		Id:          self.Id(),
 		Iteration:   self.Iteration(),
 		Key:         self.key,
@@ -163,6 +174,10 @@ func (self *impl) InspectLink() *inspect.LinkInspectDetail {
 		DestVersion: self.DestVersion(),
 		Dialed:      self.dialed,
 	}
+	if self.scheduler != nil {
+		result.PriorityQueues = self.scheduler.queueSizes()
+	}
+	return result
 }
 
 func (self *impl) GetAddresses() []*ctrl_pb.LinkConn {
@@ -48,6 +48,8 @@ type splitImpl struct {
 	droppedXgMsgMeter  metrics.Meter
 	droppedRtxMsgMeter metrics.Meter
 	droppedFwdMsgMeter metrics.Meter
+
+	scheduler *payloadScheduler
 }
 
 func (self *splitImpl) Id() string {
@@ -68,6 +70,12 @@ func (self *splitImpl) Init(metricsRegistry metrics.Registry) error {
 		self.droppedXgMsgMeter = metricsRegistry.Meter("link.dropped_xg_msgs:" + self.id)
 		self.droppedRtxMsgMeter = metricsRegistry.Meter("link.dropped_rtx_msgs:" + self.id)
 		self.droppedFwdMsgMeter = metricsRegistry.Meter("link.dropped_fwd_msgs:" + self.id)
+		self.scheduler = newPayloadScheduler(func(msg *channel.Message, deadline time.Time) error {
+			return sendScheduledPayload(self.payloadCh, msg, deadline, self.droppedMsgMeter)
+		}, func(error) {
+			_ = self.Close()
+		})
+		go self.scheduler.run()
 	}
 	return nil
 }
@@ -79,22 +87,22 @@ func (self *splitImpl) syncInit(f func() error) error {
 }
 
 func (self *splitImpl) SendPayload(msg *xgress.Payload, timeout time.Duration, payloadType xgress.PayloadType) error {
-	if timeout == 0 {
-		sent, err := self.payloadCh.TrySend(msg.Marshall())
-		if err == nil && !sent {
-			self.droppedMsgMeter.Mark(1)
-			if payloadType == xgress.PayloadTypeXg {
-				self.droppedXgMsgMeter.Mark(1)
-			} else if payloadType == xgress.PayloadTypeRtx {
-				self.droppedRtxMsgMeter.Mark(1)
-			} else if payloadType == xgress.PayloadTypeFwd {
-				self.droppedFwdMsgMeter.Mark(1)
-			}
-		}
-		return err
+	if self.scheduler == nil {
+		return errLinkNotInitialized
 	}
 
-	return msg.Marshall().WithTimeout(timeout).Send(self.payloadCh)
+	sent, err := self.scheduler.enqueue(msg.Marshall(), msg.Priority, timeout)
+	if err == nil && !sent {
+		self.droppedMsgMeter.Mark(1)
+		if payloadType == xgress.PayloadTypeXg {
+			self.droppedXgMsgMeter.Mark(1)
+		} else if payloadType == xgress.PayloadTypeRtx {
+			self.droppedRtxMsgMeter.Mark(1)
+		} else if payloadType == xgress.PayloadTypeFwd {
+			self.droppedFwdMsgMeter.Mark(1)
+		}
+	}
+	return err
 }
 
 func (self *splitImpl) SendAcknowledgement(msg *xgress.Acknowledgement) error {
@@ -129,6 +137,9 @@ func (self *splitImpl) Close() error {
 	if self.droppedMsgMeter != nil {
 		self.droppedMsgMeter.Dispose()
 	}
+	if self.scheduler != nil {
+		self.scheduler.close()
+	}
 	var err, err2 error
 	if self.payloadCh != nil {
 		err = self.payloadCh.Close()
@@ -181,7 +192,7 @@ func (self *splitImpl) InspectCircuit(detail *inspect.CircuitInspectDetail) {
 }
 
 func (self *splitImpl) InspectLink() *inspect.LinkInspectDetail {
-	return &inspect.LinkInspectDetail{
+	result := &inspect.LinkInspectDetail{
 		Id:          self.Id(),
 		Iteration:   self.Iteration(),
 		Key:         self.key,
@@ -192,6 +203,10 @@ func (self *splitImpl) InspectLink() *inspect.LinkInspectDetail {
 		DestVersion: self.DestVersion(),
 		Dialed:      self.dialed,
 	}
+	if self.scheduler != nil {
+		result.PriorityQueues = self.scheduler.queueSizes()
+	}
+	return result
 }
 
 func (self *splitImpl) GetAddresses() []*ctrl_pb.LinkConn {
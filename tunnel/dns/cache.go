@@ -0,0 +1,170 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package dns
+
+import (
+	"container/list"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+type cacheEntry struct {
+	key     string
+	msg     *dns.Msg
+	stored  time.Time
+	expires time.Time
+}
+
+// responseCache is an LRU cache of upstream responses. Entries expire according to the TTLs of the records in
+// the response. Negative responses (NXDOMAIN and NODATA) are cached using the SOA minimum from the authority
+// section, capped at negativeTtl
+type responseCache struct {
+	maxSize     int
+	maxTtl      time.Duration
+	negativeTtl time.Duration
+	entries     map[string]*list.Element
+	lru         *list.List
+	lock        sync.Mutex
+}
+
+func newResponseCache(maxSize int, maxTtl time.Duration, negativeTtl time.Duration) *responseCache {
+	return &responseCache{
+		maxSize:     maxSize,
+		maxTtl:      maxTtl,
+		negativeTtl: negativeTtl,
+		entries:     map[string]*list.Element{},
+		lru:         list.New(),
+	}
+}
+
+func cacheKey(q dns.Question) string {
+	return fmt.Sprintf("%s|%d|%d", strings.ToLower(q.Name), q.Qtype, q.Qclass)
+}
+
+// get returns a copy of the cached response for the query, with the TTLs reduced by the time spent in the cache
+func (self *responseCache) get(query *dns.Msg) *dns.Msg {
+	key := cacheKey(query.Question[0])
+	now := time.Now()
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	elem, found := self.entries[key]
+	if !found {
+		return nil
+	}
+
+	entry := elem.Value.(*cacheEntry)
+	if !now.Before(entry.expires) {
+		self.remove(elem)
+		return nil
+	}
+	self.lru.MoveToFront(elem)
+
+	result := entry.msg.Copy()
+	result.Id = query.Id
+	elapsed := uint32(now.Sub(entry.stored) / time.Second)
+	for _, section := range [][]dns.RR{result.Answer, result.Ns, result.Extra} {
+		for _, rr := range section {
+			if rr.Header().Rrtype == dns.TypeOPT {
+				continue
+			}
+			if rr.Header().Ttl > elapsed {
+				rr.Header().Ttl -= elapsed
+			} else {
+				rr.Header().Ttl = 0
+			}
+		}
+	}
+	return result
+}
+
+func (self *responseCache) put(query *dns.Msg, resp *dns.Msg) {
+	ttl, cacheable := self.ttlOf(resp)
+	if !cacheable || ttl <= 0 {
+		return
+	}
+
+	now := time.Now()
+	entry := &cacheEntry{
+		key:     cacheKey(query.Question[0]),
+		msg:     resp.Copy(),
+		stored:  now,
+		expires: now.Add(ttl),
+	}
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if elem, found := self.entries[entry.key]; found {
+		self.remove(elem)
+	}
+
+	self.entries[entry.key] = self.lru.PushFront(entry)
+
+	for self.lru.Len() > self.maxSize {
+		self.remove(self.lru.Back())
+	}
+}
+
+func (self *responseCache) remove(elem *list.Element) {
+	self.lru.Remove(elem)
+	delete(self.entries, elem.Value.(*cacheEntry).key)
+}
+
+func (self *responseCache) size() int {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	return self.lru.Len()
+}
+
+// ttlOf returns how long the response may be cached for
+func (self *responseCache) ttlOf(resp *dns.Msg) (time.Duration, bool) {
+	if resp.Truncated {
+		return 0, false
+	}
+
+	if resp.Rcode == dns.RcodeNameError || (resp.Rcode == dns.RcodeSuccess && len(resp.Answer) == 0) {
+		ttl := self.negativeTtl
+		for _, rr := range resp.Ns {
+			if soa, ok := rr.(*dns.SOA); ok {
+				soaTtl := time.Duration(min(soa.Hdr.Ttl, soa.Minttl)) * time.Second
+				ttl = min(ttl, soaTtl)
+			}
+		}
+		return ttl, true
+	}
+
+	if resp.Rcode != dns.RcodeSuccess {
+		return 0, false
+	}
+
+	ttl := self.maxTtl
+	for _, section := range [][]dns.RR{resp.Answer, resp.Ns, resp.Extra} {
+		for _, rr := range section {
+			if rr.Header().Rrtype == dns.TypeOPT {
+				continue
+			}
+			ttl = min(ttl, time.Duration(rr.Header().Ttl)*time.Second)
+		}
+	}
+	return ttl, true
+}
@@ -0,0 +1,277 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package dns
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/openziti/metrics"
+)
+
+const (
+	DefaultForwarderCacheSize   = 1000
+	DefaultForwarderMaxTtl      = time.Hour
+	DefaultForwarderNegativeTtl = 30 * time.Second
+	DefaultForwarderTimeout     = 5 * time.Second
+
+	MetricDnsCacheHit       = "dns.cache.hit"
+	MetricDnsCacheMiss      = "dns.cache.miss"
+	MetricDnsCacheSize      = "dns.cache.size"
+	MetricDnsForward        = "dns.forward"
+	MetricDnsForwardErrors  = "dns.forward.errors"
+	MetricDnsForwardLatency = "dns.forward.latency"
+)
+
+// ForwarderConfig configures how queries for names which aren't intercepted are resolved.
+//
+// Upstreams entries are either a plain server address, ex: 1.1.1.1 or 1.1.1.1:53, which is used for all
+// names, or a split route, using dnsmasq syntax, ex: /corp.example.com/10.0.0.53, which is used for the
+// listed domains and their sub-domains. The most specific matching route wins. Servers for a route are tried in
+// the order given.
+type ForwarderConfig struct {
+	Upstreams   []string
+	CacheSize   int
+	MaxTtl      time.Duration
+	NegativeTtl time.Duration
+	Timeout     time.Duration
+}
+
+// parseForwarderConfig reads the forwarder settings from the resolver URL query parameters, ex:
+//
+//	udp://127.0.0.1:53?upstream=1.1.1.1&upstream=/corp.example.com/10.0.0.53&cacheSize=5000&negativeTtl=10s
+//
+// Returns nil if no upstreams are configured
+func parseForwarderConfig(query url.Values) (*ForwarderConfig, error) {
+	upstreams := query["upstream"]
+	if len(upstreams) == 0 {
+		return nil, nil
+	}
+
+	result := &ForwarderConfig{
+		Upstreams:   upstreams,
+		CacheSize:   DefaultForwarderCacheSize,
+		MaxTtl:      DefaultForwarderMaxTtl,
+		NegativeTtl: DefaultForwarderNegativeTtl,
+		Timeout:     DefaultForwarderTimeout,
+	}
+
+	if val := query.Get("cacheSize"); val != "" {
+		size, err := strconv.Atoi(val)
+		if err != nil || size < 0 {
+			return nil, fmt.Errorf("invalid cacheSize '%s', must be a non-negative integer", val)
+		}
+		result.CacheSize = size
+	}
+
+	durations := map[string]*time.Duration{
+		"maxTtl":      &result.MaxTtl,
+		"negativeTtl": &result.NegativeTtl,
+		"timeout":     &result.Timeout,
+	}
+
+	for name, target := range durations {
+		if val := query.Get(name); val != "" {
+			d, err := time.ParseDuration(val)
+			if err != nil || d < 0 {
+				return nil, fmt.Errorf("invalid %s '%s', must be a non-negative duration", name, val)
+			}
+			*target = d
+		}
+	}
+
+	return result, nil
+}
+
+type upstreamRoute struct {
+	domain  string
+	servers []string
+}
+
+func (self *upstreamRoute) matches(name string) bool {
+	return self.domain == "." || name == self.domain || strings.HasSuffix(name, "."+self.domain)
+}
+
+type forwarder struct {
+	routes        []*upstreamRoute
+	cache         *responseCache
+	timeout       time.Duration
+	hits          metrics.Meter
+	misses        metrics.Meter
+	forwarded     metrics.Meter
+	forwardErrors metrics.Meter
+	latency       metrics.Timer
+	cacheSize     metrics.Gauge
+}
+
+func newForwarder(config *ForwarderConfig, registry metrics.Registry) (*forwarder, error) {
+	routes := map[string]*upstreamRoute{}
+	addRoute := func(domain string, server string) {
+		route, found := routes[domain]
+		if !found {
+			route = &upstreamRoute{domain: domain}
+			routes[domain] = route
+		}
+		route.servers = append(route.servers, server)
+	}
+
+	for _, upstream := range config.Upstreams {
+		domains := []string{"."}
+		server := upstream
+		if strings.HasPrefix(upstream, "/") {
+			parts := strings.Split(upstream[1:], "/")
+			if len(parts) < 2 || parts[len(parts)-1] == "" {
+				return nil, fmt.Errorf("invalid upstream '%s', expected /<domain>[/<domain>...]/<server>", upstream)
+			}
+			domains = nil
+			for _, domain := range parts[:len(parts)-1] {
+				if domain == "" {
+					return nil, fmt.Errorf("invalid upstream '%s', empty domain", upstream)
+				}
+				domains = append(domains, strings.ToLower(dns.Fqdn(domain)))
+			}
+			server = parts[len(parts)-1]
+		}
+
+		if _, _, err := net.SplitHostPort(server); err != nil {
+			server = net.JoinHostPort(server, "53")
+		}
+
+		for _, domain := range domains {
+			addRoute(domain, server)
+		}
+	}
+
+	if registry == nil {
+		registry = metrics.NewRegistry("dns", nil)
+	}
+
+	result := &forwarder{
+		timeout:       config.Timeout,
+		hits:          registry.Meter(MetricDnsCacheHit),
+		misses:        registry.Meter(MetricDnsCacheMiss),
+		forwarded:     registry.Meter(MetricDnsForward),
+		forwardErrors: registry.Meter(MetricDnsForwardErrors),
+		latency:       registry.Timer(MetricDnsForwardLatency),
+	}
+
+	if result.timeout <= 0 {
+		result.timeout = DefaultForwarderTimeout
+	}
+
+	for _, route := range routes {
+		result.routes = append(result.routes, route)
+	}
+
+	// most specific domain first, so the default route, if any, is last
+	sort.Slice(result.routes, func(i, j int) bool {
+		return dns.CountLabel(result.routes[i].domain) > dns.CountLabel(result.routes[j].domain)
+	})
+
+	if config.CacheSize > 0 {
+		result.cache = newResponseCache(config.CacheSize, config.MaxTtl, config.NegativeTtl)
+		result.cacheSize = registry.FuncGauge(MetricDnsCacheSize, func() int64 {
+			return int64(result.cache.size())
+		})
+	}
+
+	return result, nil
+}
+
+func (self *forwarder) serversFor(name string) []string {
+	name = strings.ToLower(dns.Fqdn(name))
+	for _, route := range self.routes {
+		if route.matches(name) {
+			return route.servers
+		}
+	}
+	return nil
+}
+
+// forward returns the response for the query from the cache or from the upstream servers. Returns nil if no
+// upstream is configured for the name
+func (self *forwarder) forward(query *dns.Msg) *dns.Msg {
+	name := query.Question[0].Name
+	servers := self.serversFor(name)
+	if len(servers) == 0 {
+		return nil
+	}
+
+	if self.cache != nil {
+		if resp := self.cache.get(query); resp != nil {
+			self.hits.Mark(1)
+			return resp
+		}
+		self.misses.Mark(1)
+	}
+
+	for _, server := range servers {
+		resp, err := self.exchange(query, server)
+		if err != nil {
+			self.forwardErrors.Mark(1)
+			log.WithError(err).Debugf("failed to forward query for %s to %s", name, server)
+			continue
+		}
+
+		if resp.Rcode == dns.RcodeServerFailure || resp.Rcode == dns.RcodeRefused {
+			self.forwardErrors.Mark(1)
+			log.Debugf("upstream %s returned %s for %s", server, dns.RcodeToString[resp.Rcode], name)
+			continue
+		}
+
+		if self.cache != nil {
+			self.cache.put(query, resp)
+		}
+		resp.Id = query.Id
+		return resp
+	}
+
+	msg := &dns.Msg{}
+	msg.SetRcode(query, dns.RcodeServerFailure)
+	msg.RecursionAvailable = true
+	return msg
+}
+
+func (self *forwarder) exchange(query *dns.Msg, server string) (*dns.Msg, error) {
+	self.forwarded.Mark(1)
+	start := time.Now()
+	defer func() {
+		self.latency.UpdateSince(start)
+	}()
+
+	client := &dns.Client{Net: "udp", Timeout: self.timeout}
+	resp, _, err := client.Exchange(query, server)
+	if err == nil && resp.Truncated {
+		client.Net = "tcp"
+		resp, _, err = client.Exchange(query, server)
+	}
+	return resp, err
+}
+
+func (self *forwarder) dispose() {
+	for _, metric := range []metrics.Metric{self.hits, self.misses, self.forwarded, self.forwardErrors, self.latency, self.cacheSize} {
+		if metric != nil {
+			metric.Dispose()
+		}
+	}
+}
@@ -0,0 +1,177 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package dns
+
+import (
+	"net"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/openziti/metrics"
+	"github.com/stretchr/testify/require"
+)
+
+type testUpstream struct {
+	addr    string
+	ip      net.IP
+	queries atomic.Int32
+	server  *dns.Server
+}
+
+func startTestUpstream(t *testing.T, ip string) *testUpstream {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	result := &testUpstream{
+		addr: conn.LocalAddr().String(),
+		ip:   net.ParseIP(ip).To4(),
+	}
+
+	result.server = &dns.Server{
+		PacketConn: conn,
+		Handler: dns.HandlerFunc(func(w dns.ResponseWriter, q *dns.Msg) {
+			result.queries.Add(1)
+			msg := &dns.Msg{}
+			msg.SetReply(q)
+			if q.Question[0].Name == "missing.example.com." {
+				msg.Rcode = dns.RcodeNameError
+				msg.Ns = append(msg.Ns, &dns.SOA{
+					Hdr:    dns.RR_Header{Name: "example.com.", Rrtype: dns.TypeSOA, Class: dns.ClassINET, Ttl: 300},
+					Ns:     "ns.example.com.",
+					Mbox:   "admin.example.com.",
+					Minttl: 60,
+				})
+			} else {
+				msg.Answer = append(msg.Answer, &dns.A{
+					Hdr: dns.RR_Header{Name: q.Question[0].Name, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 30},
+					A:   result.ip,
+				})
+			}
+			_ = w.WriteMsg(msg)
+		}),
+	}
+
+	go func() {
+		_ = result.server.ActivateAndServe()
+	}()
+	t.Cleanup(func() { _ = result.server.Shutdown() })
+
+	return result
+}
+
+func TestParseForwarderConfig(t *testing.T) {
+	req := require.New(t)
+
+	config, err := parseForwarderConfig(url.Values{})
+	req.NoError(err)
+	req.Nil(config)
+
+	values, err := url.ParseQuery("upstream=1.1.1.1&upstream=/corp.example.com/10.0.0.53&cacheSize=10&negativeTtl=5s")
+	req.NoError(err)
+	config, err = parseForwarderConfig(values)
+	req.NoError(err)
+	req.Equal([]string{"1.1.1.1", "/corp.example.com/10.0.0.53"}, config.Upstreams)
+	req.Equal(10, config.CacheSize)
+	req.Equal(5*time.Second, config.NegativeTtl)
+	req.Equal(DefaultForwarderMaxTtl, config.MaxTtl)
+
+	_, err = parseForwarderConfig(url.Values{"upstream": {"1.1.1.1"}, "maxTtl": {"forever"}})
+	req.ErrorContains(err, "maxTtl")
+
+	_, err = newForwarder(&ForwarderConfig{Upstreams: []string{"/corp.example.com/"}}, nil)
+	req.Error(err)
+}
+
+func TestForwarder(t *testing.T) {
+	req := require.New(t)
+
+	defaultUpstream := startTestUpstream(t, "192.0.2.1")
+	corpUpstream := startTestUpstream(t, "10.0.0.1")
+	labUpstream := startTestUpstream(t, "10.1.0.1")
+
+	registry := metrics.NewRegistry("test", nil)
+	r, err := newResolver(&ForwarderConfig{
+		Upstreams: []string{
+			defaultUpstream.addr,
+			"/corp.example.com/" + corpUpstream.addr,
+			"/lab.corp.example.com/other.lab/" + labUpstream.addr,
+		},
+		CacheSize:   10,
+		MaxTtl:      time.Hour,
+		NegativeTtl: time.Minute,
+	}, registry)
+	req.NoError(err)
+	defer r.forwarder.dispose()
+
+	resp := query(r, "www.example.com", dns.TypeA)
+	req.Equal("192.0.2.1", resp.Answer[0].(*dns.A).A.String())
+
+	resp = query(r, "corp.example.com", dns.TypeA)
+	req.Equal("10.0.0.1", resp.Answer[0].(*dns.A).A.String())
+
+	resp = query(r, "host.lab.corp.example.com", dns.TypeA)
+	req.Equal("10.1.0.1", resp.Answer[0].(*dns.A).A.String())
+
+	resp = query(r, "host.other.lab", dns.TypeA)
+	req.Equal("10.1.0.1", resp.Answer[0].(*dns.A).A.String())
+
+	// cached responses don't go upstream
+	resp = query(r, "WWW.example.com", dns.TypeA)
+	req.Equal("192.0.2.1", resp.Answer[0].(*dns.A).A.String())
+	req.LessOrEqual(resp.Answer[0].Header().Ttl, uint32(30))
+	req.Equal(int32(1), defaultUpstream.queries.Load())
+
+	// negative responses are cached too
+	resp = query(r, "missing.example.com", dns.TypeA)
+	req.Equal(dns.RcodeNameError, resp.Rcode)
+	resp = query(r, "missing.example.com", dns.TypeA)
+	req.Equal(dns.RcodeNameError, resp.Rcode)
+	req.Equal(int32(2), defaultUpstream.queries.Load())
+
+	type counter interface{ Count() int64 }
+	req.Equal(int64(2), registry.Meter(MetricDnsCacheHit).(counter).Count())
+	req.Equal(int64(5), registry.Meter(MetricDnsCacheMiss).(counter).Count())
+	req.Equal(int64(5), registry.Meter(MetricDnsForward).(counter).Count())
+	req.Equal(int64(0), registry.Meter(MetricDnsForwardErrors).(counter).Count())
+	req.Equal(5, r.forwarder.cache.size())
+}
+
+func TestForwarderFailover(t *testing.T) {
+	req := require.New(t)
+
+	// nothing listening on the first upstream, so the second is used
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	req.NoError(err)
+	deadAddr := conn.LocalAddr().String()
+	req.NoError(conn.Close())
+
+	upstream := startTestUpstream(t, "192.0.2.1")
+
+	r, err := newResolver(&ForwarderConfig{
+		Upstreams: []string{deadAddr, upstream.addr},
+		Timeout:   500 * time.Millisecond,
+	}, nil)
+	req.NoError(err)
+
+	resp := query(r, "www.example.com", dns.TypeA)
+	req.Equal(dns.RcodeSuccess, resp.Rcode)
+	req.Equal("192.0.2.1", resp.Answer[0].(*dns.A).A.String())
+	req.Equal(int64(1), r.forwarder.forwardErrors.(interface{ Count() int64 }).Count())
+}
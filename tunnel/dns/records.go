@@ -0,0 +1,164 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package dns
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/miekg/dns"
+)
+
+const recordTtl = 60
+
+type PortRange struct {
+	Low  uint16
+	High uint16
+}
+
+func (self PortRange) String() string {
+	if self.Low == self.High {
+		return fmt.Sprintf("%d", self.Low)
+	}
+	return fmt.Sprintf("%d-%d", self.Low, self.High)
+}
+
+// ServiceInfo describes the service which intercepts a hostname. It is used to answer SRV and TXT queries,
+// so service discovery tools can find the ports and protocols served behind intercepted names
+type ServiceInfo struct {
+	Name       string
+	Protocols  []string
+	PortRanges []PortRange
+}
+
+// A ServiceInfoResolver publishes SRV and TXT records for intercepted hostnames. SRV records are published as
+// _<service>._<protocol>.<hostname>, where service is the service name, lower-cased, with characters which
+// aren't valid in a DNS label replaced by '-'. TXT records are published on the hostname itself
+type ServiceInfoResolver interface {
+	AddServiceInfo(hostname string, info *ServiceInfo)
+	RemoveServiceInfo(hostname string, serviceName string)
+}
+
+func serviceLabel(serviceName string) string {
+	return strings.Trim(strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '-' {
+			return r
+		}
+		return '-'
+	}, strings.ToLower(serviceName)), "-")
+}
+
+// srvRecords returns the SRV records for a name of the form _<service>._<protocol>.<hostname>.
+func srvRecords(name string, services map[string]*ServiceInfo) []dns.RR {
+	labels := dns.SplitDomainName(name)
+	if len(labels) < 3 || !strings.HasPrefix(labels[0], "_") || !strings.HasPrefix(labels[1], "_") {
+		return nil
+	}
+
+	label := strings.ToLower(labels[0][1:])
+	protocol := strings.ToLower(labels[1][1:])
+	target := dns.Fqdn(strings.Join(labels[2:], "."))
+
+	var result []dns.RR
+	for _, info := range services {
+		if serviceLabel(info.Name) != label {
+			continue
+		}
+		for _, p := range info.Protocols {
+			if !strings.EqualFold(p, protocol) {
+				continue
+			}
+			for _, portRange := range info.PortRanges {
+				result = append(result, &dns.SRV{
+					Hdr:      dns.RR_Header{Name: name, Rrtype: dns.TypeSRV, Class: dns.ClassINET, Ttl: recordTtl},
+					Priority: 0,
+					Weight:   0,
+					Port:     portRange.Low,
+					Target:   target,
+				})
+			}
+		}
+	}
+	return result
+}
+
+// txtRecords returns one TXT record per service intercepting the hostname, ex:
+// "service=web" "protocols=tcp,udp" "ports=80,8000-8080"
+func txtRecords(name string, services map[string]*ServiceInfo) []dns.RR {
+	var names []string
+	for serviceName := range services {
+		names = append(names, serviceName)
+	}
+	sort.Strings(names)
+
+	var result []dns.RR
+	for _, serviceName := range names {
+		info := services[serviceName]
+		var ports []string
+		for _, portRange := range info.PortRanges {
+			ports = append(ports, portRange.String())
+		}
+		result = append(result, &dns.TXT{
+			Hdr: dns.RR_Header{Name: name, Rrtype: dns.TypeTXT, Class: dns.ClassINET, Ttl: recordTtl},
+			Txt: []string{
+				"service=" + info.Name,
+				"protocols=" + strings.ToLower(strings.Join(info.Protocols, ",")),
+				"ports=" + strings.Join(ports, ","),
+			},
+		})
+	}
+	return result
+}
+
+// reverseAddress returns the IP encoded in an in-addr.arpa or ip6.arpa name, or nil if the name isn't a valid
+// reverse lookup name
+func reverseAddress(name string) net.IP {
+	name = strings.ToLower(dns.Fqdn(name))
+
+	if strings.HasSuffix(name, ".in-addr.arpa.") {
+		labels := dns.SplitDomainName(strings.TrimSuffix(name, ".in-addr.arpa."))
+		if len(labels) != 4 {
+			return nil
+		}
+		for i, j := 0, len(labels)-1; i < j; i, j = i+1, j-1 {
+			labels[i], labels[j] = labels[j], labels[i]
+		}
+		return net.ParseIP(strings.Join(labels, ".")).To4()
+	}
+
+	if strings.HasSuffix(name, ".ip6.arpa.") {
+		labels := dns.SplitDomainName(strings.TrimSuffix(name, ".ip6.arpa."))
+		if len(labels) != 32 {
+			return nil
+		}
+		var sb strings.Builder
+		for i := len(labels) - 1; i >= 0; i-- {
+			if len(labels[i]) != 1 {
+				return nil
+			}
+			sb.WriteString(labels[i])
+			if i%4 == 0 && i > 0 {
+				sb.WriteByte(':')
+			}
+		}
+		return net.ParseIP(sb.String())
+	}
+
+	return nil
+}
@@ -61,6 +61,18 @@ func (self *RefCountingResolver) RemoveHostname(s string) net.IP {
 	return nil
 }
 
+func (self *RefCountingResolver) AddServiceInfo(hostname string, info *ServiceInfo) {
+	if r, ok := self.wrapped.(ServiceInfoResolver); ok {
+		r.AddServiceInfo(hostname, info)
+	}
+}
+
+func (self *RefCountingResolver) RemoveServiceInfo(hostname string, serviceName string) {
+	if r, ok := self.wrapped.(ServiceInfoResolver); ok {
+		r.RemoveServiceInfo(hostname, serviceName)
+	}
+}
+
 func (self *RefCountingResolver) Cleanup() error {
 	return self.wrapped.Cleanup()
 }
@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 	"github.com/miekg/dns"
+	"github.com/openziti/metrics"
 	"github.com/sirupsen/logrus"
 	"net"
 	"net/url"
@@ -32,12 +33,14 @@ import (
 var log = logrus.StandardLogger()
 
 type resolver struct {
-	server     *dns.Server
+	servers    []*dns.Server
 	names      map[string]net.IP
 	ips        map[string]string
+	services   map[string]map[string]*ServiceInfo
 	namesMtx   sync.Mutex
 	domains    map[string]*domainEntry
 	domainsMtx sync.Mutex
+	forwarder  *forwarder
 }
 
 func flushDnsCaches() {
@@ -60,7 +63,9 @@ func flushDnsCaches() {
 	}
 }
 
-func NewResolver(config string) (Resolver, error) {
+// NewResolver creates the resolver described by config. Forwarder metrics for udp resolvers are reported to
+// the given registry, which may be nil
+func NewResolver(config string, registry metrics.Registry) (Resolver, error) {
 	flushDnsCaches()
 	if config == "" {
 		return nil, nil
@@ -75,7 +80,11 @@ func NewResolver(config string) (Resolver, error) {
 	case "", "file":
 		return NewRefCountingResolver(NewHostFile(resolverURL.Path)), nil
 	case "udp":
-		dnsResolver, err := NewDnsServer(resolverURL.Host)
+		forwarderConfig, err := parseForwarderConfig(resolverURL.Query())
+		if err != nil {
+			return nil, fmt.Errorf("invalid resolver configuration '%s': %w", config, err)
+		}
+		dnsResolver, err := NewDnsServer(resolverURL.Host, forwarderConfig, registry)
 		if err != nil {
 			return nil, err
 		}
@@ -85,38 +94,67 @@ func NewResolver(config string) (Resolver, error) {
 	return nil, fmt.Errorf("invalid resolver configuration '%s'. must be 'file://' or 'udp://' URL", config)
 }
 
-func NewDnsServer(addr string) (Resolver, error) {
-	log.Infof("starting dns server...")
-	s := &dns.Server{
-		Addr: addr,
-		Net:  "udp",
-	}
-
-	names := make(map[string]net.IP)
+func newResolver(forwarderConfig *ForwarderConfig, registry metrics.Registry) (*resolver, error) {
 	r := &resolver{
-		server:     s,
-		names:      names,
+		names:      make(map[string]net.IP),
 		ips:        make(map[string]string),
+		services:   make(map[string]map[string]*ServiceInfo),
 		namesMtx:   sync.Mutex{},
 		domains:    make(map[string]*domainEntry),
 		domainsMtx: sync.Mutex{},
 	}
-	s.Handler = r
 
-	errChan := make(chan error)
-	go func() {
-		errChan <- s.ListenAndServe()
-	}()
+	if forwarderConfig != nil {
+		var err error
+		if r.forwarder, err = newForwarder(forwarderConfig, registry); err != nil {
+			return nil, err
+		}
+	}
+
+	return r, nil
+}
+
+// NewDnsServer starts a dns server which answers queries for intercepted names. Queries for other names are
+// sent to the configured upstream resolvers, if any, otherwise they are refused. The server listens on both
+// udp and tcp, so clients can retry over tcp when a udp answer is truncated
+func NewDnsServer(addr string, forwarderConfig *ForwarderConfig, registry metrics.Registry) (Resolver, error) {
+	log.Infof("starting dns server...")
+
+	r, err := newResolver(forwarderConfig, registry)
+	if err != nil {
+		return nil, err
+	}
+
+	r.servers = []*dns.Server{
+		{Addr: addr, Net: "udp", Handler: r},
+		{Addr: addr, Net: "tcp", Handler: r},
+	}
+
+	errChan := make(chan error, len(r.servers))
+	for _, s := range r.servers {
+		go func() {
+			errChan <- s.ListenAndServe()
+		}()
+	}
 
 	select {
 	case err := <-errChan:
+		_ = r.shutdownServers()
+		if r.forwarder != nil {
+			r.forwarder.dispose()
+		}
 		if err != nil {
 			return nil, fmt.Errorf("dns server failed to start: %w", err)
 		} else {
 			return nil, fmt.Errorf("dns server stopped prematurely")
 		}
 	case <-time.After(2 * time.Second):
-		log.Infof("dns server running at %s", s.Addr)
+		log.Infof("dns server running at %s (udp and tcp)", addr)
+		if r.forwarder != nil {
+			for _, route := range r.forwarder.routes {
+				log.Infof("dns server forwarding %s to %s", route.domain, strings.Join(route.servers, ", "))
+			}
+		}
 	}
 
 	const resolverConfigHelp = "ziti-tunnel runs an internal DNS server which must be first in the host's\n" +
@@ -125,8 +163,7 @@ func NewDnsServer(addr string) (Resolver, error) {
 		"\n" +
 		"    prepend domain-name-servers %s;\n\n"
 
-	err := r.testSystemResolver()
-	if err != nil {
+	if err = r.testSystemResolver(); err != nil {
 		log.Errorf("system resolver test failed: %s\n\n"+resolverConfigHelp, err, addr)
 	}
 
@@ -201,33 +238,141 @@ func (r *resolver) getAddress(name string) (net.IP, error) {
 
 func (r *resolver) ServeDNS(w dns.ResponseWriter, query *dns.Msg) {
 	log.Tracef("received:\n%s\n", query.String())
-	msg := dns.Msg{}
-	msg.SetReply(query)
-	msg.RecursionAvailable = false
-	msg.Rcode = dns.RcodeRefused
-	q := query.Question[0]
-	switch q.Qtype {
-	case dns.TypeA:
-		name := q.Name
-		address, err := r.getAddress(name)
-		if err == nil {
-			msg.Authoritative = true
-			msg.Rcode = dns.RcodeSuccess
-			answer := &dns.A{
-				Hdr: dns.RR_Header{Name: name, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 60},
-				A:   address,
-			}
-			msg.Answer = append(msg.Answer, answer)
-		} else {
-			msg.Rcode = dns.RcodeRefused // fail fast, and inspire resolver to query next name server in its list.
+
+	var msg *dns.Msg
+	if len(query.Question) == 0 {
+		msg = &dns.Msg{}
+		msg.SetRcode(query, dns.RcodeFormatError)
+	} else if msg = r.answer(query); msg == nil {
+		msg = r.forward(query)
+	}
+
+	if _, isUdp := w.RemoteAddr().(*net.UDPAddr); isUdp {
+		size := dns.MinMsgSize
+		if opt := query.IsEdns0(); opt != nil {
+			size = max(size, int(opt.UDPSize()))
 		}
+		msg.Truncate(size)
 	}
+
 	log.Tracef("response:\n%s\n", msg.String())
-	err := w.WriteMsg(&msg)
+	err := w.WriteMsg(msg)
 	if err != nil {
 		log.Errorf("write failed: %s", err)
 	}
+}
+
+// answer returns the response for names owned by this resolver, or nil if the name isn't known
+func (r *resolver) answer(query *dns.Msg) *dns.Msg {
+	q := query.Question[0]
+	name := q.Name
 
+	msg := &dns.Msg{}
+	msg.SetReply(query)
+	msg.Authoritative = true
+	msg.RecursionAvailable = r.forwarder != nil
+
+	switch q.Qtype {
+	case dns.TypePTR:
+		ip := reverseAddress(name)
+		if ip == nil {
+			return nil
+		}
+		hostname, err := r.Lookup(ip)
+		if err != nil {
+			return nil
+		}
+		msg.Answer = append(msg.Answer, &dns.PTR{
+			Hdr: dns.RR_Header{Name: name, Rrtype: dns.TypePTR, Class: dns.ClassINET, Ttl: recordTtl},
+			Ptr: dns.Fqdn(hostname),
+		})
+		return msg
+	case dns.TypeSRV:
+		r.namesMtx.Lock()
+		labels := dns.SplitDomainName(name)
+		var records []dns.RR
+		if len(labels) > 2 {
+			records = srvRecords(name, r.services[strings.ToLower(dns.Fqdn(strings.Join(labels[2:], ".")))])
+		}
+		r.namesMtx.Unlock()
+		if len(records) == 0 {
+			// don't fall through to the address lookup, that would allocate addresses for wildcard domains
+			return nil
+		}
+		msg.Answer = append(msg.Answer, records...)
+		return msg
+	}
+
+	address, err := r.getAddress(name)
+	if err != nil {
+		return nil
+	}
+
+	// names we own get an authoritative answer, which is empty (NODATA) if we have no records of the
+	// requested type, so resolvers don't go looking for the name elsewhere
+	switch q.Qtype {
+	case dns.TypeA:
+		if ip4 := address.To4(); ip4 != nil {
+			msg.Answer = append(msg.Answer, &dns.A{
+				Hdr: dns.RR_Header{Name: name, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: recordTtl},
+				A:   ip4,
+			})
+		}
+	case dns.TypeAAAA:
+		if address.To4() == nil {
+			msg.Answer = append(msg.Answer, &dns.AAAA{
+				Hdr:  dns.RR_Header{Name: name, Rrtype: dns.TypeAAAA, Class: dns.ClassINET, Ttl: recordTtl},
+				AAAA: address,
+			})
+		}
+	case dns.TypeTXT:
+		r.namesMtx.Lock()
+		msg.Answer = append(msg.Answer, txtRecords(name, r.services[strings.ToLower(name)])...)
+		r.namesMtx.Unlock()
+	}
+
+	return msg
+}
+
+// forward sends queries for names this resolver doesn't own to the upstream resolvers. Without a matching
+// upstream the query is refused, so the client's resolver will fail fast and query the next name server in its list
+func (r *resolver) forward(query *dns.Msg) *dns.Msg {
+	if r.forwarder != nil {
+		if resp := r.forwarder.forward(query); resp != nil {
+			return resp
+		}
+	}
+
+	msg := &dns.Msg{}
+	msg.SetRcode(query, dns.RcodeRefused)
+	msg.RecursionAvailable = r.forwarder != nil
+	return msg
+}
+
+func (r *resolver) AddServiceInfo(hostname string, info *ServiceInfo) {
+	r.namesMtx.Lock()
+	defer r.namesMtx.Unlock()
+
+	canonical := strings.ToLower(hostname) + "."
+	services, found := r.services[canonical]
+	if !found {
+		services = map[string]*ServiceInfo{}
+		r.services[canonical] = services
+	}
+	services[info.Name] = info
+}
+
+func (r *resolver) RemoveServiceInfo(hostname string, serviceName string) {
+	r.namesMtx.Lock()
+	defer r.namesMtx.Unlock()
+
+	canonical := strings.ToLower(hostname) + "."
+	if services, found := r.services[canonical]; found {
+		delete(services, serviceName)
+		if len(services) == 0 {
+			delete(r.services, canonical)
+		}
+	}
 }
 
 func (r *resolver) AddDomain(name string, ipCB func(string) (net.IP, error)) error {
@@ -282,6 +427,8 @@ func (r *resolver) Lookup(ip net.IP) (string, error) {
 	if ip == nil {
 		return "", errors.New("illegal argument")
 	}
+	r.namesMtx.Lock()
+	defer r.namesMtx.Unlock()
 	key := ip.String()
 	name, found := r.ips[key]
 	if found {
@@ -309,5 +456,18 @@ func (r *resolver) RemoveHostname(hostname string) net.IP {
 
 func (r *resolver) Cleanup() error {
 	log.Debug("shutting down")
-	return r.server.Shutdown()
+	if r.forwarder != nil {
+		r.forwarder.dispose()
+	}
+	return r.shutdownServers()
+}
+
+func (r *resolver) shutdownServers() error {
+	var errs []error
+	for _, s := range r.servers {
+		if err := s.Shutdown(); err != nil {
+			errs = append(errs, fmt.Errorf("unable to shut down %s dns server: %w", s.Net, err))
+		}
+	}
+	return errors.Join(errs...)
 }
@@ -0,0 +1,175 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package dns
+
+import (
+	"net"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/require"
+)
+
+type testResponseWriter struct {
+	dns.ResponseWriter
+	msg *dns.Msg
+}
+
+func (self *testResponseWriter) RemoteAddr() net.Addr {
+	return &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 5353}
+}
+
+func (self *testResponseWriter) WriteMsg(msg *dns.Msg) error {
+	self.msg = msg
+	return nil
+}
+
+func query(r *resolver, name string, qtype uint16) *dns.Msg {
+	q := &dns.Msg{}
+	q.SetQuestion(dns.Fqdn(name), qtype)
+	w := &testResponseWriter{}
+	r.ServeDNS(w, q)
+	return w.msg
+}
+
+func TestServeDNS(t *testing.T) {
+	req := require.New(t)
+
+	r, err := newResolver(nil, nil)
+	req.NoError(err)
+	req.NoError(r.AddHostname("web.ziti", net.ParseIP("100.64.0.2").To4()))
+	req.NoError(r.AddHostname("web6.ziti", net.ParseIP("fd00::2")))
+	r.AddServiceInfo("web.ziti", &ServiceInfo{
+		Name:       "Web Service",
+		Protocols:  []string{"tcp", "udp"},
+		PortRanges: []PortRange{{Low: 80, High: 80}, {Low: 8000, High: 8080}},
+	})
+
+	resp := query(r, "web.ziti", dns.TypeA)
+	req.Equal(dns.RcodeSuccess, resp.Rcode)
+	req.Len(resp.Answer, 1)
+	req.Equal("100.64.0.2", resp.Answer[0].(*dns.A).A.String())
+
+	resp = query(r, "web.ziti", dns.TypeAAAA)
+	req.Equal(dns.RcodeSuccess, resp.Rcode)
+	req.True(resp.Authoritative)
+	req.Empty(resp.Answer)
+
+	resp = query(r, "web6.ziti", dns.TypeAAAA)
+	req.Equal(dns.RcodeSuccess, resp.Rcode)
+	req.Len(resp.Answer, 1)
+	req.Equal("fd00::2", resp.Answer[0].(*dns.AAAA).AAAA.String())
+
+	resp = query(r, "2.0.64.100.in-addr.arpa", dns.TypePTR)
+	req.Equal(dns.RcodeSuccess, resp.Rcode)
+	req.Len(resp.Answer, 1)
+	req.Equal("web.ziti.", resp.Answer[0].(*dns.PTR).Ptr)
+
+	reverse, err := dns.ReverseAddr("fd00::2")
+	req.NoError(err)
+	resp = query(r, reverse, dns.TypePTR)
+	req.Len(resp.Answer, 1)
+	req.Equal("web6.ziti.", resp.Answer[0].(*dns.PTR).Ptr)
+
+	resp = query(r, "_web-service._tcp.web.ziti", dns.TypeSRV)
+	req.Equal(dns.RcodeSuccess, resp.Rcode)
+	req.Len(resp.Answer, 2)
+	req.Equal(uint16(80), resp.Answer[0].(*dns.SRV).Port)
+	req.Equal("web.ziti.", resp.Answer[0].(*dns.SRV).Target)
+	req.Equal(uint16(8000), resp.Answer[1].(*dns.SRV).Port)
+
+	resp = query(r, "_other._tcp.web.ziti", dns.TypeSRV)
+	req.Equal(dns.RcodeRefused, resp.Rcode)
+
+	resp = query(r, "web.ziti", dns.TypeTXT)
+	req.Len(resp.Answer, 1)
+	req.Equal([]string{"service=Web Service", "protocols=tcp,udp", "ports=80,8000-8080"}, resp.Answer[0].(*dns.TXT).Txt)
+
+	r.RemoveServiceInfo("web.ziti", "Web Service")
+	resp = query(r, "web.ziti", dns.TypeTXT)
+	req.Equal(dns.RcodeSuccess, resp.Rcode)
+	req.Empty(resp.Answer)
+
+	resp = query(r, "example.com", dns.TypeA)
+	req.Equal(dns.RcodeRefused, resp.Rcode)
+}
+
+func TestServeDNSForwarding(t *testing.T) {
+	req := require.New(t)
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	req.NoError(err)
+
+	upstream := &dns.Server{
+		PacketConn: conn,
+		Handler: dns.HandlerFunc(func(w dns.ResponseWriter, q *dns.Msg) {
+			msg := &dns.Msg{}
+			msg.SetReply(q)
+			msg.Answer = append(msg.Answer, &dns.A{
+				Hdr: dns.RR_Header{Name: q.Question[0].Name, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 30},
+				A:   net.ParseIP("93.184.216.34").To4(),
+			})
+			_ = w.WriteMsg(msg)
+		}),
+	}
+	go func() {
+		_ = upstream.ActivateAndServe()
+	}()
+	defer func() { _ = upstream.Shutdown() }()
+
+	r, err := newResolver(&ForwarderConfig{Upstreams: []string{conn.LocalAddr().String()}}, nil)
+	req.NoError(err)
+	req.NoError(r.AddHostname("web.ziti", net.ParseIP("100.64.0.2").To4()))
+
+	resp := query(r, "example.com", dns.TypeA)
+	req.Equal(dns.RcodeSuccess, resp.Rcode)
+	req.Len(resp.Answer, 1)
+	req.Equal("93.184.216.34", resp.Answer[0].(*dns.A).A.String())
+
+	resp = query(r, "web.ziti", dns.TypeA)
+	req.Equal("100.64.0.2", resp.Answer[0].(*dns.A).A.String())
+	req.True(resp.RecursionAvailable)
+}
+
+func TestDnsServerAnswersOverUdpAndTcp(t *testing.T) {
+	req := require.New(t)
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	req.NoError(err)
+	addr := conn.LocalAddr().String()
+	req.NoError(conn.Close())
+
+	server, err := NewDnsServer(addr, nil, nil)
+	req.NoError(err)
+	r := server.(*resolver)
+	req.NoError(r.AddHostname("web.ziti", net.ParseIP("100.64.0.2").To4()))
+
+	q := &dns.Msg{}
+	q.SetQuestion("web.ziti.", dns.TypeA)
+
+	for _, network := range []string{"udp", "tcp"} {
+		resp, _, err := (&dns.Client{Net: network}).Exchange(q, addr)
+		req.NoError(err, network)
+		req.Len(resp.Answer, 1, network)
+		req.Equal("100.64.0.2", resp.Answer[0].(*dns.A).A.String(), network)
+	}
+
+	req.NoError(r.Cleanup())
+
+	_, err = net.Dial("tcp", addr)
+	req.Error(err)
+}
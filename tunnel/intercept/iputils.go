@@ -138,5 +138,23 @@ func getInterceptIP(svc *entities.Service, hostname string, resolver dns.Resolve
 		logger.WithError(err).Errorf("failed to add host/ip mapping to resolver: %v -> %v", hostname, ip)
 	}
 
+	if infoResolver, ok := resolver.(dns.ServiceInfoResolver); ok && svc.Name != nil {
+		infoResolver.AddServiceInfo(hostname, getServiceInfo(svc))
+		serviceName := *svc.Name
+		svc.AddCleanupAction(func() { infoResolver.RemoveServiceInfo(hostname, serviceName) })
+	}
+
 	return nil
 }
+
+// getServiceInfo returns the information published in SRV and TXT records for hostnames intercepted by the service
+func getServiceInfo(svc *entities.Service) *dns.ServiceInfo {
+	result := &dns.ServiceInfo{
+		Name:      *svc.Name,
+		Protocols: svc.InterceptV1Config.Protocols,
+	}
+	for _, portRange := range svc.InterceptV1Config.PortRanges {
+		result.PortRanges = append(result.PortRanges, dns.PortRange{Low: portRange.Low, High: portRange.High})
+	}
+	return result
+}
@@ -0,0 +1,165 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package tproxy
+
+import (
+	"fmt"
+
+	"github.com/coreos/go-iptables/iptables"
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/foundation/v2/stringz"
+	"github.com/pkg/errors"
+	"ztna-core/ztna/tunnel/intercept"
+)
+
+const (
+	mangleTable = "mangle"
+	filterTable = "filter"
+	dstChain    = "NF-INTERCEPT"
+)
+
+type iptablesFirewall struct {
+	ipt   *iptables.IPTables
+	lanIf string
+}
+
+func newIptablesFirewall(lanIf string) (*iptablesFirewall, error) {
+	ipt, err := iptables.New()
+	if err != nil {
+		return nil, errors.Wrap(err, "tproxy: failed to initialize iptables handle")
+	}
+
+	result := &iptablesFirewall{
+		ipt:   ipt,
+		lanIf: lanIf,
+	}
+
+	if err = result.addChain(mangleTable, "PREROUTING", dstChain); err != nil {
+		return nil, err
+	}
+
+	if lanIf != "" {
+		if err = result.addChain(filterTable, "INPUT", dstChain); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func (self *iptablesFirewall) String() string {
+	return FirewallIptables
+}
+
+func (self *iptablesFirewall) addChain(table, srcChain, dstChain string) error {
+	chains, err := self.ipt.ListChains(table)
+	if err != nil {
+		return fmt.Errorf("failed to list iptables %s chains: %v", table, err)
+	}
+
+	if !stringz.Contains(chains, dstChain) {
+		err = self.ipt.NewChain(table, dstChain)
+		if err != nil {
+			return fmt.Errorf("failed to create iptables chain: %v", err)
+		}
+	}
+
+	err = self.ipt.AppendUnique(table, srcChain, []string{"-j", dstChain}...)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create '%v' link: '%v' --> '%v'", table, srcChain, dstChain)
+	} else {
+		pfxlog.Logger().Infof("added iptables '%v' link '%v' --> '%v'", table, srcChain, dstChain)
+	}
+
+	return nil
+}
+
+func (self *iptablesFirewall) deleteChain(table, srcChain, dstChain string) {
+	log := pfxlog.Logger().WithField("chain", dstChain)
+	log.Infof("removing iptables '%v' link '%v' --> '%v'", table, srcChain, dstChain)
+
+	if err := self.ipt.Delete(table, srcChain, []string{"-j", dstChain}...); err != nil {
+		log.WithError(err).Error("failed to unlink chain")
+	}
+
+	if err := self.ipt.ClearChain(table, dstChain); err != nil {
+		log.WithError(err).Error("failed to clear chain")
+	}
+
+	if err := self.ipt.DeleteChain(table, dstChain); err != nil {
+		log.WithError(err).Error("failed to delete chain")
+	}
+}
+
+func (self *iptablesFirewall) addRules(addr *intercept.InterceptAddress, serviceName string, port IPPortAddr) error {
+	ipNet := addr.IpNet()
+	addr.TproxySpec = []string{
+		"-m", "comment", "--comment", serviceName,
+		"-d", ipNet.String(),
+		"-p", addr.Proto(),
+		"--dport", fmt.Sprintf("%v:%v", addr.LowPort(), addr.HighPort()),
+		"-j", "TPROXY",
+		"--tproxy-mark", "0x1/0x1",
+		fmt.Sprintf("--on-ip=%s", port.GetIP().String()),
+		fmt.Sprintf("--on-port=%d", port.GetPort()),
+	}
+
+	pfxlog.Logger().Infof("Adding rule iptables -t %v -A %v %v", mangleTable, dstChain, addr.TproxySpec)
+	if err := self.ipt.Insert(mangleTable, dstChain, 1, addr.TproxySpec...); err != nil {
+		return errors.Wrap(err, "failed to insert rule")
+	}
+
+	if self.lanIf != "" {
+		addr.AcceptSpec = []string{
+			"-i", self.lanIf,
+			"-m", "comment", "--comment", serviceName,
+			"-d", ipNet.String(),
+			"-p", addr.Proto(),
+			"--dport", fmt.Sprintf("%v:%v", addr.LowPort(), addr.HighPort()),
+			"-j", "ACCEPT",
+		}
+		pfxlog.Logger().Infof("Adding rule iptables -t %v -A %v %v", filterTable, dstChain, addr.AcceptSpec)
+		if err := self.ipt.Insert(filterTable, dstChain, 1, addr.AcceptSpec...); err != nil {
+			return errors.Wrap(err, "failed to insert rule")
+		}
+	}
+
+	return nil
+}
+
+func (self *iptablesFirewall) removeRules(addr *intercept.InterceptAddress) error {
+	log := pfxlog.Logger().WithField("route", addr.IpNet())
+
+	log.Infof("Removing rule iptables -t %v -A %v %v", mangleTable, dstChain, addr.TproxySpec)
+	result := self.ipt.Delete(mangleTable, dstChain, addr.TproxySpec...)
+
+	if self.lanIf != "" {
+		log.Infof("Removing rule iptables -t %v -A %v %v", filterTable, dstChain, addr.AcceptSpec)
+		if err := self.ipt.Delete(filterTable, dstChain, addr.AcceptSpec...); err != nil && result == nil {
+			result = err
+		}
+	}
+
+	return result
+}
+
+func (self *iptablesFirewall) cleanup() {
+	self.deleteChain(mangleTable, "PREROUTING", dstChain)
+	if self.lanIf != "" {
+		self.deleteChain(filterTable, "INPUT", dstChain)
+	}
+}
@@ -0,0 +1,84 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package tproxy
+
+import (
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/nftables"
+	"github.com/michaelquigley/pfxlog"
+	"github.com/pkg/errors"
+	"ztna-core/ztna/tunnel/intercept"
+)
+
+// firewall manages the rules which divert intercepted addresses to the tproxy listeners and, if a lan interface
+// is configured, accept traffic for those addresses arriving on it
+type firewall interface {
+	addRules(addr *intercept.InterceptAddress, serviceName string, port IPPortAddr) error
+	removeRules(addr *intercept.InterceptAddress) error
+	cleanup()
+	String() string
+}
+
+func newFirewall(backend string, lanIf string) (firewall, error) {
+	switch backend {
+	case "", FirewallAuto:
+		return detectFirewall(lanIf)
+	case FirewallIptables:
+		return newIptablesFirewall(lanIf)
+	case FirewallNftables:
+		return newNftablesFirewall(lanIf)
+	}
+	return nil, errors.Errorf("invalid firewall '%s', must be one of [%s, %s, %s]", backend, FirewallAuto, FirewallIptables, FirewallNftables)
+}
+
+// detectFirewall uses nftables directly if the kernel supports it and iptables is either not installed or is the
+// iptables-nft compatibility layer. Hosts running legacy iptables keep using iptables
+func detectFirewall(lanIf string) (firewall, error) {
+	if nftablesAvailable() && !legacyIptablesInstalled() {
+		fw, err := newNftablesFirewall(lanIf)
+		if err == nil {
+			return fw, nil
+		}
+		pfxlog.Logger().WithError(err).Warn("failed to initialize nftables, falling back to iptables")
+	}
+	return newIptablesFirewall(lanIf)
+}
+
+func nftablesAvailable() bool {
+	conn, err := nftables.New()
+	if err != nil {
+		return false
+	}
+	_, err = conn.ListTablesOfFamily(nftables.TableFamilyIPv4)
+	return err == nil
+}
+
+// legacyIptablesInstalled reports whether the iptables command is the legacy x_tables implementation. The nft
+// compatibility layer is installed as iptables-nft or xtables-nft-multi, usually behind a chain of symlinks
+func legacyIptablesInstalled() bool {
+	path, err := exec.LookPath("iptables")
+	if err != nil {
+		return false
+	}
+	if resolved, err := filepath.EvalSymlinks(path); err == nil {
+		path = resolved
+	}
+	return !strings.Contains(filepath.Base(path), "nft")
+}
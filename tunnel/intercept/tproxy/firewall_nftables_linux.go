@@ -0,0 +1,285 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package tproxy
+
+import (
+	"net"
+	"slices"
+	"sync"
+
+	"github.com/google/nftables"
+	"github.com/google/nftables/binaryutil"
+	"github.com/google/nftables/expr"
+	"github.com/google/nftables/userdata"
+	"github.com/michaelquigley/pfxlog"
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+	"ztna-core/ztna/tunnel/intercept"
+)
+
+const nftTable = "nf-intercept"
+
+type nftRule struct {
+	addr        *intercept.InterceptAddress
+	serviceName string
+	port        IPPortAddr
+}
+
+// nftablesFirewall keeps the intercept rules in a table of its own, which is rewritten as a whole on every change.
+// The rewrite is sent as a single netlink batch, which the kernel applies as one transaction, so packets never see
+// a partially updated rule set
+type nftablesFirewall struct {
+	lanIf    string
+	table    *nftables.Table
+	rules    []*nftRule
+	connOpts []nftables.ConnOption
+	lock     sync.Mutex
+}
+
+func newNftablesFirewall(lanIf string, connOpts ...nftables.ConnOption) (*nftablesFirewall, error) {
+	result := &nftablesFirewall{
+		lanIf: lanIf,
+		table: &nftables.Table{
+			Family: nftables.TableFamilyIPv4,
+			Name:   nftTable,
+		},
+		connOpts: connOpts,
+	}
+
+	// this also replaces any rules left behind by a previous run
+	if err := result.apply(); err != nil {
+		return nil, errors.Wrap(err, "tproxy: failed to initialize nftables table")
+	}
+
+	pfxlog.Logger().Infof("added nftables table 'ip %v'", nftTable)
+	return result, nil
+}
+
+func (self *nftablesFirewall) String() string {
+	return FirewallNftables
+}
+
+func (self *nftablesFirewall) addRules(addr *intercept.InterceptAddress, serviceName string, port IPPortAddr) error {
+	if addr.IpNet().IP.To4() == nil {
+		return errors.Errorf("nftables firewall only supports IPv4 addresses, can't intercept %v", addr.IpNet())
+	}
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	pfxlog.Logger().Infof("Adding nftables rule for %v %v:%v:%v-%v -> %v:%v", serviceName,
+		addr.Proto(), addr.IpNet(), addr.LowPort(), addr.HighPort(), port.GetIP(), port.GetPort())
+
+	self.rules = append(self.rules, &nftRule{
+		addr:        addr,
+		serviceName: serviceName,
+		port:        port,
+	})
+
+	if err := self.apply(); err != nil {
+		self.rules = self.rules[:len(self.rules)-1]
+		return errors.Wrap(err, "failed to insert rule")
+	}
+
+	return nil
+}
+
+func (self *nftablesFirewall) removeRules(addr *intercept.InterceptAddress) error {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	idx := slices.IndexFunc(self.rules, func(rule *nftRule) bool {
+		return rule.addr == addr
+	})
+
+	if idx < 0 {
+		return nil
+	}
+
+	pfxlog.Logger().Infof("Removing nftables rule for %v %v:%v:%v-%v", self.rules[idx].serviceName,
+		addr.Proto(), addr.IpNet(), addr.LowPort(), addr.HighPort())
+
+	removed := self.rules[idx]
+	self.rules = slices.Delete(self.rules, idx, idx+1)
+
+	if err := self.apply(); err != nil {
+		self.rules = slices.Insert(self.rules, idx, removed)
+		return errors.Wrap(err, "failed to remove rule")
+	}
+
+	return nil
+}
+
+func (self *nftablesFirewall) cleanup() {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	log := pfxlog.Logger().WithField("table", nftTable)
+	log.Infof("removing nftables table 'ip %v'", nftTable)
+
+	conn, err := nftables.New(self.connOpts...)
+	if err != nil {
+		log.WithError(err).Error("failed to open nftables connection")
+		return
+	}
+
+	conn.DelTable(self.table)
+	if err = conn.Flush(); err != nil {
+		log.WithError(err).Error("failed to delete table")
+	}
+}
+
+// apply replaces the contents of the table with the current rules. Must be called with the lock held
+func (self *nftablesFirewall) apply() error {
+	conn, err := nftables.New(self.connOpts...)
+	if err != nil {
+		return err
+	}
+
+	chains, rules := self.ruleset()
+
+	conn.AddTable(self.table)
+	for _, chain := range chains {
+		conn.AddChain(chain)
+	}
+
+	conn.FlushTable(self.table)
+
+	for _, rule := range rules {
+		conn.AddRule(rule)
+	}
+
+	return conn.Flush()
+}
+
+// ruleset returns the chains of the table and the rules they should contain, in order. Must be called with the
+// lock held
+func (self *nftablesFirewall) ruleset() ([]*nftables.Chain, []*nftables.Rule) {
+	prerouting := &nftables.Chain{
+		Name:     "prerouting",
+		Table:    self.table,
+		Type:     nftables.ChainTypeFilter,
+		Hooknum:  nftables.ChainHookPrerouting,
+		Priority: nftables.ChainPriorityMangle,
+	}
+	chains := []*nftables.Chain{prerouting}
+
+	var input *nftables.Chain
+	if self.lanIf != "" {
+		input = &nftables.Chain{
+			Name:     "input",
+			Table:    self.table,
+			Type:     nftables.ChainTypeFilter,
+			Hooknum:  nftables.ChainHookInput,
+			Priority: nftables.ChainPriorityFilter,
+		}
+		chains = append(chains, input)
+	}
+
+	var rules []*nftables.Rule
+
+	// newest first, matching the iptables firewall, which inserts rules at the head of the chain
+	for i := len(self.rules) - 1; i >= 0; i-- {
+		rule := self.rules[i]
+		comment := userdata.AppendString(nil, userdata.TypeComment, rule.serviceName)
+
+		rules = append(rules, &nftables.Rule{
+			Table:    self.table,
+			Chain:    prerouting,
+			Exprs:    rule.tproxyExprs(),
+			UserData: comment,
+		})
+
+		if input != nil {
+			rules = append(rules, &nftables.Rule{
+				Table:    self.table,
+				Chain:    input,
+				Exprs:    rule.acceptExprs(self.lanIf),
+				UserData: comment,
+			})
+		}
+	}
+
+	return chains, rules
+}
+
+// matchExprs matches the destination cidr, protocol and port range, ex: ip daddr 100.64.0.0/10 tcp dport 80-443
+func (self *nftRule) matchExprs() []expr.Any {
+	ipNet := self.addr.IpNet()
+	mask := ipNet.Mask
+	if len(mask) == net.IPv6len {
+		mask = mask[12:]
+	}
+
+	var proto byte = unix.IPPROTO_TCP
+	if self.addr.Proto() == "udp" {
+		proto = unix.IPPROTO_UDP
+	}
+
+	return []expr.Any{
+		&expr.Payload{DestRegister: 1, Base: expr.PayloadBaseNetworkHeader, Offset: 16, Len: 4},
+		&expr.Bitwise{SourceRegister: 1, DestRegister: 1, Len: 4, Mask: mask, Xor: make([]byte, 4)},
+		&expr.Cmp{Op: expr.CmpOpEq, Register: 1, Data: ipNet.IP.Mask(mask).To4()},
+		&expr.Meta{Key: expr.MetaKeyL4PROTO, Register: 1},
+		&expr.Cmp{Op: expr.CmpOpEq, Register: 1, Data: []byte{proto}},
+		&expr.Payload{DestRegister: 1, Base: expr.PayloadBaseTransportHeader, Offset: 2, Len: 2},
+		&expr.Range{
+			Op:       expr.CmpOpEq,
+			Register: 1,
+			FromData: binaryutil.BigEndian.PutUint16(self.addr.LowPort()),
+			ToData:   binaryutil.BigEndian.PutUint16(self.addr.HighPort()),
+		},
+	}
+}
+
+// tproxyExprs is the equivalent of the iptables TPROXY rule:
+// <match> tproxy to <ip>:<port> meta mark set mark | 0x1 accept
+func (self *nftRule) tproxyExprs() []expr.Any {
+	return append(self.matchExprs(),
+		&expr.Immediate{Register: 1, Data: self.port.GetIP().To4()},
+		&expr.Immediate{Register: 2, Data: binaryutil.BigEndian.PutUint16(uint16(self.port.GetPort()))},
+		&expr.TProxy{
+			Family:      byte(nftables.TableFamilyIPv4),
+			TableFamily: byte(nftables.TableFamilyIPv4),
+			RegAddr:     1,
+			RegPort:     2,
+		},
+		&expr.Meta{Key: expr.MetaKeyMARK, Register: 1},
+		&expr.Bitwise{
+			SourceRegister: 1,
+			DestRegister:   1,
+			Len:            4,
+			Mask:           binaryutil.NativeEndian.PutUint32(^uint32(0x1)),
+			Xor:            binaryutil.NativeEndian.PutUint32(0x1),
+		},
+		&expr.Meta{Key: expr.MetaKeyMARK, SourceRegister: true, Register: 1},
+		&expr.Verdict{Kind: expr.VerdictAccept},
+	)
+}
+
+// acceptExprs accepts intercepted traffic arriving on the lan interface: iifname <lanIf> <match> accept
+func (self *nftRule) acceptExprs(lanIf string) []expr.Any {
+	ifName := make([]byte, unix.IFNAMSIZ)
+	copy(ifName, lanIf)
+
+	result := []expr.Any{
+		&expr.Meta{Key: expr.MetaKeyIIFNAME, Register: 1},
+		&expr.Cmp{Op: expr.CmpOpEq, Register: 1, Data: ifName},
+	}
+	result = append(result, self.matchExprs()...)
+	return append(result, &expr.Verdict{Kind: expr.VerdictAccept})
+}
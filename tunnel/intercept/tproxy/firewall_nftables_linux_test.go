@@ -0,0 +1,238 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package tproxy
+
+import (
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/google/nftables"
+	"github.com/google/nftables/binaryutil"
+	"github.com/google/nftables/expr"
+	"github.com/google/nftables/userdata"
+	"github.com/mdlayher/netlink"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sys/unix"
+	"ztna-core/ztna/tunnel/entities"
+	"ztna-core/ztna/tunnel/intercept"
+)
+
+// testNetlink stands in for the kernel, acknowledging every batch unless told to fail
+type testNetlink struct {
+	sync.Mutex
+	fail bool
+}
+
+func (self *testNetlink) dial(req []netlink.Message) ([]netlink.Message, error) {
+	self.Lock()
+	defer self.Unlock()
+	if self.fail {
+		return nil, errors.New("operation not permitted")
+	}
+	return req, nil
+}
+
+func (self *testNetlink) setFail(fail bool) {
+	self.Lock()
+	defer self.Unlock()
+	self.fail = fail
+}
+
+func newTestNftablesFirewall(t *testing.T, lanIf string) (*nftablesFirewall, *testNetlink) {
+	nl := &testNetlink{}
+	fw, err := newNftablesFirewall(lanIf, nftables.WithTestDial(nl.dial))
+	require.NoError(t, err)
+	return fw, nl
+}
+
+type testAddrCollector []*intercept.InterceptAddress
+
+func (self *testAddrCollector) Apply(addr *intercept.InterceptAddress) {
+	*self = append(*self, addr)
+}
+
+func testInterceptAddress(t *testing.T, cidr string, protocol string, lowPort, highPort uint16) *intercept.InterceptAddress {
+	svc := &entities.Service{
+		InterceptV1Config: &entities.InterceptV1Config{
+			Addresses:  []string{cidr},
+			PortRanges: []*entities.PortRange{{Low: lowPort, High: highPort}},
+		},
+	}
+
+	var addrs testAddrCollector
+	require.NoError(t, intercept.GetInterceptAddresses(svc, []string{protocol}, nil, &addrs))
+	require.Len(t, addrs, 1)
+	return addrs[0]
+}
+
+func testRuleComment(t *testing.T, rule *nftables.Rule) string {
+	comment, found := userdata.GetString(rule.UserData, userdata.TypeComment)
+	require.True(t, found)
+	return comment
+}
+
+func Test_NftablesTproxyRule(t *testing.T) {
+	req := require.New(t)
+
+	var fw firewall
+	fw, _ = newTestNftablesFirewall(t, "")
+	req.Equal(FirewallNftables, fw.String())
+
+	addr := testInterceptAddress(t, "100.64.0.0/10", "tcp", 80, 443)
+	port := &TCPIPPortAddr{IP: net.ParseIP("127.0.0.1"), Port: 12345}
+	req.NoError(fw.addRules(addr, "svc1", port))
+
+	chains, rules := fw.(*nftablesFirewall).ruleset()
+	req.Len(chains, 1)
+	req.Equal("prerouting", chains[0].Name)
+	req.Equal(nftables.ChainHookPrerouting, chains[0].Hooknum)
+	req.Equal(nftables.ChainPriorityMangle, chains[0].Priority)
+
+	req.Len(rules, 1)
+	req.Equal(nftTable, rules[0].Table.Name)
+	req.Equal(nftables.TableFamilyIPv4, rules[0].Table.Family)
+	req.Equal(chains[0], rules[0].Chain)
+	req.Equal("svc1", testRuleComment(t, rules[0]))
+
+	req.Equal([]expr.Any{
+		&expr.Payload{DestRegister: 1, Base: expr.PayloadBaseNetworkHeader, Offset: 16, Len: 4},
+		&expr.Bitwise{SourceRegister: 1, DestRegister: 1, Len: 4, Mask: []byte{255, 192, 0, 0}, Xor: []byte{0, 0, 0, 0}},
+		&expr.Cmp{Op: expr.CmpOpEq, Register: 1, Data: []byte{100, 64, 0, 0}},
+		&expr.Meta{Key: expr.MetaKeyL4PROTO, Register: 1},
+		&expr.Cmp{Op: expr.CmpOpEq, Register: 1, Data: []byte{unix.IPPROTO_TCP}},
+		&expr.Payload{DestRegister: 1, Base: expr.PayloadBaseTransportHeader, Offset: 2, Len: 2},
+		&expr.Range{Op: expr.CmpOpEq, Register: 1, FromData: []byte{0, 80}, ToData: []byte{1, 187}},
+		&expr.Immediate{Register: 1, Data: []byte{127, 0, 0, 1}},
+		&expr.Immediate{Register: 2, Data: []byte{0x30, 0x39}},
+		&expr.TProxy{
+			Family:      byte(nftables.TableFamilyIPv4),
+			TableFamily: byte(nftables.TableFamilyIPv4),
+			RegAddr:     1,
+			RegPort:     2,
+		},
+		&expr.Meta{Key: expr.MetaKeyMARK, Register: 1},
+		&expr.Bitwise{
+			SourceRegister: 1,
+			DestRegister:   1,
+			Len:            4,
+			Mask:           binaryutil.NativeEndian.PutUint32(^uint32(0x1)),
+			Xor:            binaryutil.NativeEndian.PutUint32(0x1),
+		},
+		&expr.Meta{Key: expr.MetaKeyMARK, SourceRegister: true, Register: 1},
+		&expr.Verdict{Kind: expr.VerdictAccept},
+	}, rules[0].Exprs)
+}
+
+func Test_NftablesLanIfAcceptRule(t *testing.T) {
+	req := require.New(t)
+
+	fw, _ := newTestNftablesFirewall(t, "eth1")
+
+	addr := testInterceptAddress(t, "192.168.10.5", "udp", 53, 53)
+	port := &UDPIPPortAddr{IP: net.ParseIP("127.0.0.1"), Port: 5353}
+	req.NoError(fw.addRules(addr, "dns", port))
+
+	chains, rules := fw.ruleset()
+	req.Len(chains, 2)
+	req.Equal("input", chains[1].Name)
+	req.Equal(nftables.ChainHookInput, chains[1].Hooknum)
+	req.Equal(nftables.ChainPriorityFilter, chains[1].Priority)
+
+	req.Len(rules, 2)
+	req.Equal(chains[0], rules[0].Chain)
+	req.Equal(chains[1], rules[1].Chain)
+	req.Equal("dns", testRuleComment(t, rules[1]))
+
+	ifName := make([]byte, unix.IFNAMSIZ)
+	copy(ifName, "eth1")
+
+	accept := rules[1].Exprs
+	req.Len(accept, 10)
+	req.Equal(&expr.Meta{Key: expr.MetaKeyIIFNAME, Register: 1}, accept[0])
+	req.Equal(&expr.Cmp{Op: expr.CmpOpEq, Register: 1, Data: ifName}, accept[1])
+	req.Equal(&expr.Bitwise{SourceRegister: 1, DestRegister: 1, Len: 4, Mask: []byte{255, 255, 255, 255}, Xor: []byte{0, 0, 0, 0}}, accept[3])
+	req.Equal(&expr.Cmp{Op: expr.CmpOpEq, Register: 1, Data: []byte{192, 168, 10, 5}}, accept[4])
+	req.Equal(&expr.Cmp{Op: expr.CmpOpEq, Register: 1, Data: []byte{unix.IPPROTO_UDP}}, accept[6])
+	req.Equal(&expr.Range{Op: expr.CmpOpEq, Register: 1, FromData: []byte{0, 53}, ToData: []byte{0, 53}}, accept[8])
+	req.Equal(&expr.Verdict{Kind: expr.VerdictAccept}, accept[9])
+
+	// the tproxy rule targets the udp listener
+	req.Contains(rules[0].Exprs, &expr.Immediate{Register: 2, Data: []byte{0x14, 0xe9}})
+}
+
+func Test_NftablesRuleOrderAndRemoval(t *testing.T) {
+	req := require.New(t)
+
+	fw, _ := newTestNftablesFirewall(t, "")
+	port := &TCPIPPortAddr{IP: net.ParseIP("127.0.0.1"), Port: 12345}
+
+	addr1 := testInterceptAddress(t, "10.0.0.1", "tcp", 22, 22)
+	addr2 := testInterceptAddress(t, "10.0.0.2", "tcp", 22, 22)
+	req.NoError(fw.addRules(addr1, "svc1", port))
+	req.NoError(fw.addRules(addr2, "svc2", port))
+
+	_, rules := fw.ruleset()
+	req.Len(rules, 2)
+	req.Equal("svc2", testRuleComment(t, rules[0]))
+	req.Equal("svc1", testRuleComment(t, rules[1]))
+
+	req.NoError(fw.removeRules(addr1))
+	_, rules = fw.ruleset()
+	req.Len(rules, 1)
+	req.Equal("svc2", testRuleComment(t, rules[0]))
+
+	// removing an address which has no rules is a no-op
+	req.NoError(fw.removeRules(addr1))
+	_, rules = fw.ruleset()
+	req.Len(rules, 1)
+}
+
+func Test_NftablesRollbackOnFailure(t *testing.T) {
+	req := require.New(t)
+
+	fw, nl := newTestNftablesFirewall(t, "")
+	port := &TCPIPPortAddr{IP: net.ParseIP("127.0.0.1"), Port: 12345}
+
+	addr1 := testInterceptAddress(t, "10.0.0.1", "tcp", 22, 22)
+	addr2 := testInterceptAddress(t, "10.0.0.2", "tcp", 22, 22)
+	req.NoError(fw.addRules(addr1, "svc1", port))
+
+	nl.setFail(true)
+
+	req.Error(fw.addRules(addr2, "svc2", port))
+	_, rules := fw.ruleset()
+	req.Len(rules, 1)
+	req.Equal("svc1", testRuleComment(t, rules[0]))
+
+	req.Error(fw.removeRules(addr1))
+	_, rules = fw.ruleset()
+	req.Len(rules, 1)
+	req.Equal("svc1", testRuleComment(t, rules[0]))
+}
+
+func Test_NftablesRejectsIPv6(t *testing.T) {
+	req := require.New(t)
+
+	fw, _ := newTestNftablesFirewall(t, "")
+	port := &TCPIPPortAddr{IP: net.ParseIP("127.0.0.1"), Port: 12345}
+
+	req.Error(fw.addRules(testInterceptAddress(t, "fd00::1", "tcp", 80, 80), "svc1", port))
+	_, rules := fw.ruleset()
+	req.Empty(rules)
+}
@@ -18,9 +18,16 @@ package tproxy
 
 import "time"
 
+const (
+	FirewallAuto     = "auto"
+	FirewallIptables = "iptables"
+	FirewallNftables = "nftables"
+)
+
 type Config struct {
 	LanIf            string
 	Diverter         string
+	Firewall         string // one of auto, iptables or nftables. ignored if Diverter is set
 	UDPIdleTimeout   time.Duration
 	UDPCheckInterval time.Duration
 }
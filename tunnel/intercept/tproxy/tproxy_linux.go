@@ -25,7 +25,6 @@ import (
 	"syscall"
 	"time"
 
-	"github.com/coreos/go-iptables/iptables"
 	"github.com/michaelquigley/pfxlog"
 	"github.com/openziti/foundation/v2/info"
 	"github.com/openziti/foundation/v2/mempool"
@@ -86,7 +85,6 @@ func New(config Config) (intercept.Interceptor, error) {
 		udpIdleTimeout:   config.UDPIdleTimeout,
 		udpCheckInterval: config.UDPCheckInterval,
 		serviceProxies:   cmap.New[*tProxy](),
-		ipt:              nil,
 	}
 
 	if self.udpIdleTimeout < 5*time.Second {
@@ -122,29 +120,20 @@ func New(config Config) (intercept.Interceptor, error) {
 		return self, nil
 	}
 
-	ipt, err := iptables.New()
-	if err != nil {
-		return nil, errors.Wrap(err, "tproxy: failed to initialize iptables handle")
-	}
-	self.ipt = ipt
-	if err := self.addIptablesChain(self.ipt, mangleTable, "PREROUTING", dstChain); err != nil {
-		return nil, err
-	}
-
 	if self.lanIf != "" {
-		_, err := net.InterfaceByName(self.lanIf)
-		if err != nil {
+		if _, err = net.InterfaceByName(self.lanIf); err != nil {
 			return nil, fmt.Errorf("invalid lanIf '%s'", self.lanIf)
 		}
-		err = self.addIptablesChain(self.ipt, filterTable, "INPUT", dstChain)
-		if err != nil {
-			return nil, err
-		}
 	} else {
 		logrus.Infof("no lan interface specified with '-lanIf'. please ensure firewall accepts intercepted service addresses")
 	}
 
-	return self, err
+	if self.fw, err = newFirewall(config.Firewall, self.lanIf); err != nil {
+		return nil, err
+	}
+	log.Infof("tproxy config: firewall         =  [%s]", self.fw)
+
+	return self, nil
 }
 
 type alwaysRemoveAddressTracker struct{}
@@ -157,12 +146,12 @@ func (a alwaysRemoveAddressTracker) RemoveAddress(string) bool {
 
 type interceptor struct {
 	lanIf            string
-	diverter         string // external tproxy configuration utility. use internal iptables implementation if not specified.
+	diverter         string // external tproxy configuration utility. use internal firewall implementation if not specified.
 	udpIdleTimeout   time.Duration
 	udpCheckInterval time.Duration
 
 	serviceProxies cmap.ConcurrentMap[string, *tProxy]
-	ipt            *iptables.IPTables
+	fw             firewall
 }
 
 func (self *interceptor) Stop() {
@@ -200,10 +189,7 @@ func (self *interceptor) cleanupChains() {
 		return
 	}
 	if self.serviceProxies.IsEmpty() {
-		deleteIptablesChain(self.ipt, mangleTable, "PREROUTING", dstChain)
-		if self.lanIf != "" {
-			deleteIptablesChain(self.ipt, filterTable, "INPUT", dstChain)
-		}
+		self.fw.cleanup()
 	}
 }
 
@@ -258,29 +244,6 @@ func (self *interceptor) newTproxy(service *entities.Service, resolver dns.Resol
 	return t, t.Intercept(resolver, tracker)
 }
 
-func (self *interceptor) addIptablesChain(ipt *iptables.IPTables, table, srcChain, dstChain string) error {
-	chains, err := ipt.ListChains(table)
-	if err != nil {
-		return fmt.Errorf("failed to list iptables %s chains: %v", table, err)
-	}
-
-	if !stringz.Contains(chains, dstChain) {
-		err = ipt.NewChain(table, dstChain)
-		if err != nil {
-			return fmt.Errorf("failed to create iptables chain: %v", err)
-		}
-	}
-
-	err = ipt.AppendUnique(table, srcChain, []string{"-j", dstChain}...)
-	if err != nil {
-		return errors.Wrapf(err, "failed to create '%v' link: '%v' --> '%v'", table, srcChain, dstChain)
-	} else {
-		pfxlog.Logger().Infof("added iptables '%v' link '%v' --> '%v'", table, srcChain, dstChain)
-	}
-
-	return nil
-}
-
 type tProxy struct {
 	interceptor *interceptor
 	service     *entities.Service
@@ -291,12 +254,6 @@ type tProxy struct {
 	resolver    dns.Resolver
 }
 
-const (
-	mangleTable = "mangle"
-	filterTable = "filter"
-	dstChain    = "NF-INTERCEPT"
-)
-
 func (self *tProxy) acceptTCP() {
 	log := pfxlog.Logger()
 	for {
@@ -404,23 +361,6 @@ func getOriginalDest(oob []byte) (*net.UDPAddr, error) {
 	return nil, fmt.Errorf("original destination not found in out of band data")
 }
 
-func deleteIptablesChain(ipt *iptables.IPTables, table, srcChain, dstChain string) {
-	log := pfxlog.Logger().WithField("chain", dstChain)
-	log.Infof("removing iptables '%v' link '%v' --> '%v'", table, srcChain, dstChain)
-
-	if err := ipt.Delete(table, srcChain, []string{"-j", dstChain}...); err != nil {
-		log.WithError(err).Error("failed to unlink chain")
-	}
-
-	if err := ipt.ClearChain(table, dstChain); err != nil {
-		log.WithError(err).Error("failed to clear chain")
-	}
-
-	if err := ipt.DeleteChain(table, dstChain); err != nil {
-		log.WithError(err).Error("failed to delete chain")
-	}
-}
-
 func (self *tProxy) Stop(tracker intercept.AddressTracker) {
 	log := pfxlog.Logger().WithField("service", *self.service.Name)
 	if self.tcpLn != nil {
@@ -541,37 +481,8 @@ func (self *tProxy) addInterceptAddr(interceptAddr *intercept.InterceptAddress,
 		} else {
 			cmdLogger.Infof("diverter command succeeded. output: %s", out)
 		}
-	} else {
-		interceptAddr.TproxySpec = []string{
-			"-m", "comment", "--comment", *service.Name,
-			"-d", ipNet.String(),
-			"-p", interceptAddr.Proto(),
-			"--dport", fmt.Sprintf("%v:%v", interceptAddr.LowPort(), interceptAddr.HighPort()),
-			"-j", "TPROXY",
-			"--tproxy-mark", "0x1/0x1",
-			fmt.Sprintf("--on-ip=%s", port.GetIP().String()),
-			fmt.Sprintf("--on-port=%d", port.GetPort()),
-		}
-
-		pfxlog.Logger().Infof("Adding rule iptables -t %v -A %v %v", mangleTable, dstChain, interceptAddr.TproxySpec)
-		if err := self.interceptor.ipt.Insert(mangleTable, dstChain, 1, interceptAddr.TproxySpec...); err != nil {
-			return errors.Wrap(err, "failed to insert rule")
-		}
-
-		if self.interceptor.lanIf != "" {
-			interceptAddr.AcceptSpec = []string{
-				"-i", self.interceptor.lanIf,
-				"-m", "comment", "--comment", *service.Name,
-				"-d", ipNet.String(),
-				"-p", interceptAddr.Proto(),
-				"--dport", fmt.Sprintf("%v:%v", interceptAddr.LowPort(), interceptAddr.HighPort()),
-				"-j", "ACCEPT",
-			}
-			pfxlog.Logger().Infof("Adding rule iptables -t %v -A %v %v", filterTable, dstChain, interceptAddr.AcceptSpec)
-			if err := self.interceptor.ipt.Insert(filterTable, dstChain, 1, interceptAddr.AcceptSpec...); err != nil {
-				return errors.Wrap(err, "failed to insert rule")
-			}
-		}
+	} else if err := self.interceptor.fw.addRules(interceptAddr, *service.Name, port); err != nil {
+		return err
 	}
 
 	return nil
@@ -603,21 +514,9 @@ func (self *tProxy) StopIntercepting(tracker intercept.AddressTracker) error {
 			} else {
 				cmdLogger.Infof("diverter command succeeded. output: %s", out)
 			}
-		} else {
-			log.Infof("Removing rule iptables -t %v -A %v %v", mangleTable, dstChain, addr.TproxySpec)
-			err := self.interceptor.ipt.Delete(mangleTable, dstChain, addr.TproxySpec...)
-			if err != nil {
-				errorList = append(errorList, err)
-				log.WithError(err).Errorf("failed to remove iptables rule for service %s", *self.service.Name)
-			}
-			if self.interceptor.lanIf != "" {
-				pfxlog.Logger().Infof("Removing rule iptables -t %v -A %v %v", filterTable, dstChain, addr.TproxySpec)
-				err = self.interceptor.ipt.Delete(filterTable, dstChain, addr.AcceptSpec...)
-				if err != nil {
-					errorList = append(errorList, err)
-					log.WithError(err).Errorf("failed to remove iptables rule for service %s", *self.service.Name)
-				}
-			}
+		} else if err := self.interceptor.fw.removeRules(addr); err != nil {
+			errorList = append(errorList, err)
+			log.WithError(err).Errorf("failed to remove %s rule for service %s", self.interceptor.fw, *self.service.Name)
 		}
 
 		ipNet := addr.IpNet()
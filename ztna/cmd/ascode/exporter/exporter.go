@@ -43,6 +43,8 @@ type Exporter struct {
 	client           *rest_management_api_client.ZitiEdgeManagement
 	ofJson           bool
 	ofYaml           bool
+	ofHcl            bool
+	ofCrd            bool
 	file             *os.File
 	filename         string
 	configCache      map[string]any
@@ -62,6 +64,7 @@ func NewExportCmd(out io.Writer, errOut io.Writer) *cobra.Command {
 		Use:   "export [entity]",
 		Short: "Export entities",
 		Long: "Export all or selected entities.\n" +
+			"Output is JSON by default, or YAML, Terraform HCL or Kubernetes custom resources, which refer to other entities by name.\n" +
 			"Valid entities are: [all|ca/certificate-authority|identity|edge-router|service|config|config-type|service-policy|edge-router-policy|service-edge-router-policy|external-jwt-signer|auth-policy|posture-check] (default all)",
 		Args: cobra.MinimumNArgs(0),
 		PersistentPreRun: func(cmd *cobra.Command, args []string) {
@@ -97,7 +100,9 @@ func NewExportCmd(out io.Writer, errOut io.Writer) *cobra.Command {
 	cmd.Flags().BoolVar(&exporter.ofJson, "json", true, "Output in JSON")
 	cmd.Flags().BoolVar(&exporter.ofYaml, "yaml", false, "Output in YAML")
 	cmd.Flags().StringVar(&exporter.loginOpts.ControllerUrl, "controller-url", "", "The url of the controller")
-	cmd.MarkFlagsMutuallyExclusive("json", "yaml")
+	cmd.Flags().BoolVar(&exporter.ofHcl, "hcl", false, "Output as Terraform HCL resources")
+	cmd.Flags().BoolVar(&exporter.ofCrd, "crd", false, "Output as Kubernetes custom resource manifests")
+	cmd.MarkFlagsMutuallyExclusive("json", "yaml", "hcl", "crd")
 
 	cmd.Flags().StringVarP(&exporter.filename, "output-file", "o", "", "Write output to local file")
 
@@ -125,13 +130,13 @@ func (exporter *Exporter) Init(out io.Writer) error {
 	}
 
 	if exporter.filename != "" {
-		o, err := NewOutputToFile(exporter.loginOpts.Verbose, exporter.ofJson, exporter.ofYaml, exporter.filename, exporter.loginOpts.Err)
+		o, err := NewOutputToFile(exporter.loginOpts.Verbose, exporter.ofJson, exporter.ofYaml, exporter.ofHcl, exporter.ofCrd, exporter.filename, exporter.loginOpts.Err)
 		if err != nil {
 			return err
 		}
 		output = *o
 	} else {
-		o, err := NewOutputToWriter(exporter.loginOpts.Verbose, exporter.ofJson, exporter.ofYaml, out, exporter.loginOpts.Err)
+		o, err := NewOutputToWriter(exporter.loginOpts.Verbose, exporter.ofJson, exporter.ofYaml, exporter.ofHcl, exporter.ofCrd, out, exporter.loginOpts.Err)
 		if err != nil {
 			return err
 		}
@@ -76,7 +76,7 @@ func (exporter Exporter) GetServicePolicies() ([]map[string]interface{}, error)
 			m["serviceRoles"] = serviceRoles
 			postureCheckRoles := []string{}
 			for _, role := range item.PostureCheckRolesDisplay {
-				identityRoles = append(identityRoles, role.Name)
+				postureCheckRoles = append(postureCheckRoles, role.Name)
 			}
 			m["postureCheckRoles"] = postureCheckRoles
 
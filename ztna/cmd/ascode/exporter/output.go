@@ -29,29 +29,33 @@ import (
 type Output struct {
 	outputJson bool
 	outputYaml bool
+	outputHcl  bool
+	outputCrd  bool
 	filename   string
 	writer     *bufio.Writer
 	errWriter  io.Writer
 	verbose    bool
 }
 
-func NewOutputToFile(verbose bool, outputJson bool, outputYaml bool, filename string, errWriter io.Writer) (*Output, error) {
+func NewOutputToFile(verbose bool, outputJson bool, outputYaml bool, outputHcl bool, outputCrd bool, filename string, errWriter io.Writer) (*Output, error) {
 	file, err := os.Create(filename)
 	if err != nil {
 		log.WithError(err).Error("Error creating file for writing")
 		return nil, err
 	}
 	writer := bufio.NewWriter(file)
-	output, err := NewOutputToWriter(verbose, outputJson, outputYaml, writer, errWriter)
+	output, err := NewOutputToWriter(verbose, outputJson, outputYaml, outputHcl, outputCrd, writer, errWriter)
 	output.filename = filename
 	return output, err
 }
 
-func NewOutputToWriter(verbose bool, outputJson bool, outputYaml bool, writer io.Writer, errWriter io.Writer) (*Output, error) {
+func NewOutputToWriter(verbose bool, outputJson bool, outputYaml bool, outputHcl bool, outputCrd bool, writer io.Writer, errWriter io.Writer) (*Output, error) {
 	output := Output{}
 	output.verbose = verbose
 	output.outputJson = outputJson
 	output.outputYaml = outputYaml
+	output.outputHcl = outputHcl
+	output.outputCrd = outputCrd
 	output.writer = bufio.NewWriter(writer)
 	output.errWriter = errWriter
 	return &output, nil
@@ -60,7 +64,17 @@ func NewOutputToWriter(verbose bool, outputJson bool, outputYaml bool, writer io
 func (output Output) Write(data any) error {
 	var formatted []byte
 	var err error
-	if output.outputYaml {
+	if output.outputHcl {
+		if output.verbose {
+			_, _ = internal.FPrintfReusingLine(output.errWriter, "Formatting as HCL\r\n")
+		}
+		formatted, err = output.ToHcl(data)
+	} else if output.outputCrd {
+		if output.verbose {
+			_, _ = internal.FPrintfReusingLine(output.errWriter, "Formatting as Kubernetes resources\r\n")
+		}
+		formatted, err = output.ToCrd(data)
+	} else if output.outputYaml {
 		if output.verbose {
 			_, _ = internal.FPrintfReusingLine(output.errWriter, "Formatting as Yaml\r\n")
 		}
@@ -0,0 +1,374 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package exporter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"gopkg.in/yaml.v3"
+)
+
+const CrdApiVersion = "ascode.ztna.io/v1alpha1"
+
+// iacKind gives the Terraform resource type and Kubernetes kind of the entities under an export key
+type iacKind struct {
+	key      string
+	resource string
+	kind     string
+}
+
+// iacKinds is in dependency order, so that referenced entities are written before the entities referencing them
+var iacKinds = []iacKind{
+	{"certificateAuthorities", "ztna_certificate_authority", "CertificateAuthority"},
+	{"externalJwtSigners", "ztna_external_jwt_signer", "ExternalJwtSigner"},
+	{"authPolicies", "ztna_auth_policy", "AuthPolicy"},
+	{"identities", "ztna_identity", "Identity"},
+	{"configTypes", "ztna_config_type", "ConfigType"},
+	{"configs", "ztna_config", "Config"},
+	{"services", "ztna_service", "Service"},
+	{"postureChecks", "ztna_posture_check", "PostureCheck"},
+	{"edgeRouters", "ztna_edge_router", "EdgeRouter"},
+	{"serviceEdgeRouterPolicies", "ztna_service_edge_router_policy", "ServiceEdgeRouterPolicy"},
+	{"servicePolicies", "ztna_service_policy", "ServicePolicy"},
+	{"edgeRouterPolicies", "ztna_edge_router_policy", "EdgeRouterPolicy"},
+}
+
+// iacReferences maps the fields holding @name references to the export key of the entities they refer to
+var iacReferences = map[string]string{
+	"identityRoles":       "identities",
+	"serviceRoles":        "services",
+	"edgeRouterRoles":     "edgeRouters",
+	"postureCheckRoles":   "postureChecks",
+	"configs":             "configs",
+	"configType":          "configTypes",
+	"authPolicy":          "authPolicies",
+	"allowedSigners":      "externalJwtSigners",
+	"requireExtJwtSigner": "externalJwtSigners",
+}
+
+// iacVerbatimFields hold user defined keys, which are written as is rather than converted to snake case
+var iacVerbatimFields = map[string]bool{
+	"tags":   true,
+	"data":   true,
+	"schema": true,
+}
+
+// iacJsonFields hold free form documents, which are written to HCL as jsonencode() of an object
+var iacJsonFields = map[string]bool{
+	"data":   true,
+	"schema": true,
+}
+
+var hclIdentifier = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_-]*$`)
+
+// ToHcl writes the entities as Terraform resources, named after the entities. References to exported entities are
+// written as interpolations of the referenced resource's name, so that Terraform orders them correctly.
+func (output Output) ToHcl(data any) ([]byte, error) {
+	entities, err := toIacEntities(data)
+	if err != nil {
+		return nil, err
+	}
+
+	labels := map[string]map[string]string{}
+	for _, kind := range iacKinds {
+		labels[kind.key] = uniqueNames(entities[kind.key], hclLabel, "_")
+	}
+
+	w := &hclWriter{labels: labels}
+	for _, kind := range iacKinds {
+		for _, entity := range entities[kind.key] {
+			name, _ := entity["name"].(string)
+			w.printf("resource %q %q {\n", kind.resource, labels[kind.key][name])
+			w.writeAttributes(entity, 1, false)
+			w.printf("}\n\n")
+		}
+	}
+
+	return append(bytes.TrimRight(w.buf.Bytes(), "\n"), '\n'), nil
+}
+
+// ToCrd writes the entities as Kubernetes custom resources, one YAML document each. The spec is the entity as
+// exported, so references remain @name references.
+func (output Output) ToCrd(data any) ([]byte, error) {
+	entities, err := toIacEntities(data)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := &bytes.Buffer{}
+	for _, kind := range iacKinds {
+		names := uniqueNames(entities[kind.key], k8sName, "-")
+		for _, entity := range entities[kind.key] {
+			name, _ := entity["name"].(string)
+			resource := crdResource{
+				ApiVersion: CrdApiVersion,
+				Kind:       kind.kind,
+				Metadata: crdMetadata{
+					Name:        names[name],
+					Annotations: map[string]string{"ascode.ztna.io/name": name},
+				},
+				Spec: entity,
+			}
+
+			doc, err := yaml.Marshal(resource)
+			if err != nil {
+				log.WithError(err).Error("Error writing data as Kubernetes resources")
+				return nil, err
+			}
+			buf.WriteString("---\n")
+			buf.Write(doc)
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+type crdResource struct {
+	ApiVersion string         `yaml:"apiVersion"`
+	Kind       string         `yaml:"kind"`
+	Metadata   crdMetadata    `yaml:"metadata"`
+	Spec       map[string]any `yaml:"spec"`
+}
+
+type crdMetadata struct {
+	Name        string            `yaml:"name"`
+	Annotations map[string]string `yaml:"annotations,omitempty"`
+}
+
+// toIacEntities normalizes the export result to plain json types, keyed by export key
+func toIacEntities(data any) (map[string][]map[string]any, error) {
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string][]map[string]any{}
+	if err = json.Unmarshal(jsonData, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// uniqueNames maps each entity name to a sanitized name, made unique amongst the entities by a numeric suffix
+func uniqueNames(entities []map[string]any, sanitize func(string) string, separator string) map[string]string {
+	result := map[string]string{}
+	used := map[string]bool{}
+	for _, entity := range entities {
+		name, _ := entity["name"].(string)
+		base := sanitize(name)
+		unique := base
+		for i := 2; used[unique]; i++ {
+			unique = fmt.Sprintf("%s%s%d", base, separator, i)
+		}
+		used[unique] = true
+		result[name] = unique
+	}
+	return result
+}
+
+func hclLabel(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_') {
+			b.WriteRune(r)
+		} else {
+			b.WriteRune('_')
+		}
+	}
+	label := b.String()
+	if label == "" || unicode.IsDigit(rune(label[0])) {
+		label = "_" + label
+	}
+	return label
+}
+
+// k8sName converts a name to a valid DNS subdomain, as required of Kubernetes resource names
+func k8sName(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r) || r == '.') {
+			b.WriteRune(r)
+		} else {
+			b.WriteRune('-')
+		}
+	}
+	result := strings.Trim(b.String(), "-.")
+	if len(result) > 240 {
+		result = strings.Trim(result[:240], "-.")
+	}
+	if result == "" {
+		result = "unnamed"
+	}
+	return result
+}
+
+func snakeCase(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteRune('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+type hclWriter struct {
+	buf    bytes.Buffer
+	labels map[string]map[string]string
+}
+
+func (self *hclWriter) printf(format string, args ...any) {
+	_, _ = fmt.Fprintf(&self.buf, format, args...)
+}
+
+func (self *hclWriter) indent(depth int) {
+	self.buf.WriteString(strings.Repeat("  ", depth))
+}
+
+// writeAttributes writes the fields of an object in sorted order, one per line. Keys are converted to snake case
+// unless verbatim.
+func (self *hclWriter) writeAttributes(m map[string]any, depth int, verbatim bool) {
+	keys := make([]string, 0, len(m))
+	for k, v := range m {
+		if v != nil {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		self.indent(depth)
+		if verbatim {
+			self.writeKey(k)
+		} else {
+			self.buf.WriteString(snakeCase(k))
+		}
+		self.buf.WriteString(" = ")
+
+		switch {
+		case iacJsonFields[k] && !verbatim:
+			self.buf.WriteString("jsonencode(")
+			self.writeValue(m[k], depth, true, "")
+			self.buf.WriteString(")")
+		case verbatim:
+			self.writeValue(m[k], depth, true, "")
+		default:
+			self.writeValue(m[k], depth, iacVerbatimFields[k], iacReferences[k])
+		}
+		self.buf.WriteString("\n")
+	}
+}
+
+func (self *hclWriter) writeKey(k string) {
+	if hclIdentifier.MatchString(k) {
+		self.buf.WriteString(k)
+	} else {
+		self.buf.WriteString(hclString(k))
+	}
+}
+
+// writeValue writes a value, resolving @name references to entities under refKey to their resources
+func (self *hclWriter) writeValue(value any, depth int, verbatim bool, refKey string) {
+	switch v := value.(type) {
+	case nil:
+		self.buf.WriteString("null")
+	case bool:
+		self.buf.WriteString(strconv.FormatBool(v))
+	case float64:
+		self.buf.WriteString(strconv.FormatFloat(v, 'f', -1, 64))
+	case string:
+		self.buf.WriteString(self.reference(v, refKey))
+	case []any:
+		self.buf.WriteString("[")
+		for i, item := range v {
+			if i > 0 {
+				self.buf.WriteString(", ")
+			}
+			self.writeValue(item, depth, verbatim, refKey)
+		}
+		self.buf.WriteString("]")
+	case map[string]any:
+		if len(v) == 0 {
+			self.buf.WriteString("{}")
+			return
+		}
+		self.buf.WriteString("{\n")
+		self.writeAttributes(v, depth+1, verbatim)
+		self.indent(depth)
+		self.buf.WriteString("}")
+	default:
+		self.buf.WriteString(hclString(fmt.Sprint(v)))
+	}
+}
+
+func (self *hclWriter) reference(value, refKey string) string {
+	if refKey == "" || !strings.HasPrefix(value, "@") {
+		return hclString(value)
+	}
+
+	kind := ""
+	for _, k := range iacKinds {
+		if k.key == refKey {
+			kind = k.resource
+		}
+	}
+
+	label, found := self.labels[refKey][strings.TrimPrefix(value, "@")]
+	if !found || kind == "" {
+		return hclString(value)
+	}
+	return fmt.Sprintf(`"@${%s.%s.name}"`, kind, label)
+}
+
+// hclString quotes a string, escaping template sequences so that it is written literally
+func hclString(value string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for i, r := range value {
+		switch {
+		case r == '"' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r == '\n':
+			b.WriteString(`\n`)
+		case r == '\r':
+			b.WriteString(`\r`)
+		case r == '\t':
+			b.WriteString(`\t`)
+		case r < 0x20:
+			_, _ = fmt.Fprintf(&b, `\u%04x`, r)
+		case (r == '$' || r == '%') && strings.HasPrefix(value[i+1:], "{"):
+			b.WriteRune(r)
+			b.WriteRune(r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
@@ -0,0 +1,73 @@
+package exporter
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func testIacData() map[string]interface{} {
+	return map[string]interface{}{
+		"configTypes": []map[string]interface{}{
+			{"name": "intercept.v1", "schema": map[string]interface{}{"type": "object"}},
+		},
+		"configs": []map[string]interface{}{
+			{"name": "web intercept", "configType": "@intercept.v1", "data": map[string]interface{}{"portRanges": []interface{}{80}}},
+		},
+		"services": []map[string]interface{}{
+			{"name": "web", "configs": []string{"@web intercept"}, "roleAttributes": []string{"http"},
+				"tags": map[string]interface{}{"owner": "team-${a}"}},
+		},
+		"servicePolicies": []map[string]interface{}{
+			{"name": "web-dial", "type": "Dial", "serviceRoles": []string{"@web", "#http"},
+				"identityRoles": []string{"@missing"}, "postureCheckRoles": []string{}},
+		},
+	}
+}
+
+func TestToHcl(t *testing.T) {
+	req := require.New(t)
+
+	out, err := Output{}.ToHcl(testIacData())
+	req.NoError(err)
+	hcl := string(out)
+
+	req.Contains(hcl, `resource "ztna_config_type" "intercept_v1" {`)
+	req.Contains(hcl, `resource "ztna_config" "web_intercept" {`)
+	req.Contains(hcl, `config_type = "@${ztna_config_type.intercept_v1.name}"`)
+	req.Contains(hcl, "data = jsonencode({\n    portRanges = [80]\n  })")
+	req.Contains(hcl, `configs = ["@${ztna_config.web_intercept.name}"]`)
+	req.Contains(hcl, `owner = "team-$${a}"`)
+	req.Contains(hcl, `service_roles = ["@${ztna_service.web.name}", "#http"]`)
+	req.Contains(hcl, `identity_roles = ["@missing"]`)
+
+	// referenced resources are written first
+	req.Less(strings.Index(hcl, `"ztna_config_type"`), strings.Index(hcl, `"ztna_config"`))
+	req.Less(strings.Index(hcl, `"ztna_service"`), strings.Index(hcl, `"ztna_service_policy"`))
+}
+
+func TestToCrd(t *testing.T) {
+	req := require.New(t)
+
+	out, err := Output{}.ToCrd(testIacData())
+	req.NoError(err)
+
+	docs := strings.Split(strings.TrimPrefix(string(out), "---\n"), "---\n")
+	req.Len(docs, 4)
+
+	resource := crdResource{}
+	req.NoError(yaml.Unmarshal([]byte(docs[1]), &resource))
+	req.Equal(CrdApiVersion, resource.ApiVersion)
+	req.Equal("Config", resource.Kind)
+	req.Equal("web-intercept", resource.Metadata.Name)
+	req.Equal("web intercept", resource.Metadata.Annotations["ascode.ztna.io/name"])
+	req.Equal("@intercept.v1", resource.Spec["configType"])
+}
+
+func TestUniqueNames(t *testing.T) {
+	entities := []map[string]any{{"name": "a b"}, {"name": "a-b"}, {"name": "A_B"}}
+	names := uniqueNames(entities, hclLabel, "_")
+	require.Equal(t, map[string]string{"a b": "a_b", "a-b": "a_b_2", "A_B": "a_b_3"}, names)
+}
@@ -21,10 +21,12 @@ import (
 	"errors"
 	"io"
 	"os"
+	"sort"
 	"strings"
 
 	"ztna-core/edge-api/rest_management_api_client"
 	"ztna-core/ztna/internal"
+	"ztna-core/ztna/internal/rest/mgmt"
 	"ztna-core/ztna/ztna/cmd/edge"
 	"ztna-core/ztna/ztna/constants"
 
@@ -45,11 +47,18 @@ type Importer struct {
 	ofJson             bool
 	ofYaml             bool
 	configCache        map[string]any
+	configTypeCache    map[string]any
 	serviceCache       map[string]any
 	edgeRouterCache    map[string]any
 	authPolicyCache    map[string]any
 	extJwtSignersCache map[string]any
 	identityCache      map[string]any
+	postureCheckCache  map[string]any
+	upsert             bool
+	prune              bool
+	pruneTag           string
+	dryRun             bool
+	plan               *Plan
 }
 
 func NewImportCmd(out io.Writer, errOut io.Writer) *cobra.Command {
@@ -61,6 +70,7 @@ func NewImportCmd(out io.Writer, errOut io.Writer) *cobra.Command {
 		Use:   "import filename [entity]",
 		Short: "Import entities",
 		Long: "Import all or selected entities from the specified file.\n" +
+			"Existing entities are skipped unless --upsert is given, in which case they are updated to match the file.\n" +
 			"Valid entities are: [all|ca/certificate-authority|identity|edge-router|service|config|config-type|service-policy|edge-router-policy|service-edge-router-policy|external-jwt-signer|auth-policy|posture-check] (default all)",
 		Args: cobra.MinimumNArgs(1),
 		PersistentPreRun: func(cmd *cobra.Command, args []string) {
@@ -89,6 +99,9 @@ func NewImportCmd(out io.Writer, errOut io.Writer) *cobra.Command {
 			if executeErr != nil {
 				panic(executeErr)
 			}
+			if importer.dryRun {
+				importer.plan.Print(out)
+			}
 			log.WithField("results", result).Debug("Finished")
 		},
 		Hidden: true,
@@ -104,6 +117,10 @@ func NewImportCmd(out io.Writer, errOut io.Writer) *cobra.Command {
 	cmd.Flags().BoolVar(&importer.ofJson, "json", true, "Input parsed as JSON")
 	cmd.Flags().BoolVar(&importer.ofYaml, "yaml", false, "Input parsed as YAML")
 	cmd.Flags().StringVar(&importer.loginOpts.ControllerUrl, "controller-url", "", "The url of the controller")
+	cmd.Flags().BoolVar(&importer.upsert, "upsert", false, "Update existing entities which differ from the input")
+	cmd.Flags().BoolVar(&importer.prune, "prune", false, "Delete entities carrying the prune tag which are not in the input. Deletes are not undone if the import fails")
+	cmd.Flags().StringVar(&importer.pruneTag, "prune-tag", "", "A key=value tag added to every imported entity, scoping which entities --prune may delete")
+	cmd.Flags().BoolVar(&importer.dryRun, "dry-run", false, "Print the entities which would be created, updated and deleted, without changing anything")
 	cmd.MarkFlagsMutuallyExclusive("json", "yaml")
 
 	edge.AddLoginFlags(cmd, &importer.loginOpts)
@@ -135,176 +152,255 @@ func (importer *Importer) Execute(data map[string][]interface{}, inputArgs []str
 
 	args := arrayutils.Map(inputArgs, strings.ToLower)
 
+	if importer.prune && importer.pruneTag == "" {
+		return nil, errors.New("prune requires a prune tag, to scope the entities which may be deleted")
+	}
+
+	if importer.pruneTag != "" {
+		if err := tagInput(data, importer.pruneTag); err != nil {
+			return nil, err
+		}
+	}
+
+	// plan the whole import before changing anything, so that bad input or unresolvable references fail it up front
+	dryRun := importer.dryRun
+	importer.dryRun = true
+	result, err := importer.execute(data, args)
+	importer.dryRun = dryRun
+	if err != nil || dryRun {
+		return result, err
+	}
+
+	result, err = importer.execute(data, args)
+	if err != nil {
+		importer.rollback()
+		return nil, err
+	}
+
+	log.Info("Upload complete")
+
+	return result, nil
+}
+
+func (importer *Importer) execute(data map[string][]interface{}, args []string) (map[string]any, error) {
 	importer.configCache = map[string]any{}
+	importer.configTypeCache = map[string]any{}
 	importer.serviceCache = map[string]any{}
 	importer.edgeRouterCache = map[string]any{}
 	importer.authPolicyCache = map[string]any{}
 	importer.extJwtSignersCache = map[string]any{}
 	importer.identityCache = map[string]any{}
+	importer.postureCheckCache = map[string]any{}
+	importer.plan = &Plan{}
 
 	result := map[string]any{}
 
-	cas := map[string]string{}
-	if importer.IsCertificateAuthorityImportRequired(args) {
-		log.Debug("Processing CertificateAuthorities")
-		var err error
-		cas, err = importer.ProcessCertificateAuthorities(data)
-		if err != nil {
-			return nil, err
+	for _, entity := range importEntities {
+		created := map[string]string{}
+		if entity.required(importer, args) {
+			log.Debugf("Processing %s", entity.plural)
+			var err error
+			created, err = entity.process(importer, data)
+			if err != nil {
+				return nil, err
+			}
+			log.WithField(entity.inputKey, created).Debugf("%s created", entity.plural)
 		}
-		log.
-			WithField("certificateAuthorities", cas).
-			Debug("CertificateAuthorities created")
-	}
-	result["certificateAuthorities"] = cas
-	_, _ = internal.FPrintfReusingLine(importer.loginOpts.Err, "Created %d CertificateAuthorities\r\n", len(cas))
-
-	externalJwtSigners := map[string]string{}
-	if importer.IsExtJwtSignerImportRequired(args) {
-		log.Debug("Processing ExtJWTSigners")
-		var err error
-		externalJwtSigners, err = importer.ProcessExternalJwtSigners(data)
-		if err != nil {
-			return nil, err
+		if !importer.dryRun {
+			_, _ = internal.FPrintfReusingLine(importer.loginOpts.Err, "Created %d %s\r\n", len(created), entity.plural)
 		}
-		log.WithField("externalJwtSigners", externalJwtSigners).Debug("ExtJWTSigners created")
+		result[entity.inputKey] = created
 	}
-	_, _ = internal.FPrintfReusingLine(importer.loginOpts.Err, "Created %d ExtJWTSigners\r\n", len(externalJwtSigners))
-	result["externalJwtSigners"] = externalJwtSigners
-
-	authPolicies := map[string]string{}
-	if importer.IsAuthPolicyImportRequired(args) {
-		log.Debug("Processing AuthPolicies")
-		var err error
-		authPolicies, err = importer.ProcessAuthPolicies(data)
-		if err != nil {
+
+	if importer.prune {
+		if err := importer.pruneEntities(data, args); err != nil {
 			return nil, err
 		}
-		log.WithField("authPolicies", authPolicies).Debug("AuthPolicies created")
 	}
-	_, _ = internal.FPrintfReusingLine(importer.loginOpts.Err, "Created %d AuthPolicies\r\n", len(authPolicies))
-	result["authPolicies"] = authPolicies
-
-	identities := map[string]string{}
-	if importer.IsIdentityImportRequired(args) {
-		log.Debug("Processing Identities")
-		var err error
-		identities, err = importer.ProcessIdentities(data)
-		if err != nil {
-			return nil, err
+
+	result["plan"] = importer.plan
+
+	return result, nil
+}
+
+// pruneEntities deletes the entities carrying the prune tag which aren't in the input, dependents first
+func (importer *Importer) pruneEntities(data map[string][]interface{}, args []string) error {
+	key, value, _ := strings.Cut(importer.pruneTag, "=")
+	filter := mgmt.TagFilter(key, value)
+
+	for i := len(importEntities) - 1; i >= 0; i-- {
+		entity := importEntities[i]
+		if !entity.required(importer, args) {
+			continue
 		}
-		log.WithField("identities", identities).Debug("Identities created")
-	}
-	_, _ = internal.FPrintfReusingLine(importer.loginOpts.Err, "Created %d Identities\r\n", len(identities))
-	result["identities"] = identities
-
-	configTypes := map[string]string{}
-	if importer.IsConfigTypeImportRequired(args) {
-		log.Debug("Processing ConfigTypes")
-		var err error
-		configTypes, err = importer.ProcessConfigTypes(data)
+
+		existing, err := entity.list(importer, filter)
 		if err != nil {
-			return nil, err
+			return err
 		}
-		log.WithField("configTypes", configTypes).Debug("ConfigTypes created")
-	}
-	_, _ = internal.FPrintfReusingLine(importer.loginOpts.Err, "Created %d ConfigTypes\r\n", len(configTypes))
-	result["configTypes"] = configTypes
-
-	configs := map[string]string{}
-	if importer.IsConfigImportRequired(args) {
-		log.Debug("Processing Configs")
-		var err error
-		configs, err = importer.ProcessConfigs(data)
-		if err != nil {
-			return nil, err
+
+		desired := inputNames(data[entity.inputKey])
+
+		var names []string
+		for name := range existing {
+			if !desired[name] {
+				names = append(names, name)
+			}
 		}
-		log.WithField("configs", configs).Debug("Configs created")
-	}
-	_, _ = internal.FPrintfReusingLine(importer.loginOpts.Err, "Created %d Configs\r\n", len(configs))
-	result["configs"] = configs
-
-	services := map[string]string{}
-	if importer.IsServiceImportRequired(args) {
-		log.Debug("Processing Services")
-		var err error
-		services, err = importer.ProcessServices(data)
-		if err != nil {
-			return nil, err
+		sort.Strings(names)
+
+		for _, name := range names {
+			entry := importer.plan.add(PlanDelete, entity.name, name)
+			entry.Id = existing[name]
+			if importer.dryRun {
+				continue
+			}
+
+			_, _ = internal.FPrintfReusingLine(importer.loginOpts.Err, "Deleting %s %s\r", entity.name, name)
+			if err = entity.delete(importer, entry.Id); err != nil {
+				log.WithError(err).WithField("name", name).Errorf("Unable to delete %s", entity.name)
+				return err
+			}
+			entry.deleted = true
 		}
-		log.WithField("services", services).Debug("Services created")
 	}
-	_, _ = internal.FPrintfReusingLine(importer.loginOpts.Err, "Created %d Services\r\n", len(services))
-	result["services"] = services
-
-	postureChecks := map[string]string{}
-	if importer.IsPostureCheckImportRequired(args) {
-		log.Debug("Processing PostureChecks")
-		var err error
-		postureChecks, err = importer.ProcessPostureChecks(data)
-		if err != nil {
-			return nil, err
+
+	return nil
+}
+
+// rollback undoes the changes made by a failed import, newest first. Created entities are deleted and updated
+// entities are put back to the state they were read in. Pruned entities can't be brought back, as recreating them
+// would give them new ids and lose their enrollments, so they are only reported.
+func (importer *Importer) rollback() {
+	for i := len(importer.plan.Entries) - 1; i >= 0; i-- {
+		entry := importer.plan.Entries[i]
+		if entry.Id == "" {
+			continue
 		}
-		log.WithField("postureChecks", postureChecks).Debug("PostureChecks created")
-	}
-	_, _ = internal.FPrintfReusingLine(importer.loginOpts.Err, "Created %d PostureChecks\r\n", len(postureChecks))
-	result["postureChecks"] = postureChecks
-
-	routers := map[string]string{}
-	if importer.IsEdgeRouterImportRequired(args) {
-		log.Debug("Processing EdgeRouters")
-		var err error
-		routers, err = importer.ProcessEdgeRouters(data)
-		if err != nil {
-			return nil, err
+
+		switch entry.Action {
+		case PlanCreate:
+			entity := importEntityNamed(entry.EntityType)
+			if entity == nil {
+				continue
+			}
+			_, _ = internal.FPrintfReusingLine(importer.loginOpts.Err, "Rolling back %s %s\r", entry.EntityType, entry.Name)
+			if err := entity.delete(importer, entry.Id); err != nil {
+				log.WithError(err).WithField("name", entry.Name).Errorf("Unable to roll back create of %s", entry.EntityType)
+			}
+		case PlanUpdate:
+			if entry.revert == nil {
+				continue
+			}
+			_, _ = internal.FPrintfReusingLine(importer.loginOpts.Err, "Rolling back %s %s\r", entry.EntityType, entry.Name)
+			if err := entry.revert(); err != nil {
+				log.WithError(err).WithField("name", entry.Name).Errorf("Unable to roll back update of %s", entry.EntityType)
+			}
+		case PlanDelete:
+			if entry.deleted {
+				log.WithField("name", entry.Name).Warnf("Unable to roll back delete of %s, it must be recreated", entry.EntityType)
+			}
 		}
-		log.WithField("edgeRouters", routers).Debug("EdgeRouters created")
 	}
-	_, _ = internal.FPrintfReusingLine(importer.loginOpts.Err, "Created %d EdgeRouters\r\n", len(routers))
-	result["edgeRouters"] = routers
-
-	serviceEdgeRouterPolicies := map[string]string{}
-	if importer.IsServiceEdgeRouterPolicyImportRequired(args) {
-		log.Debug("Processing ServiceEdgeRouterPolicies")
-		var err error
-		serviceEdgeRouterPolicies, err = importer.ProcessServiceEdgeRouterPolicies(data)
-		if err != nil {
-			return nil, err
+}
+
+func importEntityNamed(name string) *importEntity {
+	for _, entity := range importEntities {
+		if entity.name == name {
+			return entity
 		}
-		log.WithField("serviceEdgeRouterPolicies", serviceEdgeRouterPolicies).Debug("ServiceEdgeRouterPolicies created")
 	}
-	_, _ = internal.FPrintfReusingLine(importer.loginOpts.Err, "Created %d ServiceEdgeRouterPolicies\r\n", len(serviceEdgeRouterPolicies))
-	result["serviceEdgeRouterPolicies"] = serviceEdgeRouterPolicies
-
-	servicePolicies := map[string]string{}
-	if importer.IsServicePolicyImportRequired(args) {
-		log.Debug("Processing ServicePolicies")
-		var err error
-		servicePolicies, err = importer.ProcessServicePolicies(data)
-		if err != nil {
-			return nil, err
-		}
-		log.WithField("servicePolicies", servicePolicies).Debug("ServicePolicies created")
+	return nil
+}
+
+// importEntity describes how one type of entity is imported and pruned
+type importEntity struct {
+	name     string
+	plural   string
+	inputKey string
+	required func(importer *Importer, args []string) bool
+	process  func(importer *Importer, input map[string][]interface{}) (map[string]string, error)
+	list     func(importer *Importer, filter string) (map[string]string, error)
+	delete   func(importer *Importer, id string) error
+}
+
+// importEntities is ordered so that each type is imported after those it references: config types before configs,
+// configs before services, and services, identities, posture checks and edge routers before policies. Pruning runs
+// in reverse order.
+var importEntities = []*importEntity{
+	{"CertificateAuthority", "CertificateAuthorities", "certificateAuthorities",
+		(*Importer).IsCertificateAuthorityImportRequired, (*Importer).ProcessCertificateAuthorities,
+		(*Importer).listCertificateAuthorities, (*Importer).deleteCertificateAuthority},
+	{"ExtJWTSigner", "ExtJWTSigners", "externalJwtSigners",
+		(*Importer).IsExtJwtSignerImportRequired, (*Importer).ProcessExternalJwtSigners,
+		(*Importer).listExternalJwtSigners, (*Importer).deleteExternalJwtSigner},
+	{"AuthPolicy", "AuthPolicies", "authPolicies",
+		(*Importer).IsAuthPolicyImportRequired, (*Importer).ProcessAuthPolicies,
+		(*Importer).listAuthPolicies, (*Importer).deleteAuthPolicy},
+	{"Identity", "Identities", "identities",
+		(*Importer).IsIdentityImportRequired, (*Importer).ProcessIdentities,
+		(*Importer).listIdentities, (*Importer).deleteIdentity},
+	{"ConfigType", "ConfigTypes", "configTypes",
+		(*Importer).IsConfigTypeImportRequired, (*Importer).ProcessConfigTypes,
+		(*Importer).listConfigTypes, (*Importer).deleteConfigType},
+	{"Config", "Configs", "configs",
+		(*Importer).IsConfigImportRequired, (*Importer).ProcessConfigs,
+		(*Importer).listConfigs, (*Importer).deleteConfig},
+	{"Service", "Services", "services",
+		(*Importer).IsServiceImportRequired, (*Importer).ProcessServices,
+		(*Importer).listServices, (*Importer).deleteService},
+	{"PostureCheck", "PostureChecks", "postureChecks",
+		(*Importer).IsPostureCheckImportRequired, (*Importer).ProcessPostureChecks,
+		(*Importer).listPostureChecks, (*Importer).deletePostureCheck},
+	{"EdgeRouter", "EdgeRouters", "edgeRouters",
+		(*Importer).IsEdgeRouterImportRequired, (*Importer).ProcessEdgeRouters,
+		(*Importer).listEdgeRouters, (*Importer).deleteEdgeRouter},
+	{"ServiceEdgeRouterPolicy", "ServiceEdgeRouterPolicies", "serviceEdgeRouterPolicies",
+		(*Importer).IsServiceEdgeRouterPolicyImportRequired, (*Importer).ProcessServiceEdgeRouterPolicies,
+		(*Importer).listServiceEdgeRouterPolicies, (*Importer).deleteServiceEdgeRouterPolicy},
+	{"ServicePolicy", "ServicePolicies", "servicePolicies",
+		(*Importer).IsServicePolicyImportRequired, (*Importer).ProcessServicePolicies,
+		(*Importer).listServicePolicies, (*Importer).deleteServicePolicy},
+	{"EdgeRouterPolicy", "EdgeRouterPolicies", "edgeRouterPolicies",
+		(*Importer).IsEdgeRouterPolicyImportRequired, (*Importer).ProcessEdgeRouterPolicies,
+		(*Importer).listEdgeRouterPolicies, (*Importer).deleteEdgeRouterPolicy},
+}
+
+// tagInput adds a key=value tag to every entity in the input
+func tagInput(data map[string][]interface{}, tag string) error {
+	key, value, found := strings.Cut(tag, "=")
+	if !found || key == "" {
+		return errors.New("prune tag must be of the form key=value")
 	}
-	_, _ = internal.FPrintfReusingLine(importer.loginOpts.Err, "Created %d ServicePolicies\r\n", len(servicePolicies))
-	result["servicePolicies"] = servicePolicies
-
-	routerPolicies := map[string]string{}
-	if importer.IsEdgeRouterPolicyImportRequired(args) {
-		log.Debug("Processing EdgeRouterPolicies")
-		var err error
-		routerPolicies, err = importer.ProcessEdgeRouterPolicies(data)
-		if err != nil {
-			return nil, err
+
+	for _, entities := range data {
+		for _, entity := range entities {
+			fields, ok := entity.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			tags, _ := fields["tags"].(map[string]interface{})
+			if tags == nil {
+				tags = map[string]interface{}{}
+				fields["tags"] = tags
+			}
+			tags[key] = value
 		}
-		log.WithField("routerPolicies", routerPolicies).Debug("EdgeRouterPolicies created")
 	}
-	_, _ = internal.FPrintfReusingLine(importer.loginOpts.Err, "Created %d EdgeRouterPolicies\r\n", len(routerPolicies))
-	result["edgeRouterPolicies"] = routerPolicies
 
-	log.Info("Upload complete")
+	return nil
+}
 
-	return result, nil
+func inputNames(entities []interface{}) map[string]bool {
+	result := map[string]bool{}
+	for _, entity := range entities {
+		if fields, ok := entity.(map[string]interface{}); ok {
+			if name, ok := fields["name"].(string); ok {
+				result[name] = true
+			}
+		}
+	}
+	return result
 }
 
 func FromMap[T interface{}](input interface{}, v T) *T {
@@ -22,7 +22,6 @@ import (
 	"ztna-core/edge-api/rest_management_api_client/auth_policy"
 	"ztna-core/edge-api/rest_model"
 	"ztna-core/edge-api/rest_util"
-	"ztna-core/ztna/internal"
 	"ztna-core/ztna/internal/ascode"
 	"ztna-core/ztna/internal/rest/mgmt"
 
@@ -46,19 +45,6 @@ func (importer *Importer) ProcessAuthPolicies(input map[string][]interface{}) (m
 	for _, data := range input["authPolicies"] {
 		create := FromMap(data, rest_model.AuthPolicyCreate{})
 
-		// see if the auth policy already exists
-		existing := mgmt.AuthPolicyFromFilter(importer.client, mgmt.NameFilter(*create.Name))
-		if existing != nil {
-			if importer.loginOpts.Verbose {
-				log.WithFields(map[string]interface{}{
-					"name":         *create.Name,
-					"authPolicyId": *existing.ID,
-				}).Info("Found existing Auth Policy, skipping create")
-			}
-			_, _ = internal.FPrintfReusingLine(importer.loginOpts.Err, "Skipping AuthPolicy %s\r", *create.Name)
-			continue
-		}
-
 		// convert to a json doc so we can query inside the data
 		jsonData, _ := json.Marshal(data)
 		doc, jsonParseError := gabs.ParseJSON(jsonData)
@@ -97,8 +83,22 @@ func (importer *Importer) ProcessAuthPolicies(input map[string][]interface{}) (m
 			create.Secondary.RequireExtJWTSigner = extJwtSigner.(*rest_model.ExternalJWTSignerDetail).ID
 		}
 
+		// see if the auth policy already exists
+		existing := mgmt.AuthPolicyFromFilter(importer.client, mgmt.NameFilter(*create.Name))
+		if existing != nil {
+			err := importer.updateExisting("AuthPolicy", *create.Name, *existing.ID, create, existing, importer.patcher("auth-policies"))
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+
 		// do the actual create since it doesn't exist
-		_, _ = internal.FPrintfReusingLine(importer.loginOpts.Err, "Creating AuthPolicy %s\r", *create.Name)
+		entry := importer.planCreate("AuthPolicy", *create.Name)
+		if entry == nil {
+			importer.authPolicyCache[*create.Name] = &rest_model.AuthPolicyDetail{BaseEntity: rest_model.BaseEntity{ID: plannedId(*create.Name)}}
+			continue
+		}
 		if importer.loginOpts.Verbose {
 			log.WithField("name", *create.Name).
 				Debug("Creating AuthPolicy")
@@ -125,8 +125,27 @@ func (importer *Importer) ProcessAuthPolicies(input map[string][]interface{}) (m
 			}).Info("Created AuthPolicy")
 		}
 
+		entry.Id = created.Payload.Data.ID
 		result[*create.Name] = created.Payload.Data.ID
 	}
 
 	return result, nil
 }
+
+func (importer *Importer) listAuthPolicies(filter string) (map[string]string, error) {
+	resp, err := importer.client.AuthPolicy.ListAuthPolicies(&auth_policy.ListAuthPoliciesParams{Filter: &filter}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]string{}
+	for _, entity := range resp.Payload.Data {
+		result[*entity.Name] = *entity.ID
+	}
+	return result, nil
+}
+
+func (importer *Importer) deleteAuthPolicy(id string) error {
+	_, err := importer.client.AuthPolicy.DeleteAuthPolicy(&auth_policy.DeleteAuthPolicyParams{ID: id}, nil)
+	return err
+}
@@ -21,7 +21,6 @@ import (
 	"ztna-core/edge-api/rest_management_api_client/certificate_authority"
 	"ztna-core/edge-api/rest_model"
 	"ztna-core/edge-api/rest_util"
-	"ztna-core/ztna/internal"
 	"ztna-core/ztna/internal/rest/mgmt"
 )
 
@@ -40,19 +39,19 @@ func (importer *Importer) ProcessCertificateAuthorities(input map[string][]inter
 		// see if the CA already exists
 		existing := mgmt.CertificateAuthorityFromFilter(importer.client, mgmt.NameFilter(*create.Name))
 		if existing != nil {
-			if importer.loginOpts.Verbose {
-				log.WithFields(map[string]interface{}{
-					"name":                   *create.Name,
-					"certificateAuthorityId": *existing.ID,
-				}).
-					Info("Found existing CertificateAuthority, skipping create")
+			// the certificate of a CA can't be changed
+			err := importer.updateExisting("CertificateAuthority", *create.Name, *existing.ID, create, existing, importer.patcher("cas"), "certPem")
+			if err != nil {
+				return nil, err
 			}
-			_, _ = internal.FPrintfReusingLine(importer.loginOpts.Err, "Skipping CertificateAuthority %s\r", *create.Name)
 			continue
 		}
 
 		// do the actual create since it doesn't exist
-		_, _ = internal.FPrintfReusingLine(importer.loginOpts.Err, "Creating CertificateAuthority %s\r", *create.Name)
+		entry := importer.planCreate("CertificateAuthority", *create.Name)
+		if entry == nil {
+			continue
+		}
 		created, createErr := importer.client.CertificateAuthority.CreateCa(&certificate_authority.CreateCaParams{Ca: create}, nil)
 		if createErr != nil {
 			if payloadErr, ok := createErr.(rest_util.ApiErrorPayload); ok {
@@ -77,8 +76,27 @@ func (importer *Importer) ProcessCertificateAuthorities(input map[string][]inter
 				Info("Created CertificateAuthority")
 		}
 
+		entry.Id = created.Payload.Data.ID
 		result[*create.Name] = created.Payload.Data.ID
 	}
 
 	return result, nil
 }
+
+func (importer *Importer) listCertificateAuthorities(filter string) (map[string]string, error) {
+	resp, err := importer.client.CertificateAuthority.ListCas(&certificate_authority.ListCasParams{Filter: &filter}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]string{}
+	for _, entity := range resp.Payload.Data {
+		result[*entity.Name] = *entity.ID
+	}
+	return result, nil
+}
+
+func (importer *Importer) deleteCertificateAuthority(id string) error {
+	_, err := importer.client.CertificateAuthority.DeleteCa(&certificate_authority.DeleteCaParams{ID: id}, nil)
+	return err
+}
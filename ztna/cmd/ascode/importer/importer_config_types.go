@@ -21,7 +21,6 @@ import (
 	"ztna-core/edge-api/rest_management_api_client/config"
 	"ztna-core/edge-api/rest_model"
 	"ztna-core/edge-api/rest_util"
-	"ztna-core/ztna/internal"
 	"ztna-core/ztna/internal/rest/mgmt"
 )
 
@@ -41,19 +40,19 @@ func (importer *Importer) ProcessConfigTypes(input map[string][]interface{}) (ma
 		// see if the config type already exists
 		existing := mgmt.ConfigTypeFromFilter(importer.client, mgmt.NameFilter(*create.Name))
 		if existing != nil {
-			if importer.loginOpts.Verbose {
-				log.WithFields(map[string]interface{}{
-					"name":         *create.Name,
-					"configTypeId": *existing.ID,
-				}).
-					Info("Found existing ConfigType, skipping create")
+			err := importer.updateExisting("ConfigType", *create.Name, *existing.ID, create, existing, importer.patcher("config-types"))
+			if err != nil {
+				return nil, err
 			}
-			_, _ = internal.FPrintfReusingLine(importer.loginOpts.Err, "Skipping ConfigType %s\r", *create.Name)
 			continue
 		}
 
 		// do the actual create since it doesn't exist
-		_, _ = internal.FPrintfReusingLine(importer.loginOpts.Err, "Creating ConfigType %s\r", *create.Name)
+		entry := importer.planCreate("ConfigType", *create.Name)
+		if entry == nil {
+			importer.configTypeCache[*create.Name] = &rest_model.ConfigTypeDetail{BaseEntity: rest_model.BaseEntity{ID: plannedId(*create.Name)}}
+			continue
+		}
 		if importer.loginOpts.Verbose {
 			log.WithField("name", *create.Name).
 				Debug("Creating ConfigType")
@@ -81,8 +80,27 @@ func (importer *Importer) ProcessConfigTypes(input map[string][]interface{}) (ma
 				Info("Created Config Type")
 		}
 
+		entry.Id = created.Payload.Data.ID
 		result[*create.Name] = created.Payload.Data.ID
 	}
 
 	return result, nil
 }
+
+func (importer *Importer) listConfigTypes(filter string) (map[string]string, error) {
+	resp, err := importer.client.Config.ListConfigTypes(&config.ListConfigTypesParams{Filter: &filter}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]string{}
+	for _, entity := range resp.Payload.Data {
+		result[*entity.Name] = *entity.ID
+	}
+	return result, nil
+}
+
+func (importer *Importer) deleteConfigType(id string) error {
+	_, err := importer.client.Config.DeleteConfigType(&config.DeleteConfigTypeParams{ID: id}, nil)
+	return err
+}
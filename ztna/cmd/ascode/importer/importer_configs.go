@@ -23,7 +23,6 @@ import (
 	"ztna-core/edge-api/rest_management_api_client/config"
 	"ztna-core/edge-api/rest_model"
 	"ztna-core/edge-api/rest_util"
-	"ztna-core/ztna/internal"
 	"ztna-core/ztna/internal/ascode"
 	"ztna-core/ztna/internal/rest/mgmt"
 
@@ -42,21 +41,6 @@ func (importer *Importer) ProcessConfigs(input map[string][]interface{}) (map[st
 	for _, data := range input["configs"] {
 		create := FromMap(data, rest_model.ConfigCreate{})
 
-		// see if the config already exists
-		existing := mgmt.ConfigFromFilter(importer.client, mgmt.NameFilter(*create.Name))
-		if existing != nil {
-			if importer.loginOpts.Verbose {
-				log.
-					WithFields(map[string]interface{}{
-						"name":     *create.Name,
-						"configId": *existing.ID,
-					}).
-					Info("Found existing Config, skipping create")
-			}
-			_, _ = internal.FPrintfReusingLine(importer.loginOpts.Err, "Skipping Config %s\r", *create.Name)
-			continue
-		}
-
 		// convert to a json doc so we can query inside the data
 		jsonData, _ := json.Marshal(data)
 		doc, jsonParseError := gabs.ParseJSON(jsonData)
@@ -67,16 +51,30 @@ func (importer *Importer) ProcessConfigs(input map[string][]interface{}) (map[st
 
 		// look up the config type id from the name and add to the create
 		value := doc.Path("configType").Data().(string)[1:]
-		configType, _ := ascode.GetItemFromCache(importer.configCache, value, func(name string) (interface{}, error) {
+		configType, _ := ascode.GetItemFromCache(importer.configTypeCache, value, func(name string) (interface{}, error) {
 			return mgmt.ConfigTypeFromFilter(importer.client, mgmt.NameFilter(name)), nil
 		})
-		if importer.configCache == nil {
+		if configType == nil {
 			return nil, errors.New("error reading ConfigType: " + value)
 		}
 		create.ConfigTypeID = configType.(*rest_model.ConfigTypeDetail).ID
 
+		// see if the config already exists
+		existing := mgmt.ConfigFromFilter(importer.client, mgmt.NameFilter(*create.Name))
+		if existing != nil {
+			err := importer.updateExisting("Config", *create.Name, *existing.ID, create, existing, importer.patcher("configs"))
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+
 		// do the actual create since it doesn't exist
-		_, _ = internal.FPrintfReusingLine(importer.loginOpts.Err, "Creating Config %s\r", *create.Name)
+		entry := importer.planCreate("Config", *create.Name)
+		if entry == nil {
+			importer.configCache[*create.Name] = &rest_model.ConfigDetail{BaseEntity: rest_model.BaseEntity{ID: plannedId(*create.Name)}}
+			continue
+		}
 		if importer.loginOpts.Verbose {
 			log.WithField("name", *create.Name).Debug("Creating Config")
 		}
@@ -89,7 +87,7 @@ func (importer *Importer) ProcessConfigs(input map[string][]interface{}) (map[st
 					Error("Unable to create Config")
 				return nil, createErr
 			} else {
-				log.WithError(createErr).Error("Unable to list Configs")
+				log.WithError(createErr).Error("Unable to create Config")
 				return nil, createErr
 			}
 		}
@@ -100,8 +98,27 @@ func (importer *Importer) ProcessConfigs(input map[string][]interface{}) (map[st
 			}).
 				Info("Created Config")
 		}
+		entry.Id = created.Payload.Data.ID
 		result[*create.Name] = created.Payload.Data.ID
 	}
 
 	return result, nil
 }
+
+func (importer *Importer) listConfigs(filter string) (map[string]string, error) {
+	resp, err := importer.client.Config.ListConfigs(&config.ListConfigsParams{Filter: &filter}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]string{}
+	for _, entity := range resp.Payload.Data {
+		result[*entity.Name] = *entity.ID
+	}
+	return result, nil
+}
+
+func (importer *Importer) deleteConfig(id string) error {
+	_, err := importer.client.Config.DeleteConfig(&config.DeleteConfigParams{ID: id}, nil)
+	return err
+}
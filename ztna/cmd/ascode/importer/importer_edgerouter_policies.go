@@ -21,7 +21,6 @@ import (
 	"ztna-core/edge-api/rest_management_api_client/edge_router_policy"
 	"ztna-core/edge-api/rest_model"
 	"ztna-core/edge-api/rest_util"
-	"ztna-core/ztna/internal"
 	"ztna-core/ztna/internal/rest/mgmt"
 )
 
@@ -36,18 +35,6 @@ func (importer *Importer) ProcessEdgeRouterPolicies(input map[string][]interface
 	for _, data := range input["edgeRouterPolicies"] {
 		create := FromMap(data, rest_model.EdgeRouterPolicyCreate{})
 
-		// see if the router already exists
-		existing := mgmt.EdgeRouterPolicyFromFilter(importer.client, mgmt.NameFilter(*create.Name))
-		if existing != nil {
-			log.WithFields(map[string]interface{}{
-				"name":               *create.Name,
-				"edgeRouterPolicyId": *existing.ID,
-			}).
-				Info("Found existing EdgeRouterPolicy, skipping create")
-			_, _ = internal.FPrintfReusingLine(importer.loginOpts.Err, "Skipping EdgeRouterPolicy %s\r", *create.Name)
-			continue
-		}
-
 		// look up the edgeRouter ids from the name and add to the create
 		edgeRouterRoles, err := importer.lookupEdgeRouters(create.EdgeRouterRoles)
 		if err != nil {
@@ -62,8 +49,21 @@ func (importer *Importer) ProcessEdgeRouterPolicies(input map[string][]interface
 		}
 		create.IdentityRoles = identityRoles
 
+		// see if the router already exists
+		existing := mgmt.EdgeRouterPolicyFromFilter(importer.client, mgmt.NameFilter(*create.Name))
+		if existing != nil {
+			err := importer.updateExisting("EdgeRouterPolicy", *create.Name, *existing.ID, create, existing, importer.patcher("edge-router-policies"))
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+
 		// do the actual create since it doesn't exist
-		_, _ = internal.FPrintfReusingLine(importer.loginOpts.Err, "Creating EdgeRouterPolicy %s\r", *create.Name)
+		entry := importer.planCreate("EdgeRouterPolicy", *create.Name)
+		if entry == nil {
+			continue
+		}
 		if importer.loginOpts.Verbose {
 			log.WithField("name", *create.Name).Debug("Creating EdgeRouterPolicy")
 		}
@@ -89,8 +89,27 @@ func (importer *Importer) ProcessEdgeRouterPolicies(input map[string][]interface
 				Info("Created EdgeRouterPolicy")
 		}
 
+		entry.Id = created.Payload.Data.ID
 		result[*create.Name] = created.Payload.Data.ID
 	}
 
 	return result, nil
 }
+
+func (importer *Importer) listEdgeRouterPolicies(filter string) (map[string]string, error) {
+	resp, err := importer.client.EdgeRouterPolicy.ListEdgeRouterPolicies(&edge_router_policy.ListEdgeRouterPoliciesParams{Filter: &filter}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]string{}
+	for _, entity := range resp.Payload.Data {
+		result[*entity.Name] = *entity.ID
+	}
+	return result, nil
+}
+
+func (importer *Importer) deleteEdgeRouterPolicy(id string) error {
+	_, err := importer.client.EdgeRouterPolicy.DeleteEdgeRouterPolicy(&edge_router_policy.DeleteEdgeRouterPolicyParams{ID: id}, nil)
+	return err
+}
@@ -22,7 +22,6 @@ import (
 	"ztna-core/edge-api/rest_management_api_client/edge_router"
 	"ztna-core/edge-api/rest_model"
 	"ztna-core/edge-api/rest_util"
-	"ztna-core/ztna/internal"
 	"ztna-core/ztna/internal/ascode"
 	"ztna-core/ztna/internal/rest/mgmt"
 )
@@ -42,17 +41,19 @@ func (importer *Importer) ProcessEdgeRouters(input map[string][]interface{}) (ma
 		// see if the router already exists
 		existing := mgmt.EdgeRouterFromFilter(importer.client, mgmt.NameFilter(*create.Name))
 		if existing != nil {
-			log.WithFields(map[string]interface{}{
-				"name":         *create.Name,
-				"edgeRouterId": *existing.ID,
-			}).
-				Info("Found existing EdgeRouter, skipping create")
-			_, _ = internal.FPrintfReusingLine(importer.loginOpts.Err, "Skipping EdgeRouter %s\r", *create.Name)
+			err := importer.updateExisting("EdgeRouter", *create.Name, *existing.ID, create, existing, importer.patcher("edge-routers"))
+			if err != nil {
+				return nil, err
+			}
 			continue
 		}
 
 		// do the actual create since it doesn't exist
-		_, _ = internal.FPrintfReusingLine(importer.loginOpts.Err, "Creating EdgeRouterPolicy %s\r", *create.Name)
+		entry := importer.planCreate("EdgeRouter", *create.Name)
+		if entry == nil {
+			importer.edgeRouterCache[*create.Name] = &rest_model.EdgeRouterDetail{BaseEntity: rest_model.BaseEntity{ID: plannedId(*create.Name)}}
+			continue
+		}
 		if importer.loginOpts.Verbose {
 			log.WithField("name", *create.Name).Debug("Creating EdgeRouter")
 		}
@@ -65,8 +66,8 @@ func (importer *Importer) ProcessEdgeRouters(input map[string][]interface{}) (ma
 				}).Error("Unable to create EdgeRouter")
 			} else {
 				log.WithField("err", createErr).Error("Unable to create EdgeRouter")
-				return nil, createErr
 			}
+			return nil, createErr
 		}
 		if importer.loginOpts.Verbose {
 			log.WithFields(map[string]interface{}{
@@ -76,6 +77,7 @@ func (importer *Importer) ProcessEdgeRouters(input map[string][]interface{}) (ma
 				Info("Created EdgeRouter")
 		}
 
+		entry.Id = created.Payload.Data.ID
 		result[*create.Name] = created.Payload.Data.ID
 	}
 
@@ -101,3 +103,21 @@ func (importer *Importer) lookupEdgeRouters(roles []string) ([]string, error) {
 	}
 	return edgeRouterRoles, nil
 }
+
+func (importer *Importer) listEdgeRouters(filter string) (map[string]string, error) {
+	resp, err := importer.client.EdgeRouter.ListEdgeRouters(&edge_router.ListEdgeRoutersParams{Filter: &filter}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]string{}
+	for _, entity := range resp.Payload.Data {
+		result[*entity.Name] = *entity.ID
+	}
+	return result, nil
+}
+
+func (importer *Importer) deleteEdgeRouter(id string) error {
+	_, err := importer.client.EdgeRouter.DeleteEdgeRouter(&edge_router.DeleteEdgeRouterParams{ID: id}, nil)
+	return err
+}
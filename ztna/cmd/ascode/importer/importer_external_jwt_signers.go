@@ -21,7 +21,6 @@ import (
 	"ztna-core/edge-api/rest_management_api_client/external_jwt_signer"
 	"ztna-core/edge-api/rest_model"
 	"ztna-core/edge-api/rest_util"
-	"ztna-core/ztna/internal"
 	"ztna-core/ztna/internal/rest/mgmt"
 )
 
@@ -42,19 +41,19 @@ func (importer *Importer) ProcessExternalJwtSigners(input map[string][]interface
 		// see if the signer already exists
 		existing := mgmt.ExternalJWTSignerFromFilter(importer.client, mgmt.NameFilter(*create.Name))
 		if existing != nil {
-			if importer.loginOpts.Verbose {
-				log.WithFields(map[string]interface{}{
-					"name":                *create.Name,
-					"externalJwtSignerId": *existing.ID,
-				}).
-					Info("Found existing ExtJWTSigner, skipping create")
+			err := importer.updateExisting("ExtJWTSigner", *create.Name, *existing.ID, create, existing, importer.patcher("external-jwt-signers"))
+			if err != nil {
+				return nil, err
 			}
-			_, _ = internal.FPrintfReusingLine(importer.loginOpts.Err, "Skipping ExtJWTSigner %s\r", *create.Name)
 			continue
 		}
 
 		// do the actual create since it doesn't exist
-		_, _ = internal.FPrintfReusingLine(importer.loginOpts.Err, "Creating ExtJWTSigner %s\r", *create.Name)
+		entry := importer.planCreate("ExtJWTSigner", *create.Name)
+		if entry == nil {
+			importer.extJwtSignersCache[*create.Name] = &rest_model.ExternalJWTSignerDetail{BaseEntity: rest_model.BaseEntity{ID: plannedId(*create.Name)}}
+			continue
+		}
 		if importer.loginOpts.Verbose {
 			log.WithField("name", *create.Name).Debug("Creating ExtJWTSigner")
 		}
@@ -81,8 +80,27 @@ func (importer *Importer) ProcessExternalJwtSigners(input map[string][]interface
 				Info("Created ExtJWTSigner")
 		}
 
+		entry.Id = created.Payload.Data.ID
 		result[*create.Name] = created.Payload.Data.ID
 	}
 
 	return result, nil
 }
+
+func (importer *Importer) listExternalJwtSigners(filter string) (map[string]string, error) {
+	resp, err := importer.client.ExternalJWTSigner.ListExternalJWTSigners(&external_jwt_signer.ListExternalJWTSignersParams{Filter: &filter}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]string{}
+	for _, entity := range resp.Payload.Data {
+		result[*entity.Name] = *entity.ID
+	}
+	return result, nil
+}
+
+func (importer *Importer) deleteExternalJwtSigner(id string) error {
+	_, err := importer.client.ExternalJWTSigner.DeleteExternalJWTSigner(&external_jwt_signer.DeleteExternalJWTSignerParams{ID: id}, nil)
+	return err
+}
@@ -23,7 +23,6 @@ import (
 	"ztna-core/edge-api/rest_management_api_client/identity"
 	"ztna-core/edge-api/rest_model"
 	"ztna-core/edge-api/rest_util"
-	"ztna-core/ztna/internal"
 	"ztna-core/ztna/internal/ascode"
 	"ztna-core/ztna/internal/rest/mgmt"
 
@@ -42,19 +41,6 @@ func (importer *Importer) ProcessIdentities(input map[string][]interface{}) (map
 	for _, data := range input["identities"] {
 		create := FromMap(data, rest_model.IdentityCreate{})
 
-		existing := mgmt.IdentityFromFilter(importer.client, mgmt.NameFilter(*create.Name))
-		if existing != nil {
-			if importer.loginOpts.Verbose {
-				log.WithFields(map[string]interface{}{
-					"name":       *create.Name,
-					"identityId": *existing.ID,
-				}).
-					Info("Found existing Identity, skipping create")
-			}
-			_, _ = internal.FPrintfReusingLine(importer.loginOpts.Err, "Skipping Identity %s\r", *create.Name)
-			continue
-		}
-
 		// set the type because it is not in the input
 		typ := rest_model.IdentityTypeDefault
 		create.Type = &typ
@@ -79,8 +65,22 @@ func (importer *Importer) ProcessIdentities(input map[string][]interface{}) (map
 			create.AuthPolicyID = policy.(*rest_model.AuthPolicyDetail).ID
 		}
 
+		existing := mgmt.IdentityFromFilter(importer.client, mgmt.NameFilter(*create.Name))
+		if existing != nil {
+			// the type and enrollment are only set on create
+			err := importer.updateExisting("Identity", *create.Name, *existing.ID, create, existing, importer.patcher("identities"), "type", "enrollment")
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+
 		// do the actual create since it doesn't exist
-		_, _ = internal.FPrintfReusingLine(importer.loginOpts.Err, "Creating Identity %s\r", *create.Name)
+		entry := importer.planCreate("Identity", *create.Name)
+		if entry == nil {
+			importer.identityCache[*create.Name] = &rest_model.IdentityDetail{BaseEntity: rest_model.BaseEntity{ID: plannedId(*create.Name)}}
+			continue
+		}
 		created, createErr := importer.client.Identity.CreateIdentity(&identity.CreateIdentityParams{Identity: create}, nil)
 		if createErr != nil {
 			if payloadErr, ok := createErr.(rest_util.ApiErrorPayload); ok {
@@ -103,12 +103,31 @@ func (importer *Importer) ProcessIdentities(input map[string][]interface{}) (map
 				Info("Created identity")
 		}
 
+		entry.Id = created.Payload.Data.ID
 		result[*create.Name] = created.Payload.Data.ID
 	}
 
 	return result, nil
 }
 
+func (importer *Importer) listIdentities(filter string) (map[string]string, error) {
+	resp, err := importer.client.Identity.ListIdentities(&identity.ListIdentitiesParams{Filter: &filter}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]string{}
+	for _, entity := range resp.Payload.Data {
+		result[*entity.Name] = *entity.ID
+	}
+	return result, nil
+}
+
+func (importer *Importer) deleteIdentity(id string) error {
+	_, err := importer.client.Identity.DeleteIdentity(&identity.DeleteIdentityParams{ID: id}, nil)
+	return err
+}
+
 func (importer *Importer) lookupIdentities(roles []string) ([]string, error) {
 	identityRoles := []string{}
 	for _, role := range roles {
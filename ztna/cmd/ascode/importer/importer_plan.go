@@ -0,0 +1,291 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package importer
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"ztna-core/ztna/internal"
+)
+
+type PlanAction string
+
+const (
+	PlanCreate PlanAction = "create"
+	PlanUpdate PlanAction = "update"
+	PlanDelete PlanAction = "delete"
+)
+
+// PlanEntry is a single change to an entity, Fields lists the changed fields of an update
+type PlanEntry struct {
+	Action     PlanAction `json:"action"`
+	EntityType string     `json:"entityType"`
+	Name       string     `json:"name"`
+	Id         string     `json:"id,omitempty"`
+	Fields     []string   `json:"fields,omitempty"`
+
+	// revert restores the entity's state from before an update, when the import is rolled back
+	revert func() error
+	// deleted is set once a pruned entity has been deleted
+	deleted bool
+}
+
+// Plan is the ordered list of changes an import makes, or would make in a dry run
+type Plan struct {
+	Entries []*PlanEntry `json:"entries"`
+}
+
+func (self *Plan) add(action PlanAction, entityType, name string, fields ...string) *PlanEntry {
+	entry := &PlanEntry{
+		Action:     action,
+		EntityType: entityType,
+		Name:       name,
+		Fields:     fields,
+	}
+	self.Entries = append(self.Entries, entry)
+	return entry
+}
+
+func (self *Plan) Count(action PlanAction) int {
+	count := 0
+	for _, entry := range self.Entries {
+		if entry.Action == action {
+			count++
+		}
+	}
+	return count
+}
+
+func (self *Plan) Print(out io.Writer) {
+	for _, entry := range self.Entries {
+		switch entry.Action {
+		case PlanCreate:
+			_, _ = fmt.Fprintf(out, "+ create %s %s\n", entry.EntityType, entry.Name)
+		case PlanUpdate:
+			_, _ = fmt.Fprintf(out, "~ update %s %s: %s\n", entry.EntityType, entry.Name, strings.Join(entry.Fields, ", "))
+		case PlanDelete:
+			_, _ = fmt.Fprintf(out, "- delete %s %s\n", entry.EntityType, entry.Name)
+		}
+	}
+	_, _ = fmt.Fprintf(out, "Plan: %d to create, %d to update, %d to delete\n",
+		self.Count(PlanCreate), self.Count(PlanUpdate), self.Count(PlanDelete))
+}
+
+// plannedId is the id given to an entity which would be created in a dry run, so that later entities can refer to it
+func plannedId(name string) *string {
+	id := "planned:" + name
+	return &id
+}
+
+// planCreate records the create of an entity. It returns nil if the entity should not be created, as this is a dry
+// run, otherwise the entry, whose id should be set once created.
+func (importer *Importer) planCreate(entityType, name string) *PlanEntry {
+	entry := importer.plan.add(PlanCreate, entityType, name)
+	if importer.dryRun {
+		return nil
+	}
+	_, _ = internal.FPrintfReusingLine(importer.loginOpts.Err, "Creating %s %s\r", entityType, name)
+	return entry
+}
+
+// updateExisting brings an existing entity in line with its desired state, if upserting. Only fields present in
+// desired are compared, ignoring those named in ignored, and update is called with the entity's id and the fields
+// which differ, if any. Should the import fail, update is called again with their existing values, to revert the
+// entity.
+func (importer *Importer) updateExisting(entityType, name, id string, desired, existing any, update func(id string, fields map[string]any) error, ignored ...string) error {
+	if !importer.upsert {
+		if importer.loginOpts.Verbose {
+			log.WithFields(map[string]interface{}{
+				"name": name,
+				"id":   id,
+			}).
+				Infof("Found existing %s, skipping create", entityType)
+		}
+		_, _ = internal.FPrintfReusingLine(importer.loginOpts.Err, "Skipping %s %s\r", entityType, name)
+		return nil
+	}
+
+	patch, revert, err := patchFields(desired, existing, ignored...)
+	if err != nil {
+		return err
+	}
+
+	if len(patch) == 0 {
+		_, _ = internal.FPrintfReusingLine(importer.loginOpts.Err, "Unchanged %s %s\r", entityType, name)
+		return nil
+	}
+
+	entry := importer.plan.add(PlanUpdate, entityType, name, sortedKeys(patch)...)
+	entry.Id = id
+	if importer.dryRun {
+		return nil
+	}
+
+	_, _ = internal.FPrintfReusingLine(importer.loginOpts.Err, "Updating %s %s\r", entityType, name)
+	if err = update(id, patch); err != nil {
+		log.WithError(err).WithField("name", name).Errorf("Unable to update %s", entityType)
+		return err
+	}
+	entry.revert = func() error {
+		return update(id, revert)
+	}
+
+	return nil
+}
+
+// patcher returns an update for updateExisting which patches the entities under the given management api path
+func (importer *Importer) patcher(path string) func(id string, fields map[string]any) error {
+	return func(id string, fields map[string]any) error {
+		return importer.patch(path, id, fields)
+	}
+}
+
+// patch sends only the given fields, so those left out of the input keep their current values. The generated patch
+// models can't be used, as they send every field they don't omit
+func (importer *Importer) patch(path, id string, fields map[string]any) error {
+	op := &runtime.ClientOperation{
+		ID:                 "patch",
+		Method:             http.MethodPatch,
+		PathPattern:        "/" + path + "/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params: runtime.ClientRequestWriterFunc(func(req runtime.ClientRequest, _ strfmt.Registry) error {
+			if err := req.SetPathParam("id", id); err != nil {
+				return err
+			}
+			return req.SetBodyParam(fields)
+		}),
+		Reader: runtime.ClientResponseReaderFunc(func(resp runtime.ClientResponse, _ runtime.Consumer) (any, error) {
+			if resp.Code() < 200 || resp.Code() >= 300 {
+				body, _ := io.ReadAll(resp.Body())
+				return nil, runtime.NewAPIError("patch "+path, string(body), resp.Code())
+			}
+			return nil, nil
+		}),
+	}
+
+	_, err := importer.client.Transport.Submit(op)
+	return err
+}
+
+// diffFields returns the sorted json names of the fields set in desired whose values differ in existing
+func diffFields(desired, existing any, ignored ...string) ([]string, error) {
+	patch, _, err := patchFields(desired, existing, ignored...)
+	if err != nil {
+		return nil, err
+	}
+	return sortedKeys(patch), nil
+}
+
+// patchFields returns the fields set in desired whose values differ in existing, along with their existing values.
+// Desired tags are merged into the existing tags, so tags added outside of the input are kept
+func patchFields(desired, existing any, ignored ...string) (map[string]any, map[string]any, error) {
+	desiredFields, err := toFields(desired)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	existingFields, err := toFields(existing)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	patch := map[string]any{}
+	revert := map[string]any{}
+	for field, value := range desiredFields {
+		if value == nil || slices.Contains(ignored, field) {
+			continue
+		}
+
+		current := existingFields[field]
+		if field == "tags" {
+			value = mergeTags(current, value)
+		}
+
+		if isEmpty(value) && isEmpty(current) {
+			continue
+		}
+
+		if !reflect.DeepEqual(value, current) {
+			patch[field] = value
+			revert[field] = current
+		}
+	}
+
+	return patch, revert, nil
+}
+
+func mergeTags(existing, desired any) any {
+	existingTags, _ := existing.(map[string]any)
+	desiredTags, ok := desired.(map[string]any)
+	if !ok || len(existingTags) == 0 {
+		return desired
+	}
+
+	result := map[string]any{}
+	for k, v := range existingTags {
+		result[k] = v
+	}
+	for k, v := range desiredTags {
+		result[k] = v
+	}
+	return result
+}
+
+func sortedKeys(m map[string]any) []string {
+	result := make([]string, 0, len(m))
+	for k := range m {
+		result = append(result, k)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func toFields(entity any) (map[string]any, error) {
+	data, err := json.Marshal(entity)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]any{}
+	if err = json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func isEmpty(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case []any:
+		return len(v) == 0
+	case map[string]any:
+		return len(v) == 0
+	}
+	return false
+}
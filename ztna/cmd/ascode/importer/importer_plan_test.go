@@ -0,0 +1,147 @@
+package importer
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffFields(t *testing.T) {
+
+	type entity struct {
+		Name           string                 `json:"name"`
+		RoleAttributes []string               `json:"roleAttributes"`
+		Tags           map[string]interface{} `json:"tags"`
+		Enrollment     *string                `json:"enrollment"`
+		Type           string                 `json:"type"`
+	}
+
+	t.Run("equal entities have no changes", func(t *testing.T) {
+		desired := &entity{Name: "a", RoleAttributes: []string{"x"}}
+		existing := &entity{Name: "a", RoleAttributes: []string{"x"}, Tags: map[string]interface{}{}}
+
+		changed, err := diffFields(desired, existing)
+		assert.NoError(t, err)
+		assert.Empty(t, changed)
+	})
+
+	t.Run("changed fields are reported in order", func(t *testing.T) {
+		desired := &entity{Name: "a", RoleAttributes: []string{"y"}, Tags: map[string]interface{}{"env": "prod"}}
+		existing := &entity{Name: "a", RoleAttributes: []string{"x"}}
+
+		changed, err := diffFields(desired, existing)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"roleAttributes", "tags"}, changed)
+	})
+
+	t.Run("unset and ignored fields are not compared", func(t *testing.T) {
+		enrollment := "ott"
+		desired := &entity{Name: "a", Enrollment: &enrollment, Type: "Default"}
+		existing := &entity{Name: "a", Type: "Router"}
+
+		changed, err := diffFields(desired, existing, "type", "enrollment")
+		assert.NoError(t, err)
+		assert.Empty(t, changed)
+	})
+
+	t.Run("tags are merged into the existing tags", func(t *testing.T) {
+		desired := &entity{Name: "a", Tags: map[string]interface{}{"managed-by": "gitops"}}
+		existing := &entity{Name: "a", Tags: map[string]interface{}{"owner": "ops"}}
+
+		patch, revert, err := patchFields(desired, existing)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{"tags": map[string]any{"owner": "ops", "managed-by": "gitops"}}, patch)
+		assert.Equal(t, map[string]any{"tags": map[string]any{"owner": "ops"}}, revert)
+
+		existing.Tags["managed-by"] = "gitops"
+		changed, err := diffFields(desired, existing)
+		assert.NoError(t, err)
+		assert.Empty(t, changed)
+	})
+}
+
+func TestTagInput(t *testing.T) {
+
+	data := map[string][]interface{}{
+		"services": {
+			map[string]interface{}{"name": "a"},
+			map[string]interface{}{"name": "b", "tags": map[string]interface{}{"owner": "ops"}},
+		},
+	}
+
+	assert.NoError(t, tagInput(data, "managed-by=gitops"))
+	assert.Equal(t, map[string]interface{}{"managed-by": "gitops"}, data["services"][0].(map[string]interface{})["tags"])
+	assert.Equal(t, map[string]interface{}{"owner": "ops", "managed-by": "gitops"}, data["services"][1].(map[string]interface{})["tags"])
+	assert.Equal(t, map[string]bool{"a": true, "b": true}, inputNames(data["services"]))
+
+	assert.Error(t, tagInput(data, "managed-by"))
+}
+
+func TestImportEntitiesOrder(t *testing.T) {
+
+	index := map[string]int{}
+	for i, entity := range importEntities {
+		index[entity.name] = i
+	}
+
+	// each entity type must come after the types it references
+	assert.Less(t, index["ConfigType"], index["Config"])
+	assert.Less(t, index["Config"], index["Service"])
+	assert.Less(t, index["ExtJWTSigner"], index["AuthPolicy"])
+	assert.Less(t, index["AuthPolicy"], index["Identity"])
+	for _, policy := range []string{"ServicePolicy", "EdgeRouterPolicy", "ServiceEdgeRouterPolicy"} {
+		assert.Less(t, index["Service"], index[policy])
+		assert.Less(t, index["Identity"], index[policy])
+		assert.Less(t, index["EdgeRouter"], index[policy])
+		assert.Less(t, index["PostureCheck"], index[policy])
+	}
+}
+
+func TestPlanPrint(t *testing.T) {
+
+	plan := &Plan{}
+	plan.add(PlanCreate, "ConfigType", "a")
+	plan.add(PlanUpdate, "Service", "b", "configs", "tags")
+	plan.add(PlanDelete, "ServicePolicy", "c")
+
+	out := &bytes.Buffer{}
+	plan.Print(out)
+
+	assert.Equal(t, "+ create ConfigType a\n"+
+		"~ update Service b: configs, tags\n"+
+		"- delete ServicePolicy c\n"+
+		"Plan: 1 to create, 1 to update, 1 to delete\n", out.String())
+}
+
+func TestRollbackRevertsUpdates(t *testing.T) {
+
+	type entity struct {
+		Name           string   `json:"name"`
+		RoleAttributes []string `json:"roleAttributes"`
+	}
+
+	importer := &Importer{upsert: true, plan: &Plan{}}
+	importer.loginOpts.Err = &bytes.Buffer{}
+
+	var applied []map[string]any
+	update := func(id string, fields map[string]any) error {
+		assert.Equal(t, "id-a", id)
+		applied = append(applied, fields)
+		return nil
+	}
+
+	// only the changed fields are sent, so fields left out of the input keep their values
+	desired := &entity{Name: "a", RoleAttributes: []string{"y"}}
+	existing := &entity{Name: "a", RoleAttributes: []string{"x"}}
+	assert.NoError(t, importer.updateExisting("Service", "a", "id-a", desired, existing, update))
+
+	// unchanged entities aren't updated, so have nothing to revert
+	assert.NoError(t, importer.updateExisting("Service", "a", "id-a", existing, existing, update))
+
+	importer.rollback()
+	assert.Equal(t, []map[string]any{
+		{"roleAttributes": []any{"y"}},
+		{"roleAttributes": []any{"x"}},
+	}, applied)
+}
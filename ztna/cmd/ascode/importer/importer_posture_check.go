@@ -18,12 +18,13 @@ package importer
 
 import (
 	"encoding/json"
+	"errors"
 	"slices"
 	"strings"
 	"ztna-core/edge-api/rest_management_api_client/posture_checks"
 	"ztna-core/edge-api/rest_model"
 	"ztna-core/edge-api/rest_util"
-	"ztna-core/ztna/internal"
+	"ztna-core/ztna/internal/ascode"
 	"ztna-core/ztna/internal/rest/mgmt"
 
 	"github.com/Jeffail/gabs/v2"
@@ -73,20 +74,23 @@ func (importer *Importer) ProcessPostureChecks(input map[string][]interface{}) (
 		// see if the posture check already exists
 		existing := mgmt.PostureCheckFromFilter(importer.client, mgmt.NameFilter(*create.Name()))
 		if existing != nil {
-			if importer.loginOpts.Verbose {
-				log.WithFields(map[string]interface{}{
-					"name":           *create.Name(),
-					"postureCheckId": (*existing).ID(),
-					"typeId":         create.TypeID(),
-				}).
-					Info("Found existing PostureCheck, skipping create")
+			err := importer.updateExisting("PostureCheck", *create.Name(), *(*existing).ID(), create, existing, func(id string, fields map[string]any) error {
+				// the type selects which posture check fields the patch is read as
+				fields["typeId"] = strings.ToUpper(typeValue)
+				return importer.patch("posture-checks", id, fields)
+			})
+			if err != nil {
+				return nil, err
 			}
-			_, _ = internal.FPrintfReusingLine(importer.loginOpts.Err, "Skipping PostureCheck %s\r", *create.Name())
 			continue
 		}
 
 		// do the actual create since it doesn't exist
-		_, _ = internal.FPrintfReusingLine(importer.loginOpts.Err, "Creating PostureCheck %s\r", *create.Name())
+		entry := importer.planCreate("PostureCheck", *create.Name())
+		if entry == nil {
+			importer.postureCheckCache[*create.Name()] = plannedId(*create.Name())
+			continue
+		}
 		if importer.loginOpts.Verbose {
 			log.WithFields(map[string]interface{}{
 				"name":   *create.Name(),
@@ -103,9 +107,9 @@ func (importer *Importer) ProcessPostureChecks(input map[string][]interface{}) (
 				}).
 					Error("Unable to create PostureCheck")
 			} else {
-				log.WithError(createErr).Error("Unable to ")
-				return nil, createErr
+				log.WithError(createErr).Error("Unable to create PostureCheck")
 			}
+			return nil, createErr
 		}
 		if importer.loginOpts.Verbose {
 			log.WithFields(map[string]interface{}{
@@ -116,8 +120,51 @@ func (importer *Importer) ProcessPostureChecks(input map[string][]interface{}) (
 				Info("Created PostureCheck")
 		}
 
+		entry.Id = created.Payload.Data.ID
 		result[*create.Name()] = created.Payload.Data.ID
 	}
 
 	return result, nil
 }
+
+func (importer *Importer) listPostureChecks(filter string) (map[string]string, error) {
+	resp, err := importer.client.PostureChecks.ListPostureChecks(&posture_checks.ListPostureChecksParams{Filter: &filter}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]string{}
+	for _, entity := range resp.Payload.Data() {
+		result[*entity.Name()] = *entity.ID()
+	}
+	return result, nil
+}
+
+func (importer *Importer) deletePostureCheck(id string) error {
+	_, err := importer.client.PostureChecks.DeletePostureCheck(&posture_checks.DeletePostureCheckParams{ID: id}, nil)
+	return err
+}
+
+func (importer *Importer) lookupPostureChecks(roles []string) ([]string, error) {
+	postureCheckRoles := []string{}
+	for _, role := range roles {
+		if role[0:1] == "@" {
+			value := role[1:]
+			// posture check details are polymorphic, so only the id is cached
+			cached, _ := ascode.GetItemFromCache(importer.postureCheckCache, value, func(name string) (interface{}, error) {
+				if postureCheck := mgmt.PostureCheckFromFilter(importer.client, mgmt.NameFilter(name)); postureCheck != nil {
+					return (*postureCheck).ID(), nil
+				}
+				return (*string)(nil), nil
+			})
+			postureCheckId, _ := cached.(*string)
+			if postureCheckId == nil {
+				return nil, errors.New("error reading PostureCheck: " + value)
+			}
+			postureCheckRoles = append(postureCheckRoles, "@"+*postureCheckId)
+		} else {
+			postureCheckRoles = append(postureCheckRoles, role)
+		}
+	}
+	return postureCheckRoles, nil
+}
@@ -21,7 +21,6 @@ import (
 	"ztna-core/edge-api/rest_management_api_client/service_edge_router_policy"
 	"ztna-core/edge-api/rest_model"
 	"ztna-core/edge-api/rest_util"
-	"ztna-core/ztna/internal"
 	"ztna-core/ztna/internal/rest/mgmt"
 )
 
@@ -36,18 +35,6 @@ func (importer *Importer) ProcessServiceEdgeRouterPolicies(input map[string][]in
 	for _, data := range input["serviceEdgeRouterPolicies"] {
 		create := FromMap(data, rest_model.ServiceEdgeRouterPolicyCreate{})
 
-		// see if the service router policy already exists
-		existing := mgmt.ServiceEdgeRouterPolicyFromFilter(importer.client, mgmt.NameFilter(*create.Name))
-		if existing != nil {
-			log.WithFields(map[string]interface{}{
-				"name":                  *create.Name,
-				"serviceRouterPolicyId": *existing.ID,
-			}).
-				Info("Found existing ServiceEdgeRouterPolicy, skipping create")
-			_, _ = internal.FPrintfReusingLine(importer.loginOpts.Err, "Skipping ServiceEdgeRouterPolicy %s\r", *create.Name)
-			continue
-		}
-
 		// look up the service ids from the name and add to the create
 		serviceRoles, err := importer.lookupServices(create.ServiceRoles)
 		if err != nil {
@@ -62,8 +49,21 @@ func (importer *Importer) ProcessServiceEdgeRouterPolicies(input map[string][]in
 		}
 		create.EdgeRouterRoles = edgeRouterRoles
 
+		// see if the service router policy already exists
+		existing := mgmt.ServiceEdgeRouterPolicyFromFilter(importer.client, mgmt.NameFilter(*create.Name))
+		if existing != nil {
+			err := importer.updateExisting("ServiceEdgeRouterPolicy", *create.Name, *existing.ID, create, existing, importer.patcher("service-edge-router-policies"))
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+
 		// do the actual create since it doesn't exist
-		_, _ = internal.FPrintfReusingLine(importer.loginOpts.Err, "Creating ServiceEdgeRouterPolicy %s\r", *create.Name)
+		entry := importer.planCreate("ServiceEdgeRouterPolicy", *create.Name)
+		if entry == nil {
+			continue
+		}
 		if importer.loginOpts.Verbose {
 			log.WithField("name", *create.Name).
 				Debug("Creating ServiceEdgeRouterPolicy")
@@ -90,8 +90,27 @@ func (importer *Importer) ProcessServiceEdgeRouterPolicies(input map[string][]in
 				Info("Created ServiceEdgeRouterPolicy")
 		}
 
+		entry.Id = created.Payload.Data.ID
 		result[*create.Name] = created.Payload.Data.ID
 	}
 
 	return result, nil
 }
+
+func (importer *Importer) listServiceEdgeRouterPolicies(filter string) (map[string]string, error) {
+	resp, err := importer.client.ServiceEdgeRouterPolicy.ListServiceEdgeRouterPolicies(&service_edge_router_policy.ListServiceEdgeRouterPoliciesParams{Filter: &filter}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]string{}
+	for _, entity := range resp.Payload.Data {
+		result[*entity.Name] = *entity.ID
+	}
+	return result, nil
+}
+
+func (importer *Importer) deleteServiceEdgeRouterPolicy(id string) error {
+	_, err := importer.client.ServiceEdgeRouterPolicy.DeleteServiceEdgeRouterPolicy(&service_edge_router_policy.DeleteServiceEdgeRouterPolicyParams{ID: id}, nil)
+	return err
+}
@@ -22,7 +22,6 @@ import (
 	"ztna-core/edge-api/rest_management_api_client/service_policy"
 	"ztna-core/edge-api/rest_model"
 	"ztna-core/edge-api/rest_util"
-	"ztna-core/ztna/internal"
 	"ztna-core/ztna/internal/rest/mgmt"
 )
 
@@ -37,18 +36,6 @@ func (importer *Importer) ProcessServicePolicies(input map[string][]interface{})
 	for _, data := range input["servicePolicies"] {
 		create := FromMap(data, rest_model.ServicePolicyCreate{})
 
-		// see if the service policy already exists
-		existing := mgmt.ServicePolicyFromFilter(importer.client, mgmt.NameFilter(*create.Name))
-		if existing != nil {
-			log.WithFields(map[string]interface{}{
-				"name":            *create.Name,
-				"servicePolicyId": *existing.ID,
-			}).
-				Info("Found existing ServicePolicy, skipping create")
-			_, _ = internal.FPrintfReusingLine(importer.loginOpts.Err, "Skipping ServicePolicy %s\r", *create.Name)
-			continue
-		}
-
 		// look up the service ids from the name and add to the create
 		serviceRoles, err := importer.lookupServices(create.ServiceRoles)
 		if err != nil {
@@ -63,8 +50,28 @@ func (importer *Importer) ProcessServicePolicies(input map[string][]interface{})
 		}
 		create.IdentityRoles = identityRoles
 
+		// look up the posture check ids from the name and add to the create
+		postureCheckRoles, err := importer.lookupPostureChecks(create.PostureCheckRoles)
+		if err != nil {
+			return nil, err
+		}
+		create.PostureCheckRoles = postureCheckRoles
+
+		// see if the service policy already exists
+		existing := mgmt.ServicePolicyFromFilter(importer.client, mgmt.NameFilter(*create.Name))
+		if existing != nil {
+			err := importer.updateExisting("ServicePolicy", *create.Name, *existing.ID, create, existing, importer.patcher("service-policies"))
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+
 		// do the actual create since it doesn't exist
-		_, _ = internal.FPrintfReusingLine(importer.loginOpts.Err, "Skipping ServicePolicy %s\r", *create.Name)
+		entry := importer.planCreate("ServicePolicy", *create.Name)
+		if entry == nil {
+			continue
+		}
 		if importer.loginOpts.Verbose {
 			log.WithField("name", *create.Name).Debug("Creating ServicePolicy")
 		}
@@ -77,9 +84,9 @@ func (importer *Importer) ProcessServicePolicies(input map[string][]interface{})
 				}).
 					Error("Unable to create ServicePolicy")
 			} else {
-				log.WithError(createErr).Error("Unable to ")
-				return nil, createErr
+				log.WithError(createErr).Error("Unable to create ServicePolicy")
 			}
+			return nil, createErr
 		}
 		if importer.loginOpts.Verbose {
 			log.WithFields(map[string]interface{}{
@@ -89,8 +96,27 @@ func (importer *Importer) ProcessServicePolicies(input map[string][]interface{})
 				Info("Created ServicePolicy")
 		}
 
+		entry.Id = created.Payload.Data.ID
 		result[*create.Name] = created.Payload.Data.ID
 	}
 
 	return result, nil
 }
+
+func (importer *Importer) listServicePolicies(filter string) (map[string]string, error) {
+	resp, err := importer.client.ServicePolicy.ListServicePolicies(&service_policy.ListServicePoliciesParams{Filter: &filter}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]string{}
+	for _, entity := range resp.Payload.Data {
+		result[*entity.Name] = *entity.ID
+	}
+	return result, nil
+}
+
+func (importer *Importer) deleteServicePolicy(id string) error {
+	_, err := importer.client.ServicePolicy.DeleteServicePolicy(&service_policy.DeleteServicePolicyParams{ID: id}, nil)
+	return err
+}
@@ -23,7 +23,6 @@ import (
 	"ztna-core/edge-api/rest_management_api_client/service"
 	"ztna-core/edge-api/rest_model"
 	"ztna-core/edge-api/rest_util"
-	"ztna-core/ztna/internal"
 	"ztna-core/ztna/internal/ascode"
 	"ztna-core/ztna/internal/rest/mgmt"
 
@@ -42,18 +41,6 @@ func (importer *Importer) ProcessServices(input map[string][]interface{}) (map[s
 	for _, data := range input["services"] {
 		create := FromMap(data, rest_model.ServiceCreate{})
 
-		// see if the service already exists
-		existing := mgmt.ServiceFromFilter(importer.client, mgmt.NameFilter(*create.Name))
-		if existing != nil {
-			log.WithFields(map[string]interface{}{
-				"name":      *create.Name,
-				"serviceId": *existing.ID,
-			}).
-				Info("Found existing Service, skipping create")
-			_, _ = internal.FPrintfReusingLine(importer.loginOpts.Err, "Skipping Service %s\r", *create.Name)
-			continue
-		}
-
 		// convert to a json doc so we can query inside the data
 		jsonData, _ := json.Marshal(data)
 		doc, jsonParseError := gabs.ParseJSON(jsonData)
@@ -77,8 +64,22 @@ func (importer *Importer) ProcessServices(input map[string][]interface{}) (map[s
 		}
 		create.Configs = configIds
 
+		// see if the service already exists
+		existing := mgmt.ServiceFromFilter(importer.client, mgmt.NameFilter(*create.Name))
+		if existing != nil {
+			err := importer.updateExisting("Service", *create.Name, *existing.ID, create, existing, importer.patcher("services"))
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+
 		// do the actual create since it doesn't exist
-		_, _ = internal.FPrintfReusingLine(importer.loginOpts.Err, "Creating Service %s\r", *create.Name)
+		entry := importer.planCreate("Service", *create.Name)
+		if entry == nil {
+			importer.serviceCache[*create.Name] = &rest_model.ServiceDetail{BaseEntity: rest_model.BaseEntity{ID: plannedId(*create.Name)}}
+			continue
+		}
 		if importer.loginOpts.Verbose {
 			log.WithField("name", *create.Name).Debug("Creating Service")
 		}
@@ -92,8 +93,8 @@ func (importer *Importer) ProcessServices(input map[string][]interface{}) (map[s
 					Error("Unable to create Service")
 			} else {
 				log.WithError(createErr).Error("Unable to create Service")
-				return nil, createErr
 			}
+			return nil, createErr
 		}
 		if importer.loginOpts.Verbose {
 			log.WithFields(map[string]interface{}{
@@ -103,12 +104,31 @@ func (importer *Importer) ProcessServices(input map[string][]interface{}) (map[s
 				Info("Created Service")
 		}
 
+		entry.Id = created.Payload.Data.ID
 		result[*create.Name] = created.Payload.Data.ID
 	}
 
 	return result, nil
 }
 
+func (importer *Importer) listServices(filter string) (map[string]string, error) {
+	resp, err := importer.client.Service.ListServices(&service.ListServicesParams{Filter: &filter}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]string{}
+	for _, entity := range resp.Payload.Data {
+		result[*entity.Name] = *entity.ID
+	}
+	return result, nil
+}
+
+func (importer *Importer) deleteService(id string) error {
+	_, err := importer.client.Service.DeleteService(&service.DeleteServiceParams{ID: id}, nil)
+	return err
+}
+
 func (importer *Importer) lookupServices(roles []string) ([]string, error) {
 	serviceRoles := []string{}
 	for _, role := range roles {
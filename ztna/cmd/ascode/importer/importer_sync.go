@@ -0,0 +1,277 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package importer
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"ztna-core/ztna/ztna/cmd/edge"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	SyncEventNamespace = "ascode.sync"
+
+	SyncEventDrift   = "drift"
+	SyncEventApplied = "applied"
+	SyncEventFailed  = "failed"
+
+	// SyncSourceInput marks changes made because the files changed, SyncSourceManual changes made to the network
+	// outside of sync since the files were last applied
+	SyncSourceInput  = "input"
+	SyncSourceManual = "manual"
+
+	DefaultSyncTag = "managed-by=ztna-sync"
+)
+
+// SyncEvent reports a drift between the files and the network, or changes made to bring the network in line with them
+type SyncEvent struct {
+	Namespace string       `json:"namespace"`
+	EventType string       `json:"event_type"`
+	Timestamp time.Time    `json:"timestamp"`
+	Directory string       `json:"directory"`
+	Source    string       `json:"source,omitempty"`
+	Error     string       `json:"error,omitempty"`
+	Changes   []*PlanEntry `json:"changes,omitempty"`
+}
+
+// Syncer continuously reconciles the network with a directory of YAML files in the import format. Every entity it
+// manages is tagged, entities carrying the tag which are no longer in the files are deleted.
+type Syncer struct {
+	importer    *Importer
+	dir         string
+	interval    time.Duration
+	revert      bool
+	once        bool
+	eventsFile  string
+	events      io.Writer
+	appliedHash string
+}
+
+func NewSyncCmd(out io.Writer, errOut io.Writer) *cobra.Command {
+	syncer := &Syncer{
+		importer: &Importer{
+			loginOpts: edge.LoginOptions{},
+			upsert:    true,
+			prune:     true,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "sync directory",
+		Short: "Continuously reconcile entities with a directory of YAML files",
+		Long: "Watch a directory of YAML files in the import format and reconcile the network with them.\n" +
+			"Changes to the files are applied as they are seen. Managed entities are marked with the sync tag, " +
+			"and changes made to them outside of sync are reported as drift, and reverted if --revert is given.",
+		Args: cobra.ExactArgs(1),
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			syncer.importer.Init()
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			syncer.dir = args[0]
+			syncer.events = out
+			if err := syncer.Run(); err != nil {
+				panic(err)
+			}
+		},
+		Hidden: true,
+	}
+
+	cmd.Flags().DurationVar(&syncer.interval, "interval", 30*time.Second, "How often to check the files and the network for changes")
+	cmd.Flags().StringVar(&syncer.importer.pruneTag, "tag", DefaultSyncTag, "The key=value tag marking the entities managed by sync")
+	cmd.Flags().BoolVar(&syncer.revert, "revert", false, "Revert changes made to managed entities outside of sync, instead of only reporting them")
+	cmd.Flags().BoolVar(&syncer.once, "once", false, "Reconcile once and exit")
+	cmd.Flags().StringVar(&syncer.eventsFile, "events-file", "", "Append events to this file as JSON lines, instead of writing them to stdout")
+
+	edge.AddLoginFlags(cmd, &syncer.importer.loginOpts)
+	syncer.importer.loginOpts.Out = out
+	syncer.importer.loginOpts.Err = errOut
+
+	return cmd
+}
+
+func (self *Syncer) Run() error {
+	if self.eventsFile != "" {
+		file, err := os.OpenFile(self.eventsFile, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+		if err != nil {
+			return fmt.Errorf("unable to open events file %s: %w", self.eventsFile, err)
+		}
+		defer func() { _ = file.Close() }()
+		self.events = file
+	}
+
+	if self.once {
+		return self.reconcile()
+	}
+
+	ticker := time.NewTicker(self.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := self.reconcile(); err != nil {
+			log.WithError(err).WithField("directory", self.dir).Error("sync failed, retrying")
+		}
+		<-ticker.C
+	}
+}
+
+// reconcile plans the changes needed to bring the network in line with the files. Changes caused by the files having
+// changed since they were last applied are applied, other changes are drift, which is reported and optionally reverted.
+func (self *Syncer) reconcile() error {
+	data, hash, err := readSyncDir(self.dir)
+	if err != nil {
+		self.emit(SyncEventFailed, "", err, nil)
+		return err
+	}
+
+	self.importer.dryRun = true
+	if _, err = self.importer.Execute(data, nil); err != nil {
+		self.emit(SyncEventFailed, "", err, nil)
+		return err
+	}
+	plan := self.importer.plan
+
+	if len(plan.Entries) == 0 {
+		self.appliedHash = hash
+		return nil
+	}
+
+	source := SyncSourceInput
+	if hash == self.appliedHash {
+		source = SyncSourceManual
+		self.emit(SyncEventDrift, source, nil, plan.Entries)
+		if !self.revert {
+			return nil
+		}
+	}
+
+	self.importer.dryRun = false
+	if _, err = self.importer.Execute(data, nil); err != nil {
+		self.emit(SyncEventFailed, source, err, self.importer.plan.Entries)
+		return err
+	}
+
+	self.appliedHash = hash
+	self.emit(SyncEventApplied, source, nil, self.importer.plan.Entries)
+	return nil
+}
+
+func (self *Syncer) emit(eventType, source string, err error, changes []*PlanEntry) {
+	event := &SyncEvent{
+		Namespace: SyncEventNamespace,
+		EventType: eventType,
+		Timestamp: time.Now(),
+		Directory: self.dir,
+		Source:    source,
+		Changes:   changes,
+	}
+	if err != nil {
+		event.Error = err.Error()
+	}
+
+	logger := log.WithField("event_type", eventType).WithField("source", source).WithField("changes", len(changes))
+	if eventType == SyncEventDrift {
+		for _, change := range changes {
+			logger.Warnf("drift: %s %s %s %s", change.Action, change.EntityType, change.Name, strings.Join(change.Fields, ", "))
+		}
+	} else {
+		logger.Info("sync event")
+	}
+
+	if self.events == nil {
+		return
+	}
+
+	line, marshalErr := json.Marshal(event)
+	if marshalErr != nil {
+		log.WithError(marshalErr).Error("unable to marshal sync event")
+		return
+	}
+	_, _ = fmt.Fprintln(self.events, string(line))
+}
+
+// readSyncDir merges the .yaml and .yml files under dir, in path order, into a single import input. It also returns a
+// hash of the files, which changes whenever any of them do.
+func readSyncDir(dir string) (map[string][]interface{}, string, error) {
+	var paths []string
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		ext := strings.ToLower(filepath.Ext(path))
+		if !d.IsDir() && (ext == ".yaml" || ext == ".yml") {
+			paths = append(paths, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("unable to read directory %s: %w", dir, err)
+	}
+	sort.Strings(paths)
+
+	result := map[string][]interface{}{}
+	names := map[string]string{}
+	hash := sha256.New()
+
+	for _, path := range paths {
+		contents, err := os.ReadFile(path)
+		if err != nil {
+			return nil, "", fmt.Errorf("unable to read %s: %w", path, err)
+		}
+		hash.Write([]byte(path))
+		hash.Write(contents)
+
+		m := map[string][]interface{}{}
+		if err = yaml.Unmarshal(contents, &m); err != nil {
+			return nil, "", fmt.Errorf("unable to parse %s as yaml: %w", path, err)
+		}
+
+		for key, entities := range m {
+			for _, name := range sortedNames(entities) {
+				qualified := key + "/" + name
+				if other, found := names[qualified]; found {
+					return nil, "", fmt.Errorf("%s %s is defined in both %s and %s", key, name, other, path)
+				}
+				names[qualified] = path
+			}
+			result[key] = append(result[key], entities...)
+		}
+	}
+
+	return result, hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func sortedNames(entities []interface{}) []string {
+	var result []string
+	for name := range inputNames(entities) {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,47 @@
+package importer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadSyncDir(t *testing.T) {
+	dir := t.TempDir()
+	req := require.New(t)
+
+	write := func(name, contents string) {
+		path := filepath.Join(dir, name)
+		req.NoError(os.MkdirAll(filepath.Dir(path), 0755))
+		req.NoError(os.WriteFile(path, []byte(contents), 0644))
+	}
+
+	write("services.yaml", "services:\n  - name: web\n    roleAttributes: [\"a\"]\n")
+	write("team/policies.yml", "servicePolicies:\n  - name: web-dial\n    type: Dial\nservices:\n  - name: db\n")
+	write("README.md", "not input")
+
+	data, hash, err := readSyncDir(dir)
+	req.NoError(err)
+	req.Len(data["services"], 2)
+	req.Len(data["servicePolicies"], 1)
+
+	// nested maps must decode as string keyed, so that the input can be tagged
+	req.NoError(tagInput(data, "managed-by=test"))
+	service := data["services"][0].(map[string]interface{})
+	req.Equal("test", service["tags"].(map[string]interface{})["managed-by"])
+
+	_, sameHash, err := readSyncDir(dir)
+	req.NoError(err)
+	req.Equal(hash, sameHash)
+
+	write("services.yaml", "services:\n  - name: web\n    roleAttributes: [\"b\"]\n")
+	_, changedHash, err := readSyncDir(dir)
+	req.NoError(err)
+	req.NotEqual(hash, changedHash)
+
+	write("more.yaml", "services:\n  - name: web\n")
+	_, _, err = readSyncDir(dir)
+	req.ErrorContains(err, "services web is defined in both")
+}
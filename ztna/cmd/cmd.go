@@ -150,6 +150,7 @@ func NewCmdRoot(in io.Reader, out, err io.Writer, cmd *cobra.Command) *cobra.Com
 	opsCommands.AddCommand(verify.NewVerifyTraffic(out, err))
 	opsCommands.AddCommand(exporter.NewExportCmd(out, err))
 	opsCommands.AddCommand(importer.NewImportCmd(out, err))
+	opsCommands.AddCommand(importer.NewSyncCmd(out, err))
 
 	groups := templates.CommandGroups{
 		{
@@ -0,0 +1,162 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package database
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/spf13/cobra"
+	"go.etcd.io/bbolt"
+	"io"
+	"os"
+	"strings"
+	"time"
+	"ztna-core/ztna/controller/command"
+	"ztna-core/ztna/controller/db"
+)
+
+type accessMatrixAction struct {
+	csv    bool
+	output string
+	at     string
+}
+
+func NewAccessMatrixAction() *cobra.Command {
+	action := &accessMatrixAction{}
+
+	cmd := &cobra.Command{
+		Use:   "access-matrix",
+		Short: "Computes and compares the effective identity to service access of a controller database",
+	}
+
+	exportCmd := &cobra.Command{
+		Use:   "export <db-file>",
+		Short: "Exports the effective access matrix of a controller database as JSON or CSV",
+		Args:  cobra.ExactArgs(1),
+		RunE:  action.export,
+	}
+	action.addFlags(exportCmd)
+
+	diffCmd := &cobra.Command{
+		Use:   "diff <before> <after>",
+		Short: "Compares the effective access of two database snapshots or previously exported JSON matrices",
+		Args:  cobra.ExactArgs(2),
+		RunE:  action.diff,
+	}
+	action.addFlags(diffCmd)
+
+	cmd.AddCommand(exportCmd, diffCmd)
+	return cmd
+}
+
+func (self *accessMatrixAction) addFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&self.csv, "csv", false, "output CSV instead of JSON")
+	cmd.Flags().StringVarP(&self.output, "output", "o", "", "output file. If not specified, output is written to stdout")
+	cmd.Flags().StringVar(&self.at, "at", "", "RFC3339 time at which to evaluate policy schedules. If not specified, the current time is used")
+}
+
+func (self *accessMatrixAction) export(_ *cobra.Command, args []string) error {
+	matrix, err := self.loadAccessMatrix(args[0])
+	if err != nil {
+		return err
+	}
+	return self.write(matrix, matrix.WriteCsv)
+}
+
+func (self *accessMatrixAction) diff(_ *cobra.Command, args []string) error {
+	before, err := self.loadAccessMatrix(args[0])
+	if err != nil {
+		return err
+	}
+	after, err := self.loadAccessMatrix(args[1])
+	if err != nil {
+		return err
+	}
+	diff := db.DiffAccessMatrix(before, after)
+	return self.write(diff, diff.WriteCsv)
+}
+
+func (self *accessMatrixAction) write(val any, writeCsv func(w io.Writer) error) error {
+	var out io.Writer = os.Stdout
+	if self.output != "" {
+		f, err := os.Create(self.output)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = f.Close() }()
+		out = f
+	}
+
+	if self.csv {
+		return writeCsv(out)
+	}
+
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(val)
+}
+
+// loadAccessMatrix reads a matrix from a previously exported JSON file, either as written by export or as returned
+// by the management API, or computes it from a controller database file
+func (self *accessMatrixAction) loadAccessMatrix(path string) (*db.AccessMatrix, error) {
+	if strings.HasSuffix(strings.ToLower(path), ".json") {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		wrapped := struct {
+			Data *db.AccessMatrix `json:"data"`
+		}{}
+		if err = json.Unmarshal(data, &wrapped); err != nil {
+			return nil, fmt.Errorf("unable to parse access matrix from %s: %w", path, err)
+		}
+		if wrapped.Data != nil {
+			return wrapped.Data, nil
+		}
+		matrix := &db.AccessMatrix{}
+		if err = json.Unmarshal(data, matrix); err != nil {
+			return nil, fmt.Errorf("unable to parse access matrix from %s: %w", path, err)
+		}
+		return matrix, nil
+	}
+
+	at := time.Now()
+	if self.at != "" {
+		var err error
+		if at, err = time.Parse(time.RFC3339, self.at); err != nil {
+			return nil, fmt.Errorf("invalid --at time '%s', expected RFC3339: %w", self.at, err)
+		}
+	}
+
+	zitiDb, err := db.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open database %s: %w", path, err)
+	}
+	defer func() { _ = zitiDb.Close() }()
+
+	stores, err := db.InitStores(zitiDb, command.NoOpRateLimiter{}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var matrix *db.AccessMatrix
+	err = zitiDb.View(func(tx *bbolt.Tx) error {
+		matrix, err = db.BuildAccessMatrix(tx, stores, at, nil)
+		return err
+	})
+	return matrix, err
+}
@@ -28,6 +28,7 @@ func NewCmdDb(out io.Writer, errOut io.Writer) *cobra.Command {
 	cmd.AddCommand(NewDiskUsageAction())
 	cmd.AddCommand(NewAddDebugAdminAction())
 	cmd.AddCommand(NewAnonymizeAction())
+	cmd.AddCommand(NewAccessMatrixAction())
 
 	return cmd
 }
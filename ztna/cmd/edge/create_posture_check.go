@@ -19,8 +19,10 @@ package edge
 import (
 	"fmt"
 	"io"
+	"os"
 	"regexp"
 	"strings"
+	"time"
 
 	"ztna-core/edge-api/rest_management_api_client/posture_checks"
 	"ztna-core/edge-api/rest_model"
@@ -44,6 +46,8 @@ func newCreatePostureCheckCmd(out io.Writer, errOut io.Writer) *cobra.Command {
 	cmd.AddCommand(newCreatePostureCheckOsCmd(out, errOut))
 	cmd.AddCommand(newCreatePostureCheckMfaCmd(out, errOut))
 	cmd.AddCommand(newCreatePostureCheckProcessMultiCmd(out, errOut))
+	cmd.AddCommand(newCreatePostureCheckSourceIpCmd(out, errOut))
+	cmd.AddCommand(newCreatePostureCheckAttestationCmd(out, errOut))
 
 	return cmd
 }
@@ -71,6 +75,24 @@ type createPostureCheckDomainOptions struct {
 	domains []string
 }
 
+type createPostureCheckSourceIpOptions struct {
+	createPostureCheckOptions
+	allowedCidrs     []string
+	deniedCidrs      []string
+	allowedCountries []string
+	deniedCountries  []string
+}
+
+type createPostureCheckAttestationOptions struct {
+	createPostureCheckOptions
+	publicKeyFiles        []string
+	maxAge                time.Duration
+	requireDiskEncryption bool
+	requireFirewall       bool
+	edrAgent              string
+	minEdrVersion         string
+}
+
 type createPostureCheckOsOptions struct {
 	createPostureCheckOptions
 	os []string
@@ -170,6 +192,86 @@ func newCreatePostureCheckDomainCmd(out io.Writer, errOut io.Writer) *cobra.Comm
 	return cmd
 }
 
+func newCreatePostureCheckSourceIpCmd(out io.Writer, errOut io.Writer) *cobra.Command {
+	options := &createPostureCheckSourceIpOptions{
+		createPostureCheckOptions: createPostureCheckOptions{
+			EntityOptions: api.NewEntityOptions(out, errOut),
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "source-ip <name>",
+		Short: "creates a posture check for the client's source ip, by network and country",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			options.Cmd = cmd
+			options.Args = args
+
+			err := runCreatePostureCheckSourceIp(options)
+			cmdhelper.CheckErr(err)
+		},
+		SuggestFor: []string{},
+	}
+
+	// allow interspersing positional args and flags
+	cmd.Flags().SetInterspersed(true)
+	options.AddCommonFlags(cmd)
+	options.createPostureCheckOptions.addPostureFlags(cmd)
+
+	cmd.Flags().StringSliceVar(&options.allowedCidrs, "allowed-cidrs", nil,
+		"Set the networks clients may connect from, may be CSV or multiple flags")
+	cmd.Flags().StringSliceVar(&options.deniedCidrs, "denied-cidrs", nil,
+		"Set the networks clients may not connect from, may be CSV or multiple flags")
+	cmd.Flags().StringSliceVar(&options.allowedCountries, "allowed-countries", nil,
+		"Set the ISO country codes clients may connect from, may be CSV or multiple flags")
+	cmd.Flags().StringSliceVar(&options.deniedCountries, "denied-countries", nil,
+		"Set the ISO country codes clients may not connect from, may be CSV or multiple flags")
+
+	return cmd
+}
+
+func newCreatePostureCheckAttestationCmd(out io.Writer, errOut io.Writer) *cobra.Command {
+	options := &createPostureCheckAttestationOptions{
+		createPostureCheckOptions: createPostureCheckOptions{
+			EntityOptions: api.NewEntityOptions(out, errOut),
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "attestation <name>",
+		Short: "creates a posture check for signed device attestation statements",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			options.Cmd = cmd
+			options.Args = args
+
+			err := runCreatePostureCheckAttestation(options)
+			cmdhelper.CheckErr(err)
+		},
+		SuggestFor: []string{},
+	}
+
+	// allow interspersing positional args and flags
+	cmd.Flags().SetInterspersed(true)
+	options.AddCommonFlags(cmd)
+	options.createPostureCheckOptions.addPostureFlags(cmd)
+
+	cmd.Flags().StringSliceVar(&options.publicKeyFiles, "public-key-files", nil,
+		"Set the PEM files of the keys statements may be signed with, may be CSV or multiple flags")
+	cmd.Flags().DurationVar(&options.maxAge, "max-age", 10*time.Minute,
+		"Set how long after issue a statement is accepted")
+	cmd.Flags().BoolVar(&options.requireDiskEncryption, "require-disk-encryption", false,
+		"Require statements to report disk encryption")
+	cmd.Flags().BoolVar(&options.requireFirewall, "require-firewall", false,
+		"Require statements to report an enabled firewall")
+	cmd.Flags().StringVar(&options.edrAgent, "edr-agent", "",
+		"Require statements to report the given EDR agent")
+	cmd.Flags().StringVar(&options.minEdrVersion, "min-edr-version", "",
+		"Require statements to report at least the given EDR agent version")
+
+	return cmd
+}
+
 func newCreatePostureCheckProcessCmd(out io.Writer, errOut io.Writer) *cobra.Command {
 	options := &createPostureCheckProcessOptions{
 		createPostureCheckOptions: createPostureCheckOptions{
@@ -314,6 +416,9 @@ const (
 	PostureCheckTypeMAC     = "MAC"
 	PostureCheckTypeOS      = "OS"
 	PostureCheckTypeMFA     = "MFA"
+
+	PostureCheckTypeSourceIp    = "SOURCE_IP"
+	PostureCheckTypeAttestation = "ATTESTATION"
 )
 
 // Returns the normalized Edge API value or empty string
@@ -567,7 +672,67 @@ func cleanDomains(inDomains []string) []string {
 	return domains
 }
 
-// runCreatePostureCheckDomain implements the command to create a windows domain posture check
+// runCreatePostureCheckSourceIp implements the command to create a source ip posture check
+func runCreatePostureCheckSourceIp(o *createPostureCheckSourceIpOptions) error {
+	if len(o.allowedCidrs)+len(o.deniedCidrs)+len(o.allowedCountries)+len(o.deniedCountries) == 0 {
+		return fmt.Errorf("must specify at least one allowed or denied network or country")
+	}
+
+	entityData := gabs.New()
+	setPostureCheckEntityValues(entityData, &o.createPostureCheckOptions, PostureCheckTypeSourceIp)
+	api.SetJSONValue(entityData, o.allowedCidrs, "allowedCidrs")
+	api.SetJSONValue(entityData, o.deniedCidrs, "deniedCidrs")
+	api.SetJSONValue(entityData, o.allowedCountries, "allowedCountries")
+	api.SetJSONValue(entityData, o.deniedCountries, "deniedCountries")
+
+	// source ip checks aren't part of the generated api and have their own create endpoint
+	result, err := CreateEntityOfType("posture-checks/source-ip", entityData.String(), &o.Options)
+	if err != nil {
+		return err
+	}
+
+	checkId := result.S("data", "id").Data()
+
+	_, err = fmt.Fprintf(o.Out, "%v\n", checkId)
+	return err
+}
+
+// runCreatePostureCheckAttestation implements the command to create a signed device attestation posture check
+func runCreatePostureCheckAttestation(o *createPostureCheckAttestationOptions) error {
+	if len(o.publicKeyFiles) == 0 {
+		return fmt.Errorf("must specify at least one public key file")
+	}
+
+	var publicKeys []string
+	for _, publicKeyFile := range o.publicKeyFiles {
+		pem, err := os.ReadFile(publicKeyFile)
+		if err != nil {
+			return fmt.Errorf("unable to read public key file %s: %w", publicKeyFile, err)
+		}
+		publicKeys = append(publicKeys, string(pem))
+	}
+
+	entityData := gabs.New()
+	setPostureCheckEntityValues(entityData, &o.createPostureCheckOptions, PostureCheckTypeAttestation)
+	api.SetJSONValue(entityData, publicKeys, "publicKeys")
+	api.SetJSONValue(entityData, int64(o.maxAge.Seconds()), "maxAgeSeconds")
+	api.SetJSONValue(entityData, o.requireDiskEncryption, "requireDiskEncryption")
+	api.SetJSONValue(entityData, o.requireFirewall, "requireFirewall")
+	api.SetJSONValue(entityData, o.edrAgent, "edrAgent")
+	api.SetJSONValue(entityData, o.minEdrVersion, "minEdrVersion")
+
+	// attestation checks aren't part of the generated api and have their own create endpoint
+	result, err := CreateEntityOfType("posture-checks/attestation", entityData.String(), &o.Options)
+	if err != nil {
+		return err
+	}
+
+	checkId := result.S("data", "id").Data()
+
+	_, err = fmt.Fprintf(o.Out, "%v\n", checkId)
+	return err
+}
+
 func runCreatePostureCheckDomain(o *createPostureCheckDomainOptions) (err error) {
 	entityData := gabs.New()
 	setPostureCheckEntityValues(entityData, &o.createPostureCheckOptions, PostureCheckTypeDomain)
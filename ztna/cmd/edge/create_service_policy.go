@@ -19,6 +19,7 @@ package edge
 import (
 	"io"
 	"strings"
+	"time"
 
 	"ztna-core/ztna/ztna/cmd/api"
 	cmdhelper "ztna-core/ztna/ztna/cmd/helpers"
@@ -35,6 +36,11 @@ type createServicePolicyOptions struct {
 	identityRoles     []string
 	postureCheckRoles []string
 	semantic          string
+	scheduleTimezone  string
+	scheduleDays      []string
+	scheduleWindows   []string
+	notBefore         string
+	notAfter          string
 }
 
 // newCreateServicePolicyCmd creates the 'edge controller create service-policy' command
@@ -64,6 +70,11 @@ func newCreateServicePolicyCmd(out io.Writer, errOut io.Writer) *cobra.Command {
 	cmd.Flags().StringSliceVar(&options.identityRoles, "identity-roles", nil, "Identity roles of the new service policy")
 	cmd.Flags().StringVar(&options.semantic, "semantic", "AnyOf", "Semantic dictating how multiple attributes should be interpreted. Valid values: AnyOf, AllOf")
 	cmd.Flags().StringSliceVarP(&options.postureCheckRoles, "posture-check-roles", "p", nil, "Posture check roles of the new service policy")
+	cmd.Flags().StringVar(&options.scheduleTimezone, "schedule-timezone", "", "Timezone the schedule days and windows are evaluated in, e.g. America/New_York. Defaults to UTC")
+	cmd.Flags().StringSliceVar(&options.scheduleDays, "schedule-days", nil, "Days of the week the policy is in force, e.g. mon,tue,wed,thu,fri")
+	cmd.Flags().StringSliceVar(&options.scheduleWindows, "schedule-windows", nil, "Times of day the policy is in force, as HH:MM-HH:MM, e.g. 09:00-17:00")
+	cmd.Flags().StringVar(&options.notBefore, "not-before", "", "RFC3339 time before which the policy is not in force")
+	cmd.Flags().StringVar(&options.notAfter, "not-after", "", "RFC3339 time from which the policy is no longer in force")
 	options.AddCommonFlags(cmd)
 
 	return cmd
@@ -100,12 +111,53 @@ func runCreateServicePolicy(o *createServicePolicyOptions) error {
 	if o.semantic != "" {
 		api.SetJSONValue(entityData, o.semantic, "semantic")
 	}
+	if err = o.setSchedule(entityData); err != nil {
+		return err
+	}
 	o.SetTags(entityData)
 
 	result, err := CreateEntityOfType("service-policies", entityData.String(), &o.Options)
 	return o.LogCreateResult("service policy", result, err)
 }
 
+func (o *createServicePolicyOptions) setSchedule(entityData *gabs.Container) error {
+	if o.scheduleTimezone == "" && len(o.scheduleDays) == 0 && len(o.scheduleWindows) == 0 && o.notBefore == "" && o.notAfter == "" {
+		return nil
+	}
+
+	if o.scheduleTimezone != "" {
+		api.SetJSONValue(entityData, o.scheduleTimezone, "schedule", "timezone")
+	}
+
+	if len(o.scheduleDays) > 0 {
+		api.SetJSONValue(entityData, o.scheduleDays, "schedule", "days")
+	}
+
+	var windows []map[string]string
+	for _, window := range o.scheduleWindows {
+		start, end, found := strings.Cut(window, "-")
+		if !found {
+			return errors.Errorf("invalid schedule window '%v', expected HH:MM-HH:MM", window)
+		}
+		windows = append(windows, map[string]string{"start": start, "end": end})
+	}
+	if len(windows) > 0 {
+		api.SetJSONValue(entityData, windows, "schedule", "windows")
+	}
+
+	for field, value := range map[string]string{"notBefore": o.notBefore, "notAfter": o.notAfter} {
+		if value == "" {
+			continue
+		}
+		if _, err := time.Parse(time.RFC3339, value); err != nil {
+			return errors.Wrapf(err, "invalid %v time '%v', expected RFC3339", field, value)
+		}
+		api.SetJSONValue(entityData, value, "schedule", field)
+	}
+
+	return nil
+}
+
 func convertNamesToIds(roles []string, entityType string, o api.Options) ([]string, error) {
 	var result []string
 	for _, val := range roles {
@@ -78,6 +78,7 @@ func newListCmd(out io.Writer, errOut io.Writer) *cobra.Command {
 	}
 
 	cmd.AddCommand(newListCmdForEntityType("api-sessions", runListApiSessions, newOptions()))
+	cmd.AddCommand(newListCmdForEntityType("audit-records", runListAuditRecords, newOptions()))
 	cmd.AddCommand(newListCmdForEntityType("authenticators", runListAuthenticators, newOptions()))
 	cmd.AddCommand(newListCmdForEntityType("auth-policies", runListAuthPolicies, newOptions()))
 	cmd.AddCommand(newListCmdForEntityType("cas", runListCAs, newOptions()))
@@ -1505,6 +1506,55 @@ func runListApiSessions(o *api.Options) error {
 	return nil
 }
 
+func runListAuditRecords(o *api.Options) error {
+	children, pagingInfo, err := listEntitiesWithOptions("audit-records", o)
+	if err != nil {
+		return err
+	}
+
+	if o.OutputJSONResponse {
+		return nil
+	}
+
+	t := table.NewWriter()
+	t.SetStyle(table.StyleRounded)
+	t.AppendHeader(table.Row{"Sequence", "Timestamp", "Change", "Entity Type", "Entity ID", "Actor", "Auth Method", "Source", "Hash"})
+
+	for _, entity := range children {
+		wrapper := api.Wrap(entity)
+
+		actor := wrapper.String("actorName")
+		if actor == "" {
+			actor = wrapper.String("actorId")
+		}
+
+		source := wrapper.String("remoteAddr")
+		if source == "" {
+			source = wrapper.String("sourceType")
+		}
+
+		hash := wrapper.String("hash")
+		if len(hash) > 12 {
+			hash = hash[:12]
+		}
+
+		t.AppendRow(table.Row{
+			int64(wrapper.Float64("sequence")),
+			wrapper.String("timestamp"),
+			wrapper.String("changeType"),
+			wrapper.String("entityType"),
+			wrapper.String("entityId"),
+			actor,
+			wrapper.String("authMethod"),
+			source,
+			hash,
+		})
+	}
+	api.RenderTable(o, t, pagingInfo)
+
+	return nil
+}
+
 func runListSessions(o *api.Options) error {
 	children, pagingInfo, err := listEntitiesWithOptions("sessions", o)
 
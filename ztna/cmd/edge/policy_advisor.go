@@ -41,6 +41,8 @@ func newPolicyAdivsorCmd(out io.Writer, errOut io.Writer) *cobra.Command {
 
 	cmd.AddCommand(newPolicyAdvisorIdentitiesCmd(out, errOut))
 	cmd.AddCommand(newPolicyAdvisorServicesCmd(out, errOut))
+	cmd.AddCommand(newPolicyAdvisorSimulateCmd(out, errOut))
+	cmd.AddCommand(newPolicyAdvisorMatrixCmd(out, errOut))
 
 	return cmd
 }
@@ -0,0 +1,100 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package edge
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"ztna-core/ztna/controller/db"
+	"ztna-core/ztna/ztna/cmd/api"
+	"ztna-core/ztna/ztna/cmd/common"
+	cmdhelper "ztna-core/ztna/ztna/cmd/helpers"
+	"ztna-core/ztna/ztna/util"
+)
+
+type policyAdvisorMatrixOptions struct {
+	api.Options
+	csv bool
+}
+
+// newPolicyAdvisorMatrixCmd creates the 'edge policy-advisor matrix' command
+func newPolicyAdvisorMatrixCmd(out io.Writer, errOut io.Writer) *cobra.Command {
+	options := &policyAdvisorMatrixOptions{
+		Options: api.Options{
+			CommonOptions: common.CommonOptions{Out: out, Err: errOut},
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "matrix",
+		Short: "shows which identities can dial or bind which services, with the granting policies, posture checks and edge routers",
+		Long: "Shows the effective access of the whole network, one row per identity, service and dial or bind. " +
+			"Use --csv for a spreadsheet friendly export, or --output-json for the raw matrix, which can be compared " +
+			"with another export using 'ztna ops db access-matrix diff'.",
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			options.Cmd = cmd
+			options.Args = args
+			err := runPolicyAdvisorMatrix(options)
+			cmdhelper.CheckErr(err)
+		},
+		SuggestFor: []string{},
+	}
+
+	cmd.Flags().SetInterspersed(true)
+	options.AddCommonFlags(cmd)
+	cmd.Flags().BoolVar(&options.csv, "csv", false, "Output the matrix as CSV")
+
+	return cmd
+}
+
+func runPolicyAdvisorMatrix(o *policyAdvisorMatrixOptions) error {
+	resp, err := util.EdgeControllerList("access-matrix", nil, o.OutputJSONResponse, o.Out, o.Timeout, o.Verbose)
+	if err != nil {
+		return err
+	}
+
+	if o.OutputJSONResponse {
+		return nil
+	}
+
+	matrix := &db.AccessMatrix{}
+	if err = json.Unmarshal(resp.S("data").Bytes(), matrix); err != nil {
+		return errors.Wrap(err, "unable to parse access matrix")
+	}
+
+	if o.csv {
+		return matrix.WriteCsv(o.Out)
+	}
+
+	for _, entry := range matrix.Entries {
+		_, _ = fmt.Fprintf(o.Out, "%s -> %s: %s via %s%s\n", entry.IdentityName, entry.ServiceName, entry.Type,
+			strings.Join(entry.Policies, ", "), describePostureChecks(entry.PostureChecks))
+		_, _ = fmt.Fprintf(o.Out, "    edge routers: %s\n", strings.Join(entry.EdgeRouters, ", "))
+		if len(entry.OfflineEdgeRouters) > 0 {
+			_, _ = fmt.Fprintf(o.Out, "    offline: %s\n", strings.Join(entry.OfflineEdgeRouters, ", "))
+		}
+	}
+	_, _ = fmt.Fprintf(o.Out, "\n%d access entries\n", len(matrix.Entries))
+
+	return nil
+}
@@ -0,0 +1,154 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package edge
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+	"ztna-core/ztna/controller/db"
+	"ztna-core/ztna/ztna/cmd/api"
+	"ztna-core/ztna/ztna/cmd/common"
+	cmdhelper "ztna-core/ztna/ztna/cmd/helpers"
+)
+
+type policyAdvisorSimulateOptions struct {
+	api.Options
+	file          string
+	failOnRemoved bool
+}
+
+// newPolicyAdvisorSimulateCmd creates the 'edge policy-advisor simulate' command
+func newPolicyAdvisorSimulateCmd(out io.Writer, errOut io.Writer) *cobra.Command {
+	options := &policyAdvisorSimulateOptions{
+		Options: api.Options{
+			CommonOptions: common.CommonOptions{Out: out, Err: errOut},
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "simulate -f <changes file>",
+		Short: "shows how proposed policy, identity, service and edge router changes would change access, without making them",
+		Long: "Shows how proposed changes would change which identities can dial or bind which services, over which edge routers " +
+			"and with which posture checks. The changes file is YAML or JSON, with the keys identities, services, edgeRouters, " +
+			"postureChecks, servicePolicies, edgeRouterPolicies and serviceEdgeRouterPolicies, each a list of entities to create or " +
+			"update by name, and delete, mapping the same keys to lists of names to delete. Service policies may give a schedule. " +
+			"Schedules are evaluated at the RFC3339 time given by the optional at key, or now if it is not set.",
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			options.Cmd = cmd
+			options.Args = args
+			err := runPolicyAdvisorSimulate(options)
+			cmdhelper.CheckErr(err)
+		},
+		SuggestFor: []string{},
+	}
+
+	cmd.Flags().SetInterspersed(true)
+	options.AddCommonFlags(cmd)
+	cmd.Flags().StringVarP(&options.file, "file", "f", "", "The file of proposed changes")
+	cmd.Flags().BoolVar(&options.failOnRemoved, "fail-on-removed", false, "Exit with an error if any identity would lose access to a service")
+	_ = cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+func runPolicyAdvisorSimulate(o *policyAdvisorSimulateOptions) error {
+	data, err := os.ReadFile(o.file)
+	if err != nil {
+		return errors.Wrapf(err, "unable to read %s", o.file)
+	}
+
+	// YAML is a superset of JSON, so either may be given
+	changes := map[string]interface{}{}
+	if err = yaml.Unmarshal(data, &changes); err != nil {
+		return errors.Wrapf(err, "unable to parse %s", o.file)
+	}
+
+	body, err := json.Marshal(changes)
+	if err != nil {
+		return err
+	}
+
+	resp, err := postEntityOfType("policy-advice/simulate", string(body), &o.Options)
+	if err != nil {
+		return err
+	}
+
+	result := &db.AccessMatrixDiff{}
+	if resp != nil {
+		if err = json.Unmarshal(resp.S("data").Bytes(), result); err != nil {
+			return errors.Wrap(err, "unable to parse simulation result")
+		}
+	}
+
+	if !o.OutputJSONResponse {
+		outputSimulationResult(o, result)
+	}
+
+	if o.failOnRemoved && result.Removed > 0 {
+		return errors.Errorf("%d identity/service pairs would lose access", result.Removed)
+	}
+
+	return nil
+}
+
+func outputSimulationResult(o *policyAdvisorSimulateOptions, result *db.AccessMatrixDiff) {
+	for _, change := range result.Changes {
+		switch change.Change {
+		case db.AccessMatrixAdded:
+			_, _ = fmt.Fprintf(o.Out, "+ %s\n", describeSimulatedAccess(change.After))
+		case db.AccessMatrixRemoved:
+			_, _ = fmt.Fprintf(o.Out, "- %s\n", describeSimulatedAccess(change.Before))
+		default:
+			_, _ = fmt.Fprintf(o.Out, "~ %s\n    was: %s\n", describeSimulatedAccess(change.After), describeSimulatedAccess(change.Before))
+			_, _ = fmt.Fprintf(o.Out, "    changed: %s\n", strings.Join(change.Fields, ", "))
+		}
+	}
+
+	_, _ = fmt.Fprintf(o.Out, "\nAccess: %d gained, %d lost, %d changed. %d identities and %d services affected\n",
+		result.Added, result.Removed, result.Changed, result.IdentitiesAffected, result.ServicesAffected)
+}
+
+func describeSimulatedAccess(entry *db.AccessMatrixEntry) string {
+	reachable := "reachable"
+	if !entry.IsReachable() {
+		reachable = "NOT reachable"
+	}
+
+	online := len(entry.EdgeRouters) - len(entry.OfflineEdgeRouters)
+	return fmt.Sprintf("%s -> %s (%s) via %s%s, %s via %d/%d online common routers", entry.IdentityName, entry.ServiceName,
+		entry.Type, strings.Join(entry.Policies, ", "), describePostureChecks(entry.PostureChecks), reachable,
+		online, len(entry.EdgeRouters))
+}
+
+func describePostureChecks(checks [][]string) string {
+	if len(checks) == 0 {
+		return ""
+	}
+	var sets []string
+	for _, set := range checks {
+		sets = append(sets, strings.Join(set, "+"))
+	}
+	return " (posture checks: " + strings.Join(sets, " or ") + ")"
+}
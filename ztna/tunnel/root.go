@@ -63,7 +63,9 @@ func NewTunnelCmd(standalone bool) *cobra.Command {
 	root.PersistentFlags().StringP("identity", "i", "", "Path to JSON file that contains an enrolled identity")
 	root.PersistentFlags().String("identity-dir", "", "Path to directory file that contains one or more enrolled identities")
 	root.PersistentFlags().Uint(svcPollRateFlag, 15, "Set poll rate for service updates (seconds). Polling in proxy mode is disabled unless this value is explicitly set")
-	root.PersistentFlags().StringP(resolverCfgFlag, "r", "udp://127.0.0.1:53", "Resolver configuration")
+	root.PersistentFlags().StringP(resolverCfgFlag, "r", "udp://127.0.0.1:53", "Resolver configuration. Add ?upstream=<host:port> to forward queries for unknown names instead of refusing them. "+
+		"upstream may be repeated and may be restricted to domains with /<domain>/<host:port>. "+
+		"Forwarded responses are cached, see cacheSize, maxTtl, negativeTtl and timeout")
 	root.PersistentFlags().StringVar(&logFormatter, "log-formatter", "", "Specify log formatter [json|pfxlog|text]")
 	root.PersistentFlags().StringP(dnsSvcIpRangeFlag, "d", "100.64.0.1/10", "cidr to use when assigning IPs to unresolvable intercept hostnames")
 	root.PersistentFlags().BoolVar(&cliAgentEnabled, "cli-agent", true, "Enable/disable CLI Agent (enabled by default)")
@@ -133,7 +135,7 @@ func rootPostRun(cmd *cobra.Command, _ []string) {
 	sdkinfo.SetApplication("ziti-tunnel", version.GetVersion())
 
 	resolverConfig := cmd.Flag(resolverCfgFlag).Value.String()
-	resolver, err := dns.NewResolver(resolverConfig)
+	resolver, err := dns.NewResolver(resolverConfig, nil)
 	if err != nil {
 		log.WithError(err).Fatal("failed to start DNS resolver")
 	}
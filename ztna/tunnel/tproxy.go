@@ -34,12 +34,13 @@ func NewTProxyCmd() *cobra.Command {
 	var runTProxyCmd = &cobra.Command{
 		Use:     "tproxy",
 		Short:   "Use the 'tproxy' interceptor",
-		Long:    "The 'tproxy' interceptor captures packets by using the TPROXY iptables target.",
+		Long:    "The 'tproxy' interceptor captures packets by using the TPROXY iptables or nftables target.",
 		RunE:    runTProxy,
 		PostRun: rootPostRun,
 	}
 	runTProxyCmd.PersistentFlags().String("lanIf", "", "if specified, INPUT rules for intercepted service addresses are assigned to this interface ")
-	runTProxyCmd.PersistentFlags().String("diverter", "", "if specified, use external tproxy configuration utility instead of internal iptables implementation")
+	runTProxyCmd.PersistentFlags().String("diverter", "", "if specified, use external tproxy configuration utility instead of internal firewall implementation")
+	runTProxyCmd.PersistentFlags().String("firewall", tproxy.FirewallAuto, "firewall used to divert intercepted traffic. one of [auto, iptables, nftables]. auto uses nftables unless legacy iptables is installed")
 	return runTProxyCmd
 }
 
@@ -54,7 +55,12 @@ func runTProxy(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
-	interceptor, err = tproxy.New(tproxy.Config{LanIf: lanIf, Diverter: diverter})
+	firewall, err := cmd.Flags().GetString("firewall")
+	if err != nil {
+		return err
+	}
+
+	interceptor, err = tproxy.New(tproxy.Config{LanIf: lanIf, Diverter: diverter, Firewall: firewall})
 	if err != nil {
 		return fmt.Errorf("failed to initialize tproxy interceptor: %v", err)
 	}